@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"github.com/Alf-Grindel/clide/config"
 	"github.com/Alf-Grindel/clide/internal/dal/db"
 	"github.com/Alf-Grindel/clide/internal/routers"
+	"github.com/Alf-Grindel/clide/internal/services/picture_services"
 	"github.com/Alf-Grindel/clide/pkg/constants"
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/hertz-contrib/cors"
@@ -22,7 +24,15 @@ func Init() {
 func main() {
 	Init()
 
-	h := server.Default(server.WithHostPorts(":8080"))
+	go picture_services.RunStoragePurge(context.Background())
+
+	// 超出默认上限的请求体以流的形式读取, 各路由的上限由 mw.BodyLimitMiddleware 校验
+	h := server.Default(
+		server.WithHostPorts(":8080"),
+		server.WithMaxRequestBodySize(constants.MaxRequestBodySize),
+		server.WithStreamBody(true),
+		server.WithDisablePreParseMultipartForm(true),
+	)
 
 	store := cookie.NewStore([]byte(constants.CookieStore))
 
@@ -10,8 +10,12 @@ import (
 )
 
 var (
-	Mysql *mysql
-	Cos   *cos
+	Mysql     *mysql
+	Cos       *cos
+	Storage   *storage
+	Upload    *upload
+	Watermark *watermark
+	Quota     *quota
 
 	runtimeViper = viper.New()
 )
@@ -48,6 +52,10 @@ func mapping() {
 	}
 	Mysql = &c.MySQL
 	Cos = &c.Cos
+	Storage = &c.Storage
+	Upload = &c.Upload
+	Watermark = &c.Watermark
+	Quota = &c.Quota
 }
 
 func getPath(path string) (string, error) {
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type mysql struct {
 	Addr     string
 	User     string
@@ -19,7 +21,60 @@ type cos struct {
 	Client client
 }
 
+type local struct {
+	Root    string
+	Host    string
+	Route   string
+	SignKey string
+}
+
+type storage struct {
+	Driver         string
+	Local          local
+	PurgeRetention time.Duration
+	PurgeInterval  time.Duration
+	Private        bool
+	SignExpire     time.Duration
+}
+
+type upload struct {
+	DedupScope         string
+	DedupMode          string
+	MaxSessionFileSize int64
+	SessionDir         string
+	SessionExpire      time.Duration
+	PresignExpire      time.Duration
+	FetchTimeout       time.Duration
+	FetchMaxRedirects  int
+	FetchAllowDomains  []string
+	FetchDenyDomains   []string
+	SvgMode            string
+	HeicToJpeg         bool
+	MaxArchiveSize     int64
+}
+
+type watermark struct {
+	Enabled  bool
+	Type     string
+	Text     string
+	Color    string
+	Image    string
+	Position string
+	Opacity  float64
+	Scale    float64
+	Host     string
+}
+
+type quota struct {
+	MaxCount int64
+	MaxSize  int64
+}
+
 type Config struct {
-	MySQL mysql
-	Cos   cos
+	MySQL     mysql
+	Cos       cos
+	Storage   storage
+	Upload    upload
+	Watermark watermark
+	Quota     quota
 }
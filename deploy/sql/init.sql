@@ -50,4 +50,232 @@ alter table c_pictures
     add column review_Id bigint null comment '审核人id',
     add column review_time datetime null comment  '审核时间';
 
-create index idx_review_status on c_pictures (review_status);
\ No newline at end of file
+create index idx_review_status on c_pictures (review_status);
+
+alter table c_pictures
+    add column storage_key varchar(512) null comment '存储对象key';
+
+create index idx_storage_key on c_pictures (storage_key);
+
+alter table c_pictures
+    add column pic_hash char(64) null comment '图片内容SHA-256';
+
+create index idx_pic_hash on c_pictures (pic_hash);
+
+alter table c_pictures
+    add column pic_phash bigint unsigned null comment '图片感知哈希(dHash)';
+
+alter table c_pictures
+    add column pic_color   varchar(8)   null comment '图片主色调 #rrggbb',
+    add column pic_palette varchar(128) null comment '图片调色板（JSON数组';
+
+alter table c_pictures
+    add column exif_make        varchar(64) null comment '拍摄设备厂商',
+    add column exif_model       varchar(64) null comment '拍摄设备型号',
+    add column exif_taken_at    datetime    null comment '拍摄时间',
+    add column exif_orientation tinyint     null comment '原始方向 1 ~ 8';
+
+-- 待清理存储对象表
+create table if not exists c_storage_purges
+(
+    id          bigint auto_increment primary key comment 'id',
+    storage_key varchar(512)                       not null comment '存储对象key',
+    purge_time  datetime                           not null comment '清理时间',
+    create_time datetime default current_timestamp not null comment '创建时间',
+    index idx_purge_time (purge_time)
+) comment '待清理存储对象', collate = utf8mb4_unicode_ci;
+
+-- 分片上传会话表
+create table if not exists c_upload_sessions
+(
+    id          bigint auto_increment primary key comment 'id',
+    user_id     bigint                             not null comment '创建用户id',
+    picture_id  bigint                             null comment '待替换的图片id',
+    file_name   varchar(256)                       not null comment '原始文件名',
+    file_size   bigint                             not null comment '文件大小',
+    chunk_size  bigint                             not null comment '分片大小',
+    chunk_count int                                not null comment '分片数量',
+    pic_name    varchar(128)                       null comment '图片名称',
+    keep_gps    tinyint  default 0                 not null comment '是否保留定位信息',
+    status      tinyint  default 0                 not null comment '0 - 上传中 1 - 合并中',
+    expire_time datetime                           not null comment '过期时间',
+    create_time datetime default current_timestamp not null comment '创建时间',
+    index idx_user_id (user_id),
+    index idx_expire_time (expire_time)
+) comment '分片上传会话', collate = utf8mb4_unicode_ci;
+
+alter table c_pictures
+    add column thumbnail_key  varchar(512) null comment '缩略图存储对象key',
+    add column compressed_key varchar(512) null comment '压缩图存储对象key';
+
+create index idx_thumbnail_key on c_pictures (thumbnail_key);
+create index idx_compressed_key on c_pictures (compressed_key);
+
+alter table c_users
+    add column quota_count bigint           null comment '图片数量配额, 为空时使用默认配额',
+    add column quota_size  bigint           null comment '存储容量配额, 为空时使用默认配额',
+    add column used_count  bigint default 0 not null comment '已用图片数量',
+    add column used_size   bigint default 0 not null comment '已用存储容量';
+
+update c_users u
+set u.used_count = (select count(*) from c_pictures p where p.user_id = u.id and p.is_delete = 0),
+    u.used_size  = (select coalesce(sum(p.pic_size), 0) from c_pictures p where p.user_id = u.id and p.is_delete = 0);
+
+alter table c_pictures
+    add column pic_frames   int    null comment '动图帧数',
+    add column pic_duration bigint null comment '动图播放时长 (毫秒)';
+
+-- 空间表
+create table if not exists c_spaces
+(
+    id          bigint auto_increment primary key comment 'id',
+    space_name  varchar(128)                       null comment '空间名称',
+    space_level int      default 0                 not null comment '0 - 普通版 1 - 专业版 2 - 旗舰版',
+    max_count   bigint   default 0                 not null comment '图片数量上限, 0 表示不限制',
+    max_size    bigint   default 0                 not null comment '存储容量上限, 0 表示不限制',
+    total_count bigint   default 0                 not null comment '已用图片数量',
+    total_size  bigint   default 0                 not null comment '已用存储容量',
+    user_id     bigint                             not null comment '创建用户id',
+    edit_time   datetime default current_timestamp not null comment '编辑时间',
+    create_time datetime default current_timestamp not null comment '创建时间',
+    update_time datetime default current_timestamp not null on update current_timestamp comment '更新时间',
+    is_delete   tinyint  default 0                 not null comment '是否删除',
+    index idx_user_id (user_id),
+    index idx_space_name (space_name),
+    index idx_space_level (space_level)
+) comment '空间' collate = utf8mb4_unicode_ci;
+
+alter table c_pictures
+    add column space_id bigint null comment '空间id, 为空时属于公共图库';
+
+create index idx_space_id on c_pictures (space_id);
+
+alter table c_spaces
+    add column space_type int default 0 not null comment '0 - 私有空间 1 - 团队空间';
+
+create index idx_space_type on c_spaces (space_type);
+
+-- 空间成员表
+create table if not exists c_space_users
+(
+    id          bigint auto_increment primary key comment 'id',
+    space_id    bigint                                not null comment '空间id',
+    user_id     bigint                                not null comment '用户id',
+    space_role  varchar(16) default 'viewer'          not null comment 'viewer - 浏览者 editor - 编辑者 admin - 管理员',
+    create_time datetime    default current_timestamp not null comment '创建时间',
+    update_time datetime    default current_timestamp not null on update current_timestamp comment '更新时间',
+    unique key uk_space_id_user_id (space_id, user_id),
+    index idx_user_id (user_id)
+) comment '空间成员' collate = utf8mb4_unicode_ci;
+
+-- 空间邀请表
+create table if not exists c_space_invites
+(
+    id          bigint auto_increment primary key comment 'id',
+    space_id    bigint                                not null comment '空间id',
+    user_id     bigint                                not null comment '受邀用户id',
+    inviter_id  bigint                                not null comment '邀请人id',
+    space_role  varchar(16) default 'viewer'          not null comment 'viewer - 浏览者 editor - 编辑者 admin - 管理员',
+    status      tinyint     default 0                 not null comment '0 - 待接受 1 - 已接受 2 - 已拒绝',
+    create_time datetime    default current_timestamp not null comment '创建时间',
+    update_time datetime    default current_timestamp not null on update current_timestamp comment '更新时间',
+    unique key uk_space_id_user_id (space_id, user_id),
+    index idx_user_id_status (user_id, status)
+) comment '空间邀请' collate = utf8mb4_unicode_ci;
+
+-- 相册表
+create table if not exists c_albums
+(
+    id           bigint auto_increment primary key comment 'id',
+    album_name   varchar(128)                       not null comment '相册名称',
+    introduction varchar(512)                       null comment '简介',
+    cover_id     bigint   default 0                 not null comment '封面图片id, 0 表示使用第一张图片',
+    visibility   int      default 0                 not null comment '0 - 私密 1 - 公开',
+    user_id      bigint                             not null comment '创建用户id',
+    edit_time    datetime default current_timestamp not null comment '编辑时间',
+    create_time  datetime default current_timestamp not null comment '创建时间',
+    update_time  datetime default current_timestamp not null on update current_timestamp comment '更新时间',
+    is_delete    tinyint  default 0                 not null comment '是否删除',
+    index idx_user_id (user_id),
+    index idx_album_name (album_name)
+) comment '相册' collate = utf8mb4_unicode_ci;
+
+-- 相册图片表
+create table if not exists c_album_pictures
+(
+    id          bigint auto_increment primary key comment 'id',
+    album_id    bigint                             not null comment '相册id',
+    picture_id  bigint                             not null comment '图片id',
+    sort_order  int      default 0                 not null comment '排序, 越小越靠前',
+    create_time datetime default current_timestamp not null comment '创建时间',
+    unique key uk_album_id_picture_id (album_id, picture_id),
+    index idx_album_id_sort_order (album_id, sort_order),
+    index idx_picture_id (picture_id)
+) comment '相册图片' collate = utf8mb4_unicode_ci;
+
+alter table c_pictures
+    add column like_count     bigint default 0 not null comment '点赞数',
+    add column favorite_count bigint default 0 not null comment '收藏数';
+
+-- 图片点赞表
+create table if not exists c_picture_likes
+(
+    id          bigint auto_increment primary key comment 'id',
+    picture_id  bigint                             not null comment '图片id',
+    user_id     bigint                             not null comment '用户id',
+    create_time datetime default current_timestamp not null comment '创建时间',
+    unique key uk_picture_id_user_id (picture_id, user_id),
+    index idx_user_id (user_id)
+) comment '图片点赞' collate = utf8mb4_unicode_ci;
+
+-- 图片收藏表
+create table if not exists c_picture_favorites
+(
+    id          bigint auto_increment primary key comment 'id',
+    picture_id  bigint                             not null comment '图片id',
+    user_id     bigint                             not null comment '用户id',
+    create_time datetime default current_timestamp not null comment '创建时间',
+    unique key uk_picture_id_user_id (picture_id, user_id),
+    index idx_user_id_create_time (user_id, create_time)
+) comment '图片收藏' collate = utf8mb4_unicode_ci;
+
+-- 图片评论表
+create table if not exists c_comments
+(
+    id             bigint auto_increment primary key comment 'id',
+    picture_id     bigint                             not null comment '图片id',
+    user_id        bigint                             not null comment '评论用户id',
+    parent_id      bigint   default 0                 not null comment '所属一级评论id, 0 表示一级评论',
+    reply_user_id  bigint   default 0                 not null comment '被回复用户id',
+    content        varchar(1024)                      not null comment '评论内容',
+    review_status  int      default 0                 not null comment '0 - 待审核 1 - 通过 2 - 拒绝',
+    review_message varchar(512)                       null comment '审核信息',
+    review_id      bigint                             null comment '审核人id',
+    review_time    datetime                           null comment '审核时间',
+    is_hidden      tinyint  default 0                 not null comment '是否被图片作者或管理员隐藏',
+    edit_time      datetime default current_timestamp not null comment '编辑时间',
+    create_time    datetime default current_timestamp not null comment '创建时间',
+    update_time    datetime default current_timestamp not null on update current_timestamp comment '更新时间',
+    is_delete      tinyint  default 0                 not null comment '是否删除',
+    index idx_picture_id_parent_id (picture_id, parent_id),
+    index idx_parent_id (parent_id),
+    index idx_user_id (user_id),
+    index idx_review_status (review_status)
+) comment '图片评论' collate = utf8mb4_unicode_ci;
+
+alter table c_users
+    add column follower_count  bigint default 0 not null comment '粉丝数',
+    add column following_count bigint default 0 not null comment '关注数';
+
+-- 用户关注表
+create table if not exists c_user_follows
+(
+    id             bigint auto_increment primary key comment 'id',
+    user_id        bigint                             not null comment '关注者id',
+    follow_user_id bigint                             not null comment '被关注用户id',
+    create_time    datetime default current_timestamp not null comment '创建时间',
+    unique key uk_user_id_follow_user_id (user_id, follow_user_id),
+    index idx_follow_user_id_create_time (follow_user_id, create_time)
+) comment '用户关注' collate = utf8mb4_unicode_ci;
+
+create index idx_user_id_review_time on c_pictures (user_id, review_time);
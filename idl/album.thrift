@@ -0,0 +1,120 @@
+namespace go clide.album
+
+include "base.thrift"
+
+// public
+// 私密相册仅创建者可见
+struct GetAlbumReq {
+    1: i64 id
+}
+
+struct GetAlbumResp {
+    1: base.AlbumVo album
+    255: base.BaseResp base
+}
+
+// 他人仅能看到已审核通过的图片
+struct ListAlbumPictureReq {
+    1: i64 album_id
+    2: i64 current_page
+    3: i64 page_size (api.vd = " $ <=  20")
+}
+
+struct ListAlbumPictureResp {
+    1: i64 total
+    2: list<base.PictureVo> pictures
+    255: base.BaseResp base
+}
+
+// 查询本人相册时包含私密相册
+struct ListAlbumReq {
+    1: optional i64 user_id
+    2: optional string album_name
+    3: i64 current_page
+    4: i64 page_size (api.vd = " $ <=  20")
+}
+
+struct ListAlbumResp {
+    1: i64 total
+    2: list<base.AlbumVo> albums
+    255: base.BaseResp base
+}
+
+// auth
+struct AddAlbumReq {
+    1: string album_name (api.vd = "len($) > 0 && len($) <= 30")
+    2: optional string introduction (api.vd = "$ == null || len($) < 500")
+    3: optional i32 visibility (api.vd = "$ == null || ($ >= 0 && $ <= 1)")
+}
+
+struct AddAlbumResp {
+    1: i64 id
+    255: base.BaseResp base
+}
+
+struct EditAlbumReq {
+    1: i64 id
+    2: optional string album_name (api.vd = "$ == null || (len($) > 0 && len($) <= 30)")
+    3: optional string introduction (api.vd = "$ == null || len($) < 500")
+    4: optional i32 visibility (api.vd = "$ == null || ($ >= 0 && $ <= 1)")
+    // 封面需为相册内的图片, 0 表示使用第一张图片
+    5: optional i64 cover_id
+}
+
+struct EditAlbumResp {
+    1: base.AlbumVo album
+    255: base.BaseResp base
+}
+
+struct DeleteAlbumReq {
+    1: i64 id
+}
+
+struct DeleteAlbumResp {
+    255: base.BaseResp base
+}
+
+// 可添加本人公共图库中的图片或他人已审核通过的图片, 已在相册中的图片会被忽略
+struct AddAlbumPictureReq {
+    1: i64 album_id
+    2: list<i64> picture_ids (api.vd = "len($) > 0 && len($) <= 50")
+}
+
+struct AddAlbumPictureResp {
+    255: base.BaseResp base
+}
+
+struct RemoveAlbumPictureReq {
+    1: i64 album_id
+    2: list<i64> picture_ids (api.vd = "len($) > 0 && len($) <= 50")
+}
+
+struct RemoveAlbumPictureResp {
+    255: base.BaseResp base
+}
+
+// 需包含相册内的全部图片
+struct SortAlbumPictureReq {
+    1: i64 album_id
+    2: list<i64> picture_ids (api.vd = "len($) > 0 && len($) <= 500")
+}
+
+struct SortAlbumPictureResp {
+    255: base.BaseResp base
+}
+
+service AlbumService {
+
+    ## public
+    GetAlbumResp GetAlbum(1: GetAlbumReq req)
+    ListAlbumPictureResp ListAlbumPicture(1: ListAlbumPictureReq req)
+    ListAlbumResp ListAlbum(1: ListAlbumReq req)
+
+    ## auth
+    AddAlbumResp AddAlbum(1: AddAlbumReq req)
+    EditAlbumResp EditAlbum(1: EditAlbumReq req)
+    DeleteAlbumResp DeleteAlbum(1: DeleteAlbumReq req)
+    AddAlbumPictureResp AddAlbumPicture(1: AddAlbumPictureReq req)
+    RemoveAlbumPictureResp RemoveAlbumPicture(1: RemoveAlbumPictureReq req)
+    SortAlbumPictureResp SortAlbumPicture(1: SortAlbumPictureReq req)
+}
@@ -15,6 +15,15 @@ struct User {
     7: string createTime
     8: string updateTime
     9: string isDelete
+    10: UserQuota quota
+}
+
+// 存储配额, max 为 0 表示不限制
+struct UserQuota {
+    1: i64 maxCount
+    2: i64 maxSize
+    3: i64 usedCount
+    4: i64 usedSize
 }
 
 struct UserVo {
@@ -24,6 +33,10 @@ struct UserVo {
     4: string userProfile
     5: string editTime
     6: string createTime
+    7: i64 followerCount
+    8: i64 followingCount
+    // 当前登录用户是否已关注
+    9: bool followed
 }
 
 struct Picture {
@@ -48,6 +61,21 @@ struct Picture {
     19: string reviewMessage
     20: i64 reviewId
     21: string reviewTime
+    22: string picColor
+    23: list<string> picPalette
+    24: string exifMake
+    25: string exifModel
+    26: string exifTakenAt
+    27: i32 exifOrientation
+    28: string thumbnailUrl
+    29: string compressedUrl
+    // 动图帧数与播放时长 (毫秒), 静态图片帧数为 1
+    30: i32 picFrames
+    31: i64 picDuration
+    // 所属空间, 为 0 时属于公共图库
+    32: i64 spaceId
+    33: i64 likeCount
+    34: i64 favoriteCount
 }
 
 struct PictureVo {
@@ -66,4 +94,104 @@ struct PictureVo {
     13: string createTime
     14: i64 userId
     15: UserVo user
-}
\ No newline at end of file
+    16: string picColor
+    17: list<string> picPalette
+    18: string exifMake
+    19: string exifModel
+    20: string exifTakenAt
+    21: string thumbnailUrl
+    22: string compressedUrl
+    23: i32 picFrames
+    24: i64 picDuration
+    25: i64 spaceId
+    26: i64 likeCount
+    27: i64 favoriteCount
+    // 当前用户是否已点赞与收藏, 未登录时为 false
+    28: bool liked
+    29: bool favorited
+}
+
+struct SpaceVo {
+    1: i64 id
+    2: string spaceName
+    3: i32 spaceLevel
+    // 上限为 0 表示不限制
+    4: i64 maxCount
+    5: i64 maxSize
+    6: i64 totalCount
+    7: i64 totalSize
+    8: i64 userId
+    9: UserVo user
+    10: string editTime
+    11: string createTime
+    // 0 私有空间, 1 团队空间
+    12: i32 spaceType
+    // 登录用户在空间中的角色
+    13: string spaceRole
+}
+
+struct SpaceUserVo {
+    1: i64 id
+    2: i64 spaceId
+    3: i64 userId
+    4: string spaceRole
+    5: UserVo user
+    6: string createTime
+}
+
+struct SpaceInviteVo {
+    1: i64 id
+    2: i64 spaceId
+    3: SpaceVo space
+    // 受邀用户
+    4: i64 userId
+    5: i64 inviterId
+    6: UserVo inviter
+    7: string spaceRole
+    // 0 待接受, 1 已接受, 2 已拒绝
+    8: i32 status
+    9: string createTime
+}
+
+struct SpaceLevel {
+    1: i32 value
+    2: string text
+    3: i64 maxCount
+    4: i64 maxSize
+}
+struct AlbumVo {
+    1: i64 id
+    2: string albumName
+    3: string introduction
+    // 0 表示使用第一张图片作为封面
+    4: i64 coverId
+    5: PictureVo cover
+    // 0 私密, 1 公开
+    6: i32 visibility
+    // 当前用户可见的图片数量
+    7: i64 pictureCount
+    8: i64 userId
+    9: UserVo user
+    10: string editTime
+    11: string createTime
+}
+
+struct CommentVo {
+    1: i64 id
+    2: i64 pictureId
+    3: i64 userId
+    4: UserVo user
+    // 0 表示一级评论
+    5: i64 parentId
+    6: i64 replyUserId
+    7: UserVo replyUser
+    8: string content
+    // 待审核的评论仅作者可见
+    9: string reviewStatus
+    10: bool isHidden
+    // 一级评论的可见回复数量与前几条回复
+    11: i64 replyCount
+    12: list<CommentVo> replies
+    13: string editTime
+    14: string createTime
+}
@@ -0,0 +1,112 @@
+namespace go clide.comment
+
+include "base.thrift"
+
+// public
+struct ListCommentReq {
+    1: i64 picture_id
+    2: i64 current_page
+    3: i64 page_size (api.vd = " $ <=  20")
+}
+
+struct ListCommentResp {
+    1: i64 total
+    2: list<base.CommentVo> comments
+    255: base.BaseResp base
+}
+
+struct ListCommentReplyReq {
+    1: i64 comment_id
+    2: i64 current_page
+    3: i64 page_size (api.vd = " $ <=  20")
+}
+
+struct ListCommentReplyResp {
+    1: i64 total
+    2: list<base.CommentVo> replies
+    255: base.BaseResp base
+}
+
+// auth
+// 回复二级评论时归入其所属的一级评论
+struct AddCommentReq {
+    1: i64 picture_id
+    2: string content (api.vd = "len($) > 0 && len($) <= 1000")
+    3: optional i64 parent_id
+}
+
+struct AddCommentResp {
+    1: i64 id
+    255: base.BaseResp base
+}
+
+struct EditCommentReq {
+    1: i64 id
+    2: string content (api.vd = "len($) > 0 && len($) <= 1000")
+}
+
+struct EditCommentResp {
+    255: base.BaseResp base
+}
+
+// 评论作者、图片作者与管理员可删除, 删除一级评论时一并删除其回复
+struct DeleteCommentReq {
+    1: i64 id
+}
+
+struct DeleteCommentResp {
+    255: base.BaseResp base
+}
+
+// 图片作者与管理员可隐藏
+struct HideCommentReq {
+    1: i64 id
+    2: bool hidden
+}
+
+struct HideCommentResp {
+    255: base.BaseResp base
+}
+
+## admin
+struct QueryCommentReq {
+    1: optional i64 picture_id
+    2: optional i64 user_id
+    3: optional string content
+    4: optional string review_status
+    5: i64 current_page
+    6: i64 page_size
+}
+
+struct QueryCommentResp {
+    1: i64 total
+    2: list<base.CommentVo> comments
+    255: base.BaseResp base
+}
+
+struct ReviewCommentReq {
+    1: i64 id
+    2: string review_status
+    3: string review_message
+}
+
+struct ReviewCommentResp {
+    255: base.BaseResp base
+}
+
+service CommentService {
+
+    ## public
+    ListCommentResp ListComment(1: ListCommentReq req)
+    ListCommentReplyResp ListCommentReply(1: ListCommentReplyReq req)
+
+    ## auth
+    AddCommentResp AddComment(1: AddCommentReq req)
+    EditCommentResp EditComment(1: EditCommentReq req)
+    DeleteCommentResp DeleteComment(1: DeleteCommentReq req)
+    HideCommentResp HideComment(1: HideCommentReq req)
+
+    ## admin
+    QueryCommentResp QueryComment(1: QueryCommentReq req)
+    ReviewCommentResp ReviewComment(1: ReviewCommentReq req)
+}
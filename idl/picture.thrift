@@ -26,6 +26,8 @@ struct PictureSearchReq {
     13: optional i64 user_id
     14: i64 current_page
     15: i64 page_size (api.vd = " $ <=  20")
+    16: optional string pic_color (api.vd = "$ == null || regexp('^#?[0-9a-fA-F]{6}$')")
+    17: optional i32 color_tolerance (api.vd = "$ == null || ($ >= 0 && $ <= 441)")
 }
 
 struct PictureSearchResp {
@@ -43,6 +45,20 @@ struct PictureGetByIdResp {
     255: base.BaseResp base
 }
 
+struct PictureImageReq {
+    1: i64 id (api.path = "id")
+    2: optional i32 width (api.vd = "$ == null || ($ > 0 && $ <= 4096)")
+    3: optional i32 height (api.vd = "$ == null || ($ > 0 && $ <= 4096)")
+    4: optional string fit (api.vd = "$ == null || in($, 'inside', 'cover', 'contain', 'fill')")
+    5: optional i32 quality (api.vd = "$ == null || ($ > 0 && $ <= 100)")
+    6: optional string format (api.vd = "$ == null || in($, 'jpeg', 'png', 'webp')")
+}
+
+// 成功时直接返回图片内容
+struct PictureImageResp {
+    255: base.BaseResp base
+}
+
 struct PictureEditReq {
     1: i64 id
     2: optional string pic_name
@@ -55,10 +71,68 @@ struct PictureEditResp {
     255: base.BaseResp base
 }
 
+// 公共图库只能删除自己创建的, 空间内的图片需空间编辑者及以上角色
+struct PictureDeleteReq {
+    1: i64 id
+}
+
+struct PictureDeleteResp {
+    255: base.BaseResp base
+}
+
+// 已点赞时取消点赞
+struct PictureLikeReq {
+    1: i64 id
+}
+
+struct PictureLikeResp {
+    1: bool liked
+    2: i64 like_count
+    255: base.BaseResp base
+}
+
+// 已收藏时取消收藏
+struct PictureFavoriteReq {
+    1: i64 id
+}
+
+struct PictureFavoriteResp {
+    1: bool favorited
+    2: i64 favorite_count
+    255: base.BaseResp base
+}
+
+struct ListFavoritePictureReq {
+    1: i64 current_page
+    2: i64 page_size (api.vd = " $ <=  20")
+}
+
+struct ListFavoritePictureResp {
+    1: i64 total
+    2: list<base.PictureVo> pictures
+    255: base.BaseResp base
+}
+
+// 游标为上一页返回的 next_cursor, 为空时从最新的图片开始
+struct PictureFeedReq {
+    1: optional string cursor
+    2: i64 page_size (api.vd = " $ <=  20")
+}
+
+struct PictureFeedResp {
+    1: list<base.PictureVo> pictures
+    2: string next_cursor
+    3: bool has_more
+    255: base.BaseResp base
+}
+
 struct UploadPictureReq {
     1: optional i64 id
     2: optional string file_url
     3: optional string pic_name
+    4: optional bool keep_gps
+    // 上传至私有空间, 为空时上传至公共图库
+    5: optional i64 space_id
 }
 
 struct UploadPictureResp {
@@ -66,6 +140,118 @@ struct UploadPictureResp {
     255: base.BaseResp base
 }
 
+struct SearchSimilarPictureReq {
+    1: optional i64 id
+    2: optional i32 max_distance (api.vd = "$ == null || ($ >= 0 && $ <= 64)")
+    3: optional i32 limit (api.vd = "$ == null || ($ > 0 && $ <= 50)")
+}
+
+struct SearchSimilarPictureResp {
+    1: list<base.PictureVo> pictures
+    255: base.BaseResp base
+}
+
+struct InitUploadSessionReq {
+    1: string file_name (api.vd = "len($) > 0")
+    2: i64 file_size (api.vd = "$ > 0")
+    3: optional i64 chunk_size
+    4: optional i64 id
+    5: optional string pic_name
+    6: optional bool keep_gps
+}
+
+struct InitUploadSessionResp {
+    1: i64 upload_id
+    2: i64 chunk_size
+    3: i32 chunk_count
+    4: string expire_time
+    255: base.BaseResp base
+}
+
+struct UploadSessionChunkReq {
+    1: i64 upload_id
+    2: i32 index (api.vd = "$ >= 0")
+}
+
+struct UploadSessionChunkResp {
+    255: base.BaseResp base
+}
+
+struct GetUploadSessionReq {
+    1: i64 upload_id
+}
+
+struct GetUploadSessionResp {
+    1: i64 upload_id
+    2: i64 file_size
+    3: i64 chunk_size
+    4: i32 chunk_count
+    5: list<i32> uploaded_chunks
+    6: string expire_time
+    255: base.BaseResp base
+}
+
+struct CompleteUploadSessionReq {
+    1: i64 upload_id
+}
+
+struct CompleteUploadSessionResp {
+    1: i64 id
+    255: base.BaseResp base
+}
+
+struct AbortUploadSessionReq {
+    1: i64 upload_id
+}
+
+struct AbortUploadSessionResp {
+    255: base.BaseResp base
+}
+
+// 上传内容的长度需与 file_size 一致
+struct PresignUploadReq {
+    1: string file_name (api.vd = "len($) > 0")
+    2: i64 file_size (api.vd = "$ > 0")
+}
+
+struct PresignUploadResp {
+    1: string key
+    2: string upload_url
+    3: string expire_time
+    255: base.BaseResp base
+}
+
+struct ConfirmUploadReq {
+    1: string key (api.vd = "len($) > 0")
+    2: optional i64 id
+    3: optional string pic_name
+    4: optional bool keep_gps
+}
+
+struct ConfirmUploadResp {
+    1: i64 id
+    255: base.BaseResp base
+}
+
+struct UploadPictureByArchiveReq {
+    1: optional bool keep_gps
+    2: optional i64 space_id
+}
+
+struct ArchiveEntryResult {
+    1: string path
+    2: bool success
+    3: i64 id
+    4: string message
+}
+
+struct UploadPictureByArchiveResp {
+    1: list<ArchiveEntryResult> results
+    2: i32 success_count
+    3: i32 fail_count
+    255: base.BaseResp base
+}
+
 ## admin
 struct DeletePictureReq {
     1: i64 id
@@ -106,6 +292,8 @@ struct QueryPictureReq {
     16: optional i64 review_id
     17: i64 current_page
     18: i64 page_size
+    // 为空时查询公共图库
+    19: optional i64 space_id
 } 
 
 struct QueryPictureResp {
@@ -151,10 +339,25 @@ service PictureService {
 
     PictureSearchResp PictureSearch(1: PictureSearchReq req)
     PictureGetByIdResp PictureGetById(1: PictureGetByIdReq req)
+    PictureImageResp PictureImage(1: PictureImageReq req)
 
     ## auth
     PictureEditResp PictureEdit (1: PictureEditReq req)
+    PictureDeleteResp PictureDelete(1: PictureDeleteReq req)
+    PictureLikeResp PictureLike(1: PictureLikeReq req)
+    PictureFavoriteResp PictureFavorite(1: PictureFavoriteReq req)
+    ListFavoritePictureResp ListFavoritePicture(1: ListFavoritePictureReq req)
+    PictureFeedResp PictureFeed(1: PictureFeedReq req)
     UploadPictureResp UploadPicture(1: UploadPictureReq req)
+    SearchSimilarPictureResp SearchSimilarPicture(1: SearchSimilarPictureReq req)
+    InitUploadSessionResp InitUploadSession(1: InitUploadSessionReq req)
+    UploadSessionChunkResp UploadSessionChunk(1: UploadSessionChunkReq req)
+    GetUploadSessionResp GetUploadSession(1: GetUploadSessionReq req)
+    CompleteUploadSessionResp CompleteUploadSession(1: CompleteUploadSessionReq req)
+    AbortUploadSessionResp AbortUploadSession(1: AbortUploadSessionReq req)
+    PresignUploadResp PresignUpload(1: PresignUploadReq req)
+    ConfirmUploadResp ConfirmUpload(1: ConfirmUploadReq req)
+    UploadPictureByArchiveResp UploadPictureByArchive(1: UploadPictureByArchiveReq req)
 
     ## admin
     DeletePictureResp DeletePicture(1: DeletePictureReq req)
@@ -0,0 +1,190 @@
+namespace go clide.space
+
+include "base.thrift"
+
+// auth
+struct AddSpaceReq {
+    1: optional string space_name (api.vd = "$ == null || len($) <= 30")
+    // 普通用户仅能创建普通版空间
+    2: optional i32 space_level (api.vd = "$ == null || ($ >= 0 && $ <= 2)")
+    // 0 私有空间, 1 团队空间, 每种类型每个用户仅能创建一个
+    3: optional i32 space_type (api.vd = "$ == null || ($ >= 0 && $ <= 1)")
+}
+
+struct AddSpaceResp {
+    1: i64 id
+    255: base.BaseResp base
+}
+
+struct GetSpaceReq {
+    // 为空时获取本人创建的指定类型空间
+    1: optional i64 id
+    2: optional i32 space_type (api.vd = "$ == null || ($ >= 0 && $ <= 1)")
+}
+
+struct GetSpaceResp {
+    1: base.SpaceVo space
+    255: base.BaseResp base
+}
+
+struct EditSpaceReq {
+    1: i64 id
+    2: optional string space_name (api.vd = "$ == null || len($) <= 30")
+}
+
+struct EditSpaceResp {
+    1: base.SpaceVo space
+    255: base.BaseResp base
+}
+
+struct ListSpaceLevelReq {}
+
+struct ListSpaceLevelResp {
+    1: list<base.SpaceLevel> levels
+    255: base.BaseResp base
+}
+
+struct SearchSpacePictureReq {
+    1: i64 space_id
+    2: optional string search_text
+    3: optional string category
+    4: optional list<string> tags
+    5: optional string pic_format
+    6: i64 current_page
+    7: i64 page_size (api.vd = " $ <=  20")
+}
+
+struct SearchSpacePictureResp {
+    1: i64 total
+    2: list<base.PictureVo> pictures
+    255: base.BaseResp base
+}
+
+struct ListMySpaceReq {}
+
+struct ListMySpaceResp {
+    1: list<base.SpaceVo> spaces
+    255: base.BaseResp base
+}
+
+// 团队空间成员, 仅空间管理员可管理
+// 添加成员时向用户发出邀请, 用户接受后成为成员
+struct AddSpaceUserReq {
+    1: i64 space_id
+    2: i64 user_id
+    3: optional string space_role (api.vd = "$ == null || in($, 'viewer', 'editor', 'admin')")
+}
+
+struct AddSpaceUserResp {
+    1: base.SpaceInviteVo space_invite
+    255: base.BaseResp base
+}
+
+// 成员可退出空间, 创建者不能被移除
+struct DeleteSpaceUserReq {
+    1: i64 space_id
+    2: i64 user_id
+}
+
+struct DeleteSpaceUserResp {
+    255: base.BaseResp base
+}
+
+struct EditSpaceUserReq {
+    1: i64 space_id
+    2: i64 user_id
+    3: string space_role (api.vd = "in($, 'viewer', 'editor', 'admin')")
+}
+
+struct EditSpaceUserResp {
+    1: base.SpaceUserVo space_user
+    255: base.BaseResp base
+}
+
+struct ListSpaceUserReq {
+    1: i64 space_id
+}
+
+struct ListSpaceUserResp {
+    1: list<base.SpaceUserVo> space_users
+    255: base.BaseResp base
+}
+
+// 空间邀请, 仅受邀用户可处理
+struct ListMySpaceInviteReq {}
+
+struct ListMySpaceInviteResp {
+    1: list<base.SpaceInviteVo> space_invites
+    255: base.BaseResp base
+}
+
+struct AcceptSpaceInviteReq {
+    1: i64 id
+}
+
+struct AcceptSpaceInviteResp {
+    1: base.SpaceUserVo space_user
+    255: base.BaseResp base
+}
+
+struct DeclineSpaceInviteReq {
+    1: i64 id
+}
+
+struct DeclineSpaceInviteResp {
+    255: base.BaseResp base
+}
+
+## admin
+struct UpdateSpaceReq {
+    1: i64 id
+    2: optional string space_name (api.vd = "$ == null || len($) <= 30")
+    // 修改级别时未指定的上限按级别重置
+    3: optional i32 space_level (api.vd = "$ == null || ($ >= 0 && $ <= 2)")
+    // 上限为 0 表示不限制
+    4: optional i64 max_count (api.vd = "$ == null || $ >= 0")
+    5: optional i64 max_size (api.vd = "$ == null || $ >= 0")
+}
+
+struct UpdateSpaceResp {
+    1: base.SpaceVo space
+    255: base.BaseResp base
+}
+
+struct QuerySpaceReq {
+    1: optional i64 id
+    2: optional i64 user_id
+    3: optional string space_name
+    4: optional i32 space_level
+    5: i64 current_page
+    6: i64 page_size
+    7: optional i32 space_type
+}
+
+struct QuerySpaceResp {
+    1: i64 total
+    2: list<base.SpaceVo> spaces
+    255: base.BaseResp base
+}
+
+service SpaceService {
+
+    ## auth
+    AddSpaceResp AddSpace(1: AddSpaceReq req)
+    GetSpaceResp GetSpace(1: GetSpaceReq req)
+    EditSpaceResp EditSpace(1: EditSpaceReq req)
+    ListSpaceLevelResp ListSpaceLevel(1: ListSpaceLevelReq req)
+    SearchSpacePictureResp SearchSpacePicture(1: SearchSpacePictureReq req)
+    ListMySpaceResp ListMySpace(1: ListMySpaceReq req)
+    AddSpaceUserResp AddSpaceUser(1: AddSpaceUserReq req)
+    DeleteSpaceUserResp DeleteSpaceUser(1: DeleteSpaceUserReq req)
+    EditSpaceUserResp EditSpaceUser(1: EditSpaceUserReq req)
+    ListSpaceUserResp ListSpaceUser(1: ListSpaceUserReq req)
+    ListMySpaceInviteResp ListMySpaceInvite(1: ListMySpaceInviteReq req)
+    AcceptSpaceInviteResp AcceptSpaceInvite(1: AcceptSpaceInviteReq req)
+    DeclineSpaceInviteResp DeclineSpaceInvite(1: DeclineSpaceInviteReq req)
+
+    ## admin
+    UpdateSpaceResp UpdateSpace(1: UpdateSpaceReq req)
+    QuerySpaceResp QuerySpace(1: QuerySpaceReq req)
+}
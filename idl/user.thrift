@@ -25,6 +25,7 @@ struct GetLoginUserReq{}
 
 struct GetLoginUserResp {
     1: base.UserVo user
+    2: base.UserQuota quota
     255: base.BaseResp resp
 }
 
@@ -59,6 +60,48 @@ struct UserSearchResp {
     255: base.BaseResp resp
 }
 
+struct FollowUserReq {
+    1: i64 user_id
+}
+
+struct FollowUserResp {
+    1: i64 follower_count
+    255: base.BaseResp resp
+}
+
+struct UnfollowUserReq {
+    1: i64 user_id
+}
+
+struct UnfollowUserResp {
+    1: i64 follower_count
+    255: base.BaseResp resp
+}
+
+struct ListFollowerReq {
+    1: i64 user_id
+    2: i64 current_page
+    3: i64 page_size (api.vd = " $ <=  30")
+}
+
+struct ListFollowerResp {
+    1: list<base.UserVo> users
+    2: i64 total
+    255: base.BaseResp resp
+}
+
+struct ListFollowingReq {
+    1: i64 user_id
+    2: i64 current_page
+    3: i64 page_size (api.vd = " $ <=  30")
+}
+
+struct ListFollowingResp {
+    1: list<base.UserVo> users
+    2: i64 total
+    255: base.BaseResp resp
+}
+
 struct AddUserReq {
     1: string user_account (api.vd = "$ == null || (len($) >= 4 && regexp('^[a-zA-Z0-9]+$'))")
     2: optional string user_avatar
@@ -85,6 +128,9 @@ struct UpdateUserReq {
     3: optional string user_avatar
     4: optional string user_profile
     5: optional string user_role
+    // 配额覆盖, -1 恢复为默认配额, 0 表示不限制
+    6: optional i64 quota_count (api.vd = "$ == null || $ >= -1")
+    7: optional i64 quota_size (api.vd = "$ == null || $ >= -1")
 }
 
 struct UpdateUserResp {
@@ -127,6 +173,10 @@ service UserServices {
     UserLogoutResp LogoutUser(1: UserLogoutReq req)
     UserEditResp UserEdit(1: UserEditReq req)
     UserSearchResp UserSearches(1: UserSearchReq req)
+    FollowUserResp FollowUser(1: FollowUserReq req)
+    UnfollowUserResp UnfollowUser(1: UnfollowUserReq req)
+    ListFollowerResp ListFollower(1: ListFollowerReq req)
+    ListFollowingResp ListFollowing(1: ListFollowingReq req)
 
     ## admin
     AddUserResp AddUser(1: AddUserReq req)
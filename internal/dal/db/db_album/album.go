@@ -0,0 +1,189 @@
+package db_album
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type Album struct {
+	Id           int64  `json:"id"`
+	AlbumName    string `json:"album_name"`
+	Introduction string `json:"introduction"`
+	// 封面图片id, 为 0 时使用第一张图片
+	CoverId    int64     `json:"cover_id"`
+	Visibility int       `json:"visibility"`
+	UserId     int64     `json:"user_id"`
+	EditTime   time.Time `json:"edit_time"`
+	CreateTime time.Time `json:"create_time" gorm:"<-:false"`
+	UpdateTime time.Time `json:"update_time" gorm:"<-:false"`
+	IsDelete   int       `json:"is_delete"`
+}
+
+func (a Album) TableName() string {
+	return constants.AlbumTableName
+}
+
+// CreateAlbum - create album
+// params:
+//   - album
+//     required: albumName, visibility, userId
+//     optional: introduction
+//
+// returns:
+//   - albumId
+//   - error: nil on success, non-nil on failure
+func CreateAlbum(ctx context.Context, album *Album) (int64, error) {
+	id, err := utils.GenerateId()
+	if err != nil {
+		hlog.Errorf("dal - CreateAlbum: generate album id failed, %s\n", err)
+		return 0, err
+	}
+	album.Id = id
+	omitFields := []string{"cover_id", "edit_time", "is_delete"}
+	if album.Introduction == "" {
+		omitFields = append(omitFields, "introduction")
+	}
+	res := db.WithContext(ctx).Omit(omitFields...).Create(album)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - CreateAlbum: create album into db failed, %s\n", err)
+		return 0, err
+	}
+	return id, nil
+}
+
+// UpdateAlbum - update album
+// params:
+//   - album
+//     required: albumId
+//     optional: albumName, introduction, editTime
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func UpdateAlbum(ctx context.Context, album *Album) error {
+	res := db.WithContext(ctx).Model(&Album{}).Where("id = ? and is_delete = 0", album.Id).Updates(album)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateAlbum: update album failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateAlbumSetting - update the visibility and cover of an album, zero values are written as well
+// params:
+//   - required: id
+//   - optional: visibility, coverId, nil keeps the current value, 0 cover means the first picture
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func UpdateAlbumSetting(ctx context.Context, id int64, visibility *int, coverId *int64) error {
+	updates := map[string]interface{}{}
+	if visibility != nil {
+		updates["visibility"] = *visibility
+	}
+	if coverId != nil {
+		updates["cover_id"] = *coverId
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	res := db.WithContext(ctx).Model(&Album{}).Where("id = ? and is_delete = 0", id).Updates(updates)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateAlbumSetting: update album setting failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteAlbum - delete album, pictures in the album are kept
+// params:
+//   - required: id
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func DeleteAlbum(ctx context.Context, id int64) error {
+	res := db.WithContext(ctx).Model(&Album{}).Where("id = ? and is_delete = 0", id).Update("is_delete", 1)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - DeleteAlbum: delete album failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// QueryAlbumById - query album based on given id
+// params:
+//   - albumId
+//
+// returns:
+//   - album
+//   - error: nil on success, non-nil on failure
+func QueryAlbumById(ctx context.Context, id int64) (*Album, error) {
+	album := &Album{}
+	res := db.WithContext(ctx).Where("id = ? and is_delete = 0", id).First(album)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QueryAlbumById: query album by id failed, %s\n", err)
+		return nil, err
+	}
+	return album, nil
+}
+
+// LockAlbumById - lock the album row until the transaction ends, serializing changes of its pictures
+// params:
+//   - ctx: transaction context
+//   - required: id
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func LockAlbumById(ctx context.Context, id int64) error {
+	album := &Album{}
+	res := db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").
+		Where("id = ? and is_delete = 0", id).First(album)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - LockAlbumById: lock album failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// QueryAlbum - query albums based on the given filters, newest first
+// params:
+//   - album
+//     required: visibility, -1 means all
+//     optional: userId, albumName
+//   - currentPage (required)
+//   - pageSize (required)
+//
+// returns:
+//   - total: total number of matched albums
+//   - albums: list of albums matching the criteria
+//   - error: nil on success, non-nil on failure
+func QueryAlbum(ctx context.Context, album *Album, currentPage, pageSize int64) (int64, []*Album, error) {
+	var albums []*Album
+	res := db.WithContext(ctx).Model(&Album{}).Where("is_delete = 0")
+	if album.UserId != 0 {
+		res = res.Where("user_id = ?", album.UserId)
+	}
+	if album.Visibility != -1 {
+		res = res.Where("visibility = ?", album.Visibility)
+	}
+	if album.AlbumName != "" {
+		res = res.Where("album_name like ?", "%"+album.AlbumName+"%")
+	}
+
+	var total int64
+	if err := res.Count(&total).Error; err != nil {
+		hlog.Errorf("dal - QueryAlbum: count match album failed, %s\n", err)
+		return 0, nil, err
+	}
+
+	offset := (currentPage - 1) * pageSize
+	if err := res.Order("create_time desc, id desc").Offset(int(offset)).Limit(int(pageSize)).Find(&albums).Error; err != nil {
+		hlog.Errorf("dal - QueryAlbum: query album failed, %s\n", err)
+		return 0, nil, err
+	}
+	return total, albums, nil
+}
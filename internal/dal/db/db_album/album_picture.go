@@ -0,0 +1,202 @@
+package db_album
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"time"
+)
+
+type AlbumPicture struct {
+	Id        int64 `json:"id"`
+	AlbumId   int64 `json:"album_id"`
+	PictureId int64 `json:"picture_id"`
+	// 越小越靠前
+	SortOrder  int       `json:"sort_order"`
+	CreateTime time.Time `json:"create_time" gorm:"<-:false"`
+}
+
+func (a AlbumPicture) TableName() string {
+	return constants.AlbumPictureTableName
+}
+
+// CreateAlbumPictures - append pictures to the end of an album
+// params:
+//   - ctx: transaction context, the album should be locked
+//   - albumId
+//   - pictureIds: pictures not in the album yet, in the order to append
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func CreateAlbumPictures(ctx context.Context, albumId int64, pictureIds []int64) error {
+	if len(pictureIds) == 0 {
+		return nil
+	}
+	var maxOrder int
+	res := db.WithContext(ctx).Model(&AlbumPicture{}).Select("coalesce(max(sort_order), -1)").
+		Where("album_id = ?", albumId).Scan(&maxOrder)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - CreateAlbumPictures: query max sort order failed, %s\n", err)
+		return err
+	}
+	albumPictures := make([]*AlbumPicture, 0, len(pictureIds))
+	for i, pictureId := range pictureIds {
+		id, err := utils.GenerateId()
+		if err != nil {
+			hlog.Errorf("dal - CreateAlbumPictures: generate album picture id failed, %s\n", err)
+			return err
+		}
+		albumPictures = append(albumPictures, &AlbumPicture{
+			Id:        id,
+			AlbumId:   albumId,
+			PictureId: pictureId,
+			SortOrder: maxOrder + 1 + i,
+		})
+	}
+	if err := db.WithContext(ctx).Create(albumPictures).Error; err != nil {
+		hlog.Errorf("dal - CreateAlbumPictures: create album picture into db failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteAlbumPictures - remove pictures from an album
+// params:
+//   - albumId
+//   - pictureIds
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func DeleteAlbumPictures(ctx context.Context, albumId int64, pictureIds []int64) error {
+	if len(pictureIds) == 0 {
+		return nil
+	}
+	res := db.WithContext(ctx).Where("album_id = ? and picture_id in ?", albumId, pictureIds).Delete(&AlbumPicture{})
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - DeleteAlbumPictures: delete album picture failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateAlbumPictureOrder - set the order of pictures in an album to the order of pictureIds
+// params:
+//   - ctx: transaction context, the album should be locked
+//   - albumId
+//   - pictureIds
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func UpdateAlbumPictureOrder(ctx context.Context, albumId int64, pictureIds []int64) error {
+	for i, pictureId := range pictureIds {
+		res := db.WithContext(ctx).Model(&AlbumPicture{}).Where("album_id = ? and picture_id = ?", albumId, pictureId).
+			Update("sort_order", i)
+		if err := res.Error; err != nil {
+			hlog.Errorf("dal - UpdateAlbumPictureOrder: update sort order failed, %s\n", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// QueryAlbumPictureIds - query ids of all pictures in an album in album order
+// params:
+//   - albumId
+//
+// returns:
+//   - pictureIds
+//   - error: nil on success, non-nil on failure
+func QueryAlbumPictureIds(ctx context.Context, albumId int64) ([]int64, error) {
+	var pictureIds []int64
+	res := db.WithContext(ctx).Model(&AlbumPicture{}).Where("album_id = ?", albumId).
+		Order("sort_order, id").Pluck("picture_id", &pictureIds)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QueryAlbumPictureIds: query album picture failed, %s\n", err)
+		return nil, err
+	}
+	return pictureIds, nil
+}
+
+// QueryVisibleAlbumPictureIds - query ids of pictures in an album visible to the user in album order
+// params:
+//   - albumId
+//   - userId: besides approved pictures, pictures uploaded by the user are visible as well
+//
+// returns:
+//   - pictureIds
+//   - error: nil on success, non-nil on failure
+func QueryVisibleAlbumPictureIds(ctx context.Context, albumId, userId int64) ([]int64, error) {
+	var pictureIds []int64
+	res := visibleAlbumPicture(ctx, albumId, userId).Order("ap.sort_order, ap.id").Pluck("ap.picture_id", &pictureIds)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QueryVisibleAlbumPictureIds: query album picture failed, %s\n", err)
+		return nil, err
+	}
+	return pictureIds, nil
+}
+
+// QueryAlbumPicture - query undeleted pictures of an album in album order
+// params:
+//   - albumId
+//   - userId: besides approved pictures, pictures uploaded by the user are returned as well, 0 for anonymous
+//   - currentPage (required)
+//   - pageSize (required)
+//
+// returns:
+//   - total: total number of visible pictures
+//   - pictures
+//   - error: nil on success, non-nil on failure
+func QueryAlbumPicture(ctx context.Context, albumId, userId int64, currentPage, pageSize int64) (int64, []*db_picture.Picture, error) {
+	var pictures []*db_picture.Picture
+	// count 会改写查询的 select, 统计与查询分别构造
+	query := func() *gorm.DB {
+		return visibleAlbumPicture(ctx, albumId, userId)
+	}
+
+	var total int64
+	if err := query().Count(&total).Error; err != nil {
+		hlog.Errorf("dal - QueryAlbumPicture: count album picture failed, %s\n", err)
+		return 0, nil, err
+	}
+
+	offset := (currentPage - 1) * pageSize
+	if err := query().Select(constants.PictureTableName + ".*").Order("ap.sort_order, ap.id").
+		Offset(int(offset)).Limit(int(pageSize)).Find(&pictures).Error; err != nil {
+		hlog.Errorf("dal - QueryAlbumPicture: query album picture failed, %s\n", err)
+		return 0, nil, err
+	}
+	return total, pictures, nil
+}
+
+// DeletePictureFromAlbums - remove a deleted picture from all albums, albums using it as cover fall back to the first picture
+// params:
+//   - pictureId
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func DeletePictureFromAlbums(ctx context.Context, pictureId int64) error {
+	res := db.WithContext(ctx).Where("picture_id = ?", pictureId).Delete(&AlbumPicture{})
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - DeletePictureFromAlbums: delete album picture failed, %s\n", err)
+		return err
+	}
+	res = db.WithContext(ctx).Model(&Album{}).Where("cover_id = ?", pictureId).Update("cover_id", 0)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - DeletePictureFromAlbums: reset album cover failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// visibleAlbumPicture - undeleted pictures of an album, approved or uploaded by the user
+func visibleAlbumPicture(ctx context.Context, albumId, userId int64) *gorm.DB {
+	return db.WithContext(ctx).Model(&db_picture.Picture{}).
+		Joins("join "+constants.AlbumPictureTableName+" ap on ap.picture_id = "+constants.PictureTableName+".id").
+		Where("ap.album_id = ? and "+constants.PictureTableName+".is_delete = 0", albumId).
+		Where(constants.PictureTableName+".review_status = ? or "+constants.PictureTableName+".user_id = ?",
+			constants.ReviewPictureMap["通过"], userId)
+}
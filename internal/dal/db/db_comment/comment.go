@@ -0,0 +1,255 @@
+package db_comment
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"time"
+)
+
+type Comment struct {
+	Id        int64 `json:"id"`
+	PictureId int64 `json:"picture_id"`
+	UserId    int64 `json:"user_id"`
+	// 所属一级评论, 为 0 时为一级评论
+	ParentId      int64     `json:"parent_id"`
+	ReplyUserId   int64     `json:"reply_user_id"`
+	Content       string    `json:"content"`
+	ReviewStatus  int       `json:"review_status"`
+	ReviewMessage string    `json:"review_message"`
+	ReviewId      int64     `json:"review_id"`
+	ReviewTime    time.Time `json:"review_time"`
+	IsHidden      int       `json:"is_hidden"`
+	EditTime      time.Time `json:"edit_time"`
+	CreateTime    time.Time `json:"create_time" gorm:"<-:false"`
+	UpdateTime    time.Time `json:"update_time" gorm:"<-:false"`
+	IsDelete      int       `json:"is_delete"`
+}
+
+func (c Comment) TableName() string {
+	return constants.CommentTableName
+}
+
+// CreateComment - create comment
+// params:
+//   - comment
+//     required: pictureId, userId, content, reviewStatus
+//     optional: parentId, replyUserId, reviewMessage, reviewId, reviewTime
+//
+// returns:
+//   - commentId
+//   - error: nil on success, non-nil on failure
+func CreateComment(ctx context.Context, comment *Comment) (int64, error) {
+	id, err := utils.GenerateId()
+	if err != nil {
+		hlog.Errorf("dal - CreateComment: generate comment id failed, %s\n", err)
+		return 0, err
+	}
+	comment.Id = id
+	omitFields := []string{"is_hidden", "edit_time", "is_delete"}
+	if comment.ReviewMessage == "" {
+		omitFields = append(omitFields, "review_message")
+	}
+	if comment.ReviewId == 0 {
+		omitFields = append(omitFields, "review_id")
+	}
+	if comment.ReviewTime.IsZero() {
+		omitFields = append(omitFields, "review_time")
+	}
+	res := db.WithContext(ctx).Omit(omitFields...).Create(comment)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - CreateComment: create comment into db failed, %s\n", err)
+		return 0, err
+	}
+	return id, nil
+}
+
+// UpdateCommentContent - update the content of a comment together with its review result
+// params:
+//   - comment
+//     required: commentId, content, editTime, reviewStatus
+//     optional: reviewMessage, reviewId, reviewTime, zero values are cleared
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func UpdateCommentContent(ctx context.Context, comment *Comment) error {
+	updates := reviewFields(comment)
+	updates["content"] = comment.Content
+	updates["edit_time"] = comment.EditTime
+	res := db.WithContext(ctx).Model(&Comment{}).Where("id = ? and is_delete = 0", comment.Id).Updates(updates)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateCommentContent: update comment failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateCommentReview - update the review result of a comment
+// params:
+//   - comment
+//     required: commentId, reviewStatus, reviewMessage, reviewId, reviewTime
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func UpdateCommentReview(ctx context.Context, comment *Comment) error {
+	res := db.WithContext(ctx).Model(&Comment{}).Where("id = ? and is_delete = 0", comment.Id).Updates(reviewFields(comment))
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateCommentReview: update comment review failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// reviewFields - 审核字段, 零值写入为 NULL, 审核状态为 0 时同样写入
+func reviewFields(comment *Comment) map[string]interface{} {
+	updates := map[string]interface{}{
+		"review_status":  comment.ReviewStatus,
+		"review_message": nil,
+		"review_id":      nil,
+		"review_time":    nil,
+	}
+	if comment.ReviewMessage != "" {
+		updates["review_message"] = comment.ReviewMessage
+	}
+	if comment.ReviewId != 0 {
+		updates["review_id"] = comment.ReviewId
+	}
+	if !comment.ReviewTime.IsZero() {
+		updates["review_time"] = comment.ReviewTime
+	}
+	return updates
+}
+
+// UpdateCommentHidden - hide or show a comment
+// params:
+//   - id
+//   - isHidden: 1 hidden, 0 shown
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func UpdateCommentHidden(ctx context.Context, id int64, isHidden int) error {
+	res := db.WithContext(ctx).Model(&Comment{}).Where("id = ? and is_delete = 0", id).Update("is_hidden", isHidden)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateCommentHidden: update comment hidden failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteComment - delete a comment, replies of a top-level comment are deleted as well
+// params:
+//   - id
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func DeleteComment(ctx context.Context, id int64) error {
+	res := db.WithContext(ctx).Model(&Comment{}).Where("(id = ? or parent_id = ?) and is_delete = 0", id, id).Update("is_delete", 1)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - DeleteComment: delete comment failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// QueryCommentById - query comment based on given id
+// params:
+//   - commentId
+//
+// returns:
+//   - comment
+//   - error: nil on success, non-nil on failure
+func QueryCommentById(ctx context.Context, id int64) (*Comment, error) {
+	comment := &Comment{}
+	res := db.WithContext(ctx).Where("id = ? and is_delete = 0", id).First(comment)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QueryCommentById: query comment by id failed, %s\n", err)
+		return nil, err
+	}
+	return comment, nil
+}
+
+// QueryPictureComment - query comments of a picture visible to the viewer
+// top-level comments are ordered newest first, replies oldest first
+// params:
+//   - pictureId
+//   - parentId: 0 for top-level comments, otherwise replies of the comment
+//   - viewerId: besides approved comments, comments of the viewer are returned as well, 0 for anonymous
+//   - showHidden: return hidden comments, for moderators
+//   - currentPage (required)
+//   - pageSize (required)
+//
+// returns:
+//   - total: total number of visible comments
+//   - comments
+//   - error: nil on success, non-nil on failure
+func QueryPictureComment(ctx context.Context, pictureId, parentId, viewerId int64, showHidden bool, currentPage, pageSize int64) (int64, []*Comment, error) {
+	var comments []*Comment
+	res := db.WithContext(ctx).Model(&Comment{}).
+		Where("picture_id = ? and parent_id = ? and is_delete = 0", pictureId, parentId)
+	if showHidden {
+		res = res.Where("review_status = ? or user_id = ?", constants.ReviewPictureMap["通过"], viewerId)
+	} else {
+		res = res.Where("(review_status = ? and is_hidden = 0) or user_id = ?", constants.ReviewPictureMap["通过"], viewerId)
+	}
+
+	var total int64
+	if err := res.Count(&total).Error; err != nil {
+		hlog.Errorf("dal - QueryPictureComment: count comment failed, %s\n", err)
+		return 0, nil, err
+	}
+
+	order := "create_time, id"
+	if parentId == 0 {
+		order = "create_time desc, id desc"
+	}
+	offset := (currentPage - 1) * pageSize
+	if err := res.Order(order).Offset(int(offset)).Limit(int(pageSize)).Find(&comments).Error; err != nil {
+		hlog.Errorf("dal - QueryPictureComment: query comment failed, %s\n", err)
+		return 0, nil, err
+	}
+	return total, comments, nil
+}
+
+// QueryComment - query comments based on the given filters, newest first
+// params:
+//   - comment
+//     required: reviewStatus, -1 means all
+//     optional: pictureId, userId, content
+//   - currentPage (required)
+//   - pageSize (required)
+//
+// returns:
+//   - total: total number of matched comments
+//   - comments
+//   - error: nil on success, non-nil on failure
+func QueryComment(ctx context.Context, comment *Comment, currentPage, pageSize int64) (int64, []*Comment, error) {
+	var comments []*Comment
+	res := db.WithContext(ctx).Model(&Comment{}).Where("is_delete = 0")
+	if comment.PictureId != 0 {
+		res = res.Where("picture_id = ?", comment.PictureId)
+	}
+	if comment.UserId != 0 {
+		res = res.Where("user_id = ?", comment.UserId)
+	}
+	if comment.ReviewStatus != -1 {
+		res = res.Where("review_status = ?", comment.ReviewStatus)
+	}
+	if comment.Content != "" {
+		res = res.Where("content like ?", "%"+comment.Content+"%")
+	}
+
+	var total int64
+	if err := res.Count(&total).Error; err != nil {
+		hlog.Errorf("dal - QueryComment: count match comment failed, %s\n", err)
+		return 0, nil, err
+	}
+
+	offset := (currentPage - 1) * pageSize
+	if err := res.Order("create_time desc, id desc").Offset(int(offset)).Limit(int(pageSize)).Find(&comments).Error; err != nil {
+		hlog.Errorf("dal - QueryComment: query comment failed, %s\n", err)
+		return 0, nil, err
+	}
+	return total, comments, nil
+}
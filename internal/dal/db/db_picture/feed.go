@@ -0,0 +1,34 @@
+package db_picture
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"time"
+)
+
+// QueryFeedPicture - query approved public pictures of the users followed by the user, latest reviewed first
+// params:
+//   - userId
+//   - cursorTime, cursorId: review time and id of the last picture of the previous page, cursorId 0 starts from the latest
+//   - limit (required)
+//
+// returns:
+//   - pictures
+//   - error: nil on success, non-nil on failure
+func QueryFeedPicture(ctx context.Context, userId int64, cursorTime time.Time, cursorId int64, limit int) ([]*Picture, error) {
+	var pictures []*Picture
+	res := db.WithContext(ctx).Model(&Picture{}).
+		Where("user_id in (select follow_user_id from "+constants.FollowTableName+" where user_id = ?)", userId).
+		Where("space_id is null and review_status = ? and is_delete = 0", constants.ReviewPictureMap["通过"])
+	if cursorId != 0 {
+		// 审核时间相同时按 id 继续翻页
+		res = res.Where("review_time < ? or (review_time = ? and id < ?)", cursorTime, cursorTime, cursorId)
+	}
+	if err := res.Order("review_time desc, id desc").Limit(limit).Find(&pictures).Error; err != nil {
+		hlog.Errorf("dal - QueryFeedPicture: query feed picture failed, %s\n", err)
+		return nil, err
+	}
+	return pictures, nil
+}
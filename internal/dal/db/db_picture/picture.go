@@ -2,45 +2,78 @@ package db_picture
 
 import (
 	"context"
+	"errors"
 	"github.com/Alf-Grindel/clide/internal/dal/db"
 	"github.com/Alf-Grindel/clide/pkg/constants"
 	"github.com/Alf-Grindel/clide/pkg/utils"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 )
 
 type Picture struct {
-	Id            int64     `json:"id"`
-	Url           string    `json:"url"`
-	PicName       string    `json:"pic_name"`
-	Introduction  string    `json:"introduction"`
-	Category      string    `json:"category"`
-	Tags          string    `json:"tags"`
-	PicSize       int64     `json:"pic_size"`
-	PicWidth      int32     `json:"pic_width"`
-	PicHeight     int32     `json:"pic_height"`
-	PicScale      float64   `json:"pic_scale"`
-	PicFormat     string    `json:"pic_format"`
-	UserId        int64     `json:"user_id"`
-	EditTime      time.Time `json:"edit_time"`
-	CreateTime    time.Time `json:"create_time" gorm:"<-:false"`
-	UpdateTime    time.Time `json:"update_time" gorm:"<-:false"`
-	IsDelete      int       `json:"is_delete"`
-	ReviewStatus  int       `json:"review_status"`
-	ReviewMessage string    `json:"review_message"`
-	ReviewId      int64     `json:"review_id"`
-	ReviewTime    time.Time `json:"review_time"`
+	Id              int64     `json:"id"`
+	Url             string    `json:"url"`
+	StorageKey      string    `json:"storage_key"`
+	ThumbnailKey    string    `json:"thumbnail_key"`
+	CompressedKey   string    `json:"compressed_key"`
+	PicName         string    `json:"pic_name"`
+	Introduction    string    `json:"introduction"`
+	Category        string    `json:"category"`
+	Tags            string    `json:"tags"`
+	PicSize         int64     `json:"pic_size"`
+	PicWidth        int32     `json:"pic_width"`
+	PicHeight       int32     `json:"pic_height"`
+	PicScale        float64   `json:"pic_scale"`
+	PicFormat       string    `json:"pic_format"`
+	PicHash         string    `json:"pic_hash"`
+	PicPhash        *uint64   `json:"pic_phash"`
+	PicColor        string    `json:"pic_color"`
+	PicPalette      string    `json:"pic_palette"`
+	ExifMake        string    `json:"exif_make"`
+	ExifModel       string    `json:"exif_model"`
+	ExifTakenAt     time.Time `json:"exif_taken_at"`
+	ExifOrientation int       `json:"exif_orientation"`
+	UserId          int64     `json:"user_id"`
+	EditTime        time.Time `json:"edit_time"`
+	CreateTime      time.Time `json:"create_time" gorm:"<-:false"`
+	UpdateTime      time.Time `json:"update_time" gorm:"<-:false"`
+	IsDelete        int       `json:"is_delete"`
+	ReviewStatus    int       `json:"review_status"`
+	ReviewMessage   string    `json:"review_message"`
+	ReviewId        int64     `json:"review_id"`
+	ReviewTime      time.Time `json:"review_time"`
+	// 动图帧数与播放时长 (毫秒)
+	PicFrames   int   `json:"pic_frames"`
+	PicDuration int64 `json:"pic_duration"`
+	// 所属空间, 为 0 时属于公共图库
+	SpaceId int64 `json:"space_id"`
+	// 点赞与收藏数, 仅随点赞与收藏关系变化
+	LikeCount     int64 `json:"like_count"`
+	FavoriteCount int64 `json:"favorite_count"`
 }
 
 func (p Picture) TableName() string {
 	return constants.PictureTableName
 }
 
+type ColorFilter struct {
+	R, G, B   int
+	Tolerance int
+}
+
+// colorDistanceSql - 主色调与给定颜色在 RGB 空间中距离的平方
+const colorDistanceSql = "(pow(conv(substr(pic_color, 2, 2), 16, 10) - ?, 2) + " +
+	"pow(conv(substr(pic_color, 4, 2), 16, 10) - ?, 2) + " +
+	"pow(conv(substr(pic_color, 6, 2), 16, 10) - ?, 2))"
+
 // CreatePicture - create picture
 // params:
 //   - picture:
 //     required: url, picName, picSize, picWidth, picHeight, picScale, picFormat, userId
-//     optional: introduction, category, tags
+//     optional: storageKey, thumbnailKey, compressedKey, picHash, picPhash, picColor, picPalette, exifMake, exifModel, exifTakenAt, exifOrientation
+//     optional: picFrames, picDuration, introduction, category, tags, spaceId
 //
 // returns:
 //   - pictureId
@@ -52,7 +85,40 @@ func CreatePicture(ctx context.Context, picture *Picture) (int64, error) {
 		return 0, err
 	}
 	picture.Id = id
-	omitFields := []string{"edit_time", "is_delete"}
+	omitFields := []string{"edit_time", "is_delete", "like_count", "favorite_count"}
+	if picture.StorageKey == "" {
+		omitFields = append(omitFields, "storage_key")
+	}
+	if picture.ThumbnailKey == "" {
+		omitFields = append(omitFields, "thumbnail_key")
+	}
+	if picture.CompressedKey == "" {
+		omitFields = append(omitFields, "compressed_key")
+	}
+	if picture.PicHash == "" {
+		omitFields = append(omitFields, "pic_hash")
+	}
+	if picture.PicPhash == nil {
+		omitFields = append(omitFields, "pic_phash")
+	}
+	if picture.PicColor == "" {
+		omitFields = append(omitFields, "pic_color")
+	}
+	if picture.PicPalette == "" {
+		omitFields = append(omitFields, "pic_palette")
+	}
+	if picture.ExifMake == "" {
+		omitFields = append(omitFields, "exif_make")
+	}
+	if picture.ExifModel == "" {
+		omitFields = append(omitFields, "exif_model")
+	}
+	if picture.ExifTakenAt.IsZero() {
+		omitFields = append(omitFields, "exif_taken_at")
+	}
+	if picture.ExifOrientation == 0 {
+		omitFields = append(omitFields, "exif_orientation")
+	}
 	if picture.Introduction == "" {
 		omitFields = append(omitFields, "introduction")
 	}
@@ -71,7 +137,16 @@ func CreatePicture(ctx context.Context, picture *Picture) (int64, error) {
 	if picture.ReviewTime.IsZero() {
 		omitFields = append(omitFields, "review_time")
 	}
-	res := db.DB.WithContext(ctx).Omit(omitFields...).Create(&picture)
+	if picture.PicFrames == 0 {
+		omitFields = append(omitFields, "pic_frames")
+	}
+	if picture.PicDuration == 0 {
+		omitFields = append(omitFields, "pic_duration")
+	}
+	if picture.SpaceId == 0 {
+		omitFields = append(omitFields, "space_id")
+	}
+	res := db.WithContext(ctx).Omit(omitFields...).Create(&picture)
 	if err := res.Error; err != nil {
 		hlog.Errorf("dal - CreatePicture: create picture into db failed, %s\n", err)
 		return 0, err
@@ -86,7 +161,7 @@ func CreatePicture(ctx context.Context, picture *Picture) (int64, error) {
 // returns:
 //   - error: nil on success, non-nil on failure
 func DeletePicture(ctx context.Context, id int64) error {
-	res := db.DB.Model(&Picture{}).WithContext(ctx).Where("id = ? and is_delete = 0", id).Update("is_delete", 1)
+	res := db.WithContext(ctx).Model(&Picture{}).Where("id = ? and is_delete = 0", id).Update("is_delete", 1)
 	if err := res.Error; err != nil {
 		hlog.Errorf("dal - DeletePicture: delete picture failed, %s\n", err)
 		return err
@@ -98,7 +173,8 @@ func DeletePicture(ctx context.Context, id int64) error {
 // params:
 //   - picture
 //     required: pictureId
-//     optional: url, picName, picSize, picWidth, picHeight, picScale, picFormat, userId, introduction, category, tags
+//     optional: url, storageKey, picHash, picPhash, picColor, picPalette, exifMake, exifModel, exifTakenAt, exifOrientation
+//     optional: picName, picSize, picWidth, picHeight, picScale, picFormat, userId, introduction, category, tags
 //
 // returns:
 //   - error: nil on success, non-nil on failure
@@ -111,6 +187,54 @@ func UpdatePicture(ctx context.Context, picture *Picture) error {
 	return nil
 }
 
+// ReplacePictureFile - replace the file of a picture, file fields missing from the new file are cleared
+// params:
+//   - picture
+//     required: pictureId, url, storageKey, picSize, picWidth, picHeight, picScale, picFormat
+//     optional: other fields are updated when non-zero
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func ReplacePictureFile(ctx context.Context, picture *Picture) error {
+	fileFields := map[string]interface{}{
+		"url":              picture.Url,
+		"storage_key":      nullable(picture.StorageKey),
+		"thumbnail_key":    nullable(picture.ThumbnailKey),
+		"compressed_key":   nullable(picture.CompressedKey),
+		"pic_hash":         nullable(picture.PicHash),
+		"pic_phash":        picture.PicPhash,
+		"pic_color":        nullable(picture.PicColor),
+		"pic_palette":      nullable(picture.PicPalette),
+		"exif_make":        nullable(picture.ExifMake),
+		"exif_model":       nullable(picture.ExifModel),
+		"exif_taken_at":    nullable(picture.ExifTakenAt),
+		"exif_orientation": nullable(picture.ExifOrientation),
+		"pic_frames":       nullable(picture.PicFrames),
+		"pic_duration":     nullable(picture.PicDuration),
+	}
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Picture{}).Where("id = ? and is_delete = 0", picture.Id).Updates(fileFields)
+		if err := res.Error; err != nil {
+			return err
+		}
+		return tx.Model(&Picture{}).Where("id = ? and is_delete = 0", picture.Id).Updates(&picture).Error
+	})
+	if err != nil {
+		hlog.Errorf("dal - ReplacePictureFile: replace picture file failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// nullable - 零值写入为 NULL
+func nullable[T comparable](v T) interface{} {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return v
+}
+
 // QueryPictureById - query picture based on given id
 // params:
 //   - pictureId
@@ -134,8 +258,10 @@ func QueryPictureById(ctx context.Context, id int64) (*Picture, error) {
 //     required: reviewStatus
 //     optional: id, picName, introduction, category, picSize, picWidth, PicHeight, picScale, picFormat, userId,
 //     optional: reviewMessage, reviewId
+//     optional: spaceId, 0 means the public gallery
 //   - searchText: match picName or introduction (optional)
 //   - tags: tags list (must all match) optional
+//   - color: order by similarity to the color and drop those beyond tolerance (optional)
 //   - currentPage (required)
 //   - pageSize (required)
 //
@@ -143,7 +269,7 @@ func QueryPictureById(ctx context.Context, id int64) (*Picture, error) {
 //   - total: total number of matched picture
 //   - pictures: list of picture matching the criteria
 //   - error: nil on success, non-nil on failure
-func QueryPicture(ctx context.Context, picture *Picture, searchText string, tags []string, currentPage, pageSize int64) (int64, []*Picture, error) {
+func QueryPicture(ctx context.Context, picture *Picture, searchText string, tags []string, color *ColorFilter, currentPage, pageSize int64) (int64, []*Picture, error) {
 	var pictures []*Picture
 	res := db.DB.WithContext(ctx).Model(&Picture{}).Where("is_delete = 0 ")
 	if picture.Id != 0 {
@@ -170,6 +296,11 @@ func QueryPicture(ctx context.Context, picture *Picture, searchText string, tags
 	if picture.ReviewStatus != -1 {
 		res = res.Where("review_status = ?", picture.ReviewStatus)
 	}
+	if picture.SpaceId != 0 {
+		res = res.Where("space_id = ?", picture.SpaceId)
+	} else {
+		res = res.Where("space_id is null")
+	}
 
 	if picture.PicName != "" {
 		res = res.Where("pic_name like ?", "%"+picture.PicName+"%")
@@ -196,6 +327,13 @@ func QueryPicture(ctx context.Context, picture *Picture, searchText string, tags
 		}
 	}
 
+	if color != nil {
+		distance := clause.Expr{SQL: colorDistanceSql, Vars: []interface{}{color.R, color.G, color.B}}
+		res = res.Where("pic_color is not null").
+			Where("? <= ?", distance, color.Tolerance*color.Tolerance).
+			Order(clause.OrderBy{Expression: distance})
+	}
+
 	var total int64
 	if err := res.Count(&total).Error; err != nil {
 		hlog.Errorf("dal - QueryPicture: count match picture failed, %s\n", err)
@@ -209,3 +347,79 @@ func QueryPicture(ctx context.Context, picture *Picture, searchText string, tags
 	}
 	return total, pictures, nil
 }
+
+// CountPictureByStorageKey - count undeleted picture referencing the given storage key as original or variant
+// params:
+//   - storageKey
+//
+// returns:
+//   - total
+//   - error: nil on success, non-nil on failure
+func CountPictureByStorageKey(ctx context.Context, storageKey string) (int64, error) {
+	var total int64
+	res := db.DB.WithContext(ctx).Model(&Picture{}).
+		Where("is_delete = 0 and (storage_key = ? or thumbnail_key = ? or compressed_key = ?)", storageKey, storageKey, storageKey).
+		Count(&total)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - CountPictureByStorageKey: count picture failed, %s\n", err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// QueryPictureByHash - query picture based on given content hash
+// params:
+//   - picHash
+//   - userId: limit to pictures of this user, 0 means all users
+//   - spaceId: limit to pictures of this space, 0 means the public gallery
+//
+// returns:
+//   - picture
+//   - error: nil on success, non-nil on failure
+func QueryPictureByHash(ctx context.Context, picHash string, userId, spaceId int64) (*Picture, error) {
+	picture := &Picture{}
+	res := db.DB.WithContext(ctx).Where("pic_hash = ? and is_delete = 0", picHash)
+	if spaceId != 0 {
+		res = res.Where("space_id = ?", spaceId)
+	} else {
+		res = res.Where("space_id is null")
+	}
+	if userId != 0 {
+		res = res.Where("user_id = ?", userId)
+	}
+	if err := res.First(&picture).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			hlog.Errorf("dal - QueryPictureByHash: query picture by hash failed, %s\n", err)
+		}
+		return nil, err
+	}
+	return picture, nil
+}
+
+// QuerySimilarPicture - query approved pictures of the public gallery ordered by hamming distance of perceptual hash
+// params:
+//   - picPhash
+//   - excludeId: picture id excluded from result, 0 means none
+//   - maxDistance: max hamming distance
+//   - limit
+//
+// returns:
+//   - pictures
+//   - error: nil on success, non-nil on failure
+func QuerySimilarPicture(ctx context.Context, picPhash uint64, excludeId int64, maxDistance, limit int) ([]*Picture, error) {
+	var pictures []*Picture
+	res := db.DB.WithContext(ctx).Model(&Picture{}).
+		Where("is_delete = 0 and review_status = ? and pic_phash is not null and space_id is null", constants.ReviewPictureMap["通过"]).
+		Where("bit_count(pic_phash ^ ?) <= ?", picPhash, maxDistance)
+	if excludeId != 0 {
+		res = res.Where("id != ?", excludeId)
+	}
+	res = res.Order(clause.OrderBy{
+		Expression: clause.Expr{SQL: "bit_count(pic_phash ^ ?), id", Vars: []interface{}{picPhash}},
+	})
+	if err := res.Limit(limit).Find(&pictures).Error; err != nil {
+		hlog.Errorf("dal - QuerySimilarPicture: query similar picture failed, %s\n", err)
+		return nil, err
+	}
+	return pictures, nil
+}
@@ -0,0 +1,80 @@
+package db_picture
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"time"
+)
+
+type StoragePurge struct {
+	Id         int64     `json:"id"`
+	StorageKey string    `json:"storage_key"`
+	PurgeTime  time.Time `json:"purge_time"`
+	CreateTime time.Time `json:"create_time" gorm:"<-:false"`
+}
+
+func (p StoragePurge) TableName() string {
+	return constants.PurgeTableName
+}
+
+// CreateStoragePurge - schedule a storage object to be purged
+// params:
+//   - storageKey
+//   - purgeTime: the object is kept until this time
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func CreateStoragePurge(ctx context.Context, storageKey string, purgeTime time.Time) error {
+	id, err := utils.GenerateId()
+	if err != nil {
+		hlog.Errorf("dal - CreateStoragePurge: generate purge id failed, %s\n", err)
+		return err
+	}
+	purge := &StoragePurge{
+		Id:         id,
+		StorageKey: storageKey,
+		PurgeTime:  purgeTime,
+	}
+	res := db.DB.WithContext(ctx).Create(purge)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - CreateStoragePurge: create purge into db failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// QueryDueStoragePurge - query purges whose purge time has passed
+// params:
+//   - now
+//   - limit
+//
+// returns:
+//   - purges
+//   - error: nil on success, non-nil on failure
+func QueryDueStoragePurge(ctx context.Context, now time.Time, limit int) ([]*StoragePurge, error) {
+	var purges []*StoragePurge
+	res := db.DB.WithContext(ctx).Where("purge_time <= ?", now).Order("purge_time").Limit(limit).Find(&purges)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QueryDueStoragePurge: query purge failed, %s\n", err)
+		return nil, err
+	}
+	return purges, nil
+}
+
+// DeleteStoragePurge - remove a finished purge
+// params:
+//   - purgeId
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func DeleteStoragePurge(ctx context.Context, id int64) error {
+	res := db.DB.WithContext(ctx).Where("id = ?", id).Delete(&StoragePurge{})
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - DeleteStoragePurge: delete purge failed, %s\n", err)
+		return err
+	}
+	return nil
+}
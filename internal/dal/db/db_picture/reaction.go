@@ -0,0 +1,179 @@
+package db_picture
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"time"
+)
+
+type PictureLike struct {
+	Id         int64     `json:"id"`
+	PictureId  int64     `json:"picture_id"`
+	UserId     int64     `json:"user_id"`
+	CreateTime time.Time `json:"create_time" gorm:"<-:false"`
+}
+
+func (p PictureLike) TableName() string {
+	return constants.LikeTableName
+}
+
+type PictureFavorite struct {
+	Id         int64     `json:"id"`
+	PictureId  int64     `json:"picture_id"`
+	UserId     int64     `json:"user_id"`
+	CreateTime time.Time `json:"create_time" gorm:"<-:false"`
+}
+
+func (p PictureFavorite) TableName() string {
+	return constants.FavoriteTableName
+}
+
+// ToggleLike - like the picture, or cancel the like when it is already liked
+// params:
+//   - pictureId
+//   - userId
+//
+// returns:
+//   - liked: state after toggling
+//   - likeCount: like count after toggling
+//   - error: nil on success, non-nil on failure
+func ToggleLike(ctx context.Context, pictureId, userId int64) (bool, int64, error) {
+	return toggleRelation(ctx, constants.LikeTableName, "like_count", pictureId, userId)
+}
+
+// ToggleFavorite - favorite the picture, or cancel the favorite when it is already favorited
+// params:
+//   - pictureId
+//   - userId
+//
+// returns:
+//   - favorited: state after toggling
+//   - favoriteCount: favorite count after toggling
+//   - error: nil on success, non-nil on failure
+func ToggleFavorite(ctx context.Context, pictureId, userId int64) (bool, int64, error) {
+	return toggleRelation(ctx, constants.FavoriteTableName, "favorite_count", pictureId, userId)
+}
+
+// toggleRelation - delete the relation if it exists, otherwise create it, and keep the counter in step
+// the counter only changes with the affected rows, so concurrent toggles never count twice
+func toggleRelation(ctx context.Context, table, counter string, pictureId, userId int64) (bool, int64, error) {
+	var active bool
+	var count int64
+	err := db.Transaction(ctx, func(ctx context.Context) error {
+		res := db.WithContext(ctx).Exec("delete from "+table+" where picture_id = ? and user_id = ?", pictureId, userId)
+		if err := res.Error; err != nil {
+			return err
+		}
+		delta := -1
+		if res.RowsAffected == 0 {
+			id, err := utils.GenerateId()
+			if err != nil {
+				return err
+			}
+			// 并发请求已创建关系时不再计数
+			res = db.WithContext(ctx).Exec("insert ignore into "+table+" (id, picture_id, user_id) values (?, ?, ?)", id, pictureId, userId)
+			if err := res.Error; err != nil {
+				return err
+			}
+			active = true
+			delta = int(res.RowsAffected)
+		}
+		if delta != 0 {
+			res = db.WithContext(ctx).Model(&Picture{}).Where("id = ?", pictureId).
+				UpdateColumn(counter, gorm.Expr(counter+" + ?", delta))
+			if err := res.Error; err != nil {
+				return err
+			}
+		}
+		return db.WithContext(ctx).Model(&Picture{}).Select(counter).Where("id = ?", pictureId).Scan(&count).Error
+	})
+	if err != nil {
+		hlog.Errorf("dal - toggleRelation: toggle %s failed, %s\n", table, err)
+		return false, 0, err
+	}
+	return active, count, nil
+}
+
+// QueryLikedPictureIds - query which of the given pictures are liked by the user
+// params:
+//   - userId
+//   - pictureIds
+//
+// returns:
+//   - likedIds
+//   - error: nil on success, non-nil on failure
+func QueryLikedPictureIds(ctx context.Context, userId int64, pictureIds []int64) ([]int64, error) {
+	return queryRelationPictureIds(ctx, &PictureLike{}, userId, pictureIds)
+}
+
+// QueryFavoritedPictureIds - query which of the given pictures are favorited by the user
+// params:
+//   - userId
+//   - pictureIds
+//
+// returns:
+//   - favoritedIds
+//   - error: nil on success, non-nil on failure
+func QueryFavoritedPictureIds(ctx context.Context, userId int64, pictureIds []int64) ([]int64, error) {
+	return queryRelationPictureIds(ctx, &PictureFavorite{}, userId, pictureIds)
+}
+
+func queryRelationPictureIds(ctx context.Context, model interface{}, userId int64, pictureIds []int64) ([]int64, error) {
+	var ids []int64
+	if userId == 0 || len(pictureIds) == 0 {
+		return ids, nil
+	}
+	res := db.WithContext(ctx).Model(model).Where("user_id = ? and picture_id in ?", userId, pictureIds).Pluck("picture_id", &ids)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - queryRelationPictureIds: query relation failed, %s\n", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// QueryFavoritePicture - query undeleted pictures favorited by the user, latest favorite first
+// params:
+//   - userId
+//   - spaceIds: spaces the user can view, pictures in other spaces are skipped
+//   - currentPage (required)
+//   - pageSize (required)
+//
+// returns:
+//   - total: total number of visible favorite pictures
+//   - pictures
+//   - error: nil on success, non-nil on failure
+func QueryFavoritePicture(ctx context.Context, userId int64, spaceIds []int64, currentPage, pageSize int64) (int64, []*Picture, error) {
+	var pictures []*Picture
+	t := constants.PictureTableName
+	// count 会改写查询的 select, 统计与查询分别构造
+	query := func() *gorm.DB {
+		res := db.WithContext(ctx).Model(&Picture{}).
+			Joins("join "+constants.FavoriteTableName+" f on f.picture_id = "+t+".id").
+			Where("f.user_id = ? and "+t+".is_delete = 0", userId)
+		// 公共图库中仅已审核通过或本人的图片可见
+		visible := db.WithContext(ctx).Where(t+".space_id is null and ("+t+".review_status = ? or "+t+".user_id = ?)",
+			constants.ReviewPictureMap["通过"], userId)
+		if len(spaceIds) > 0 {
+			visible = visible.Or(t+".space_id in ?", spaceIds)
+		}
+		return res.Where(visible)
+	}
+
+	var total int64
+	if err := query().Count(&total).Error; err != nil {
+		hlog.Errorf("dal - QueryFavoritePicture: count favorite picture failed, %s\n", err)
+		return 0, nil, err
+	}
+
+	offset := (currentPage - 1) * pageSize
+	if err := query().Select(t + ".*").Order("f.create_time desc, f.id desc").
+		Offset(int(offset)).Limit(int(pageSize)).Find(&pictures).Error; err != nil {
+		hlog.Errorf("dal - QueryFavoritePicture: query favorite picture failed, %s\n", err)
+		return 0, nil, err
+	}
+	return total, pictures, nil
+}
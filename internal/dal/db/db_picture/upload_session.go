@@ -0,0 +1,127 @@
+package db_picture
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"time"
+)
+
+type UploadSession struct {
+	Id         int64     `json:"id"`
+	UserId     int64     `json:"user_id"`
+	PictureId  int64     `json:"picture_id"`
+	FileName   string    `json:"file_name"`
+	FileSize   int64     `json:"file_size"`
+	ChunkSize  int64     `json:"chunk_size"`
+	ChunkCount int       `json:"chunk_count"`
+	PicName    string    `json:"pic_name"`
+	KeepGps    bool      `json:"keep_gps"`
+	Status     int       `json:"status"`
+	ExpireTime time.Time `json:"expire_time"`
+	CreateTime time.Time `json:"create_time" gorm:"<-:false"`
+}
+
+func (s UploadSession) TableName() string {
+	return constants.SessionTableName
+}
+
+// CreateUploadSession - create an upload session
+// params:
+//   - session
+//     required: user_id, file_name, file_size, chunk_size, chunk_count, expire_time
+//
+// returns:
+//   - id: session id
+//   - error: nil on success, non-nil on failure
+func CreateUploadSession(ctx context.Context, session *UploadSession) (int64, error) {
+	id, err := utils.GenerateId()
+	if err != nil {
+		hlog.Errorf("dal - CreateUploadSession: generate session id failed, %s\n", err)
+		return 0, err
+	}
+	session.Id = id
+	var omitFields []string
+	if session.PictureId == 0 {
+		omitFields = append(omitFields, "picture_id")
+	}
+	if session.PicName == "" {
+		omitFields = append(omitFields, "pic_name")
+	}
+	res := db.DB.WithContext(ctx).Omit(omitFields...).Create(session)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - CreateUploadSession: create session into db failed, %s\n", err)
+		return 0, err
+	}
+	return id, nil
+}
+
+// QueryUploadSessionById - query an upload session which is not expired
+// params:
+//   - id
+//
+// returns:
+//   - session
+//   - error: nil on success, non-nil on failure
+func QueryUploadSessionById(ctx context.Context, id int64) (*UploadSession, error) {
+	var session *UploadSession
+	res := db.DB.WithContext(ctx).Where("id = ? and expire_time > ?", id, time.Now()).First(&session)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QueryUploadSessionById: query session failed, %s\n", err)
+		return nil, err
+	}
+	return session, nil
+}
+
+// UpdateUploadSessionStatus - change the session status only if it is still in the expected status
+// params:
+//   - id
+//   - from: expected current status
+//   - to: new status
+//
+// returns:
+//   - ok: false if the session is not in the expected status
+//   - error: nil on success, non-nil on failure
+func UpdateUploadSessionStatus(ctx context.Context, id int64, from, to int) (bool, error) {
+	res := db.DB.WithContext(ctx).Model(&UploadSession{}).Where("id = ? and status = ?", id, from).Update("status", to)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateUploadSessionStatus: update session status failed, %s\n", err)
+		return false, err
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// QueryExpiredUploadSession - query sessions whose expire time has passed
+// params:
+//   - now
+//   - limit
+//
+// returns:
+//   - sessions
+//   - error: nil on success, non-nil on failure
+func QueryExpiredUploadSession(ctx context.Context, now time.Time, limit int) ([]*UploadSession, error) {
+	var sessions []*UploadSession
+	res := db.DB.WithContext(ctx).Where("expire_time <= ?", now).Order("expire_time").Limit(limit).Find(&sessions)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QueryExpiredUploadSession: query session failed, %s\n", err)
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// DeleteUploadSession - remove an upload session
+// params:
+//   - id
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func DeleteUploadSession(ctx context.Context, id int64) error {
+	res := db.DB.WithContext(ctx).Where("id = ?", id).Delete(&UploadSession{})
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - DeleteUploadSession: delete session failed, %s\n", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package db_space
+
+import (
+	"context"
+	"errors"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"time"
+)
+
+type Space struct {
+	Id         int64  `json:"id"`
+	SpaceName  string `json:"space_name"`
+	SpaceLevel int    `json:"space_level"`
+	SpaceType  int    `json:"space_type"`
+	// 上限为 0 表示不限制
+	MaxCount   int64     `json:"max_count"`
+	MaxSize    int64     `json:"max_size"`
+	TotalCount int64     `json:"total_count"`
+	TotalSize  int64     `json:"total_size"`
+	UserId     int64     `json:"user_id"`
+	EditTime   time.Time `json:"edit_time"`
+	CreateTime time.Time `json:"create_time" gorm:"<-:false"`
+	UpdateTime time.Time `json:"update_time" gorm:"<-:false"`
+	IsDelete   int       `json:"is_delete"`
+}
+
+func (s Space) TableName() string {
+	return constants.SpaceTableName
+}
+
+// CreateSpace - create space
+// params:
+//   - space
+//     required: spaceLevel, spaceType, maxCount, maxSize, userId
+//     optional: spaceName
+//
+// returns:
+//   - spaceId
+//   - error: nil on success, non-nil on failure
+func CreateSpace(ctx context.Context, space *Space) (int64, error) {
+	id, err := utils.GenerateId()
+	if err != nil {
+		hlog.Errorf("dal - CreateSpace: generate space id failed, %s\n", err)
+		return 0, err
+	}
+	space.Id = id
+	omitFields := []string{"total_count", "total_size", "edit_time", "is_delete"}
+	if space.SpaceName == "" {
+		omitFields = append(omitFields, "space_name")
+	}
+	res := db.WithContext(ctx).Omit(omitFields...).Create(space)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - CreateSpace: create space into db failed, %s\n", err)
+		return 0, err
+	}
+	return id, nil
+}
+
+// UpdateSpace - update space
+// params:
+//   - space
+//     required: spaceId
+//     optional: spaceName, editTime
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func UpdateSpace(ctx context.Context, space *Space) error {
+	res := db.WithContext(ctx).Model(&Space{}).Where("id = ? and is_delete = 0", space.Id).Updates(space)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateSpace: update space failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateSpaceLimit - update the level and limit of a space, zero values are written as well
+// params:
+//   - required: id
+//   - optional: spaceLevel, maxCount, maxSize, nil keeps the current value, 0 limit means unlimited
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func UpdateSpaceLimit(ctx context.Context, id int64, spaceLevel *int, maxCount, maxSize *int64) error {
+	updates := map[string]interface{}{}
+	if spaceLevel != nil {
+		updates["space_level"] = *spaceLevel
+	}
+	if maxCount != nil {
+		updates["max_count"] = *maxCount
+	}
+	if maxSize != nil {
+		updates["max_size"] = *maxSize
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	res := db.WithContext(ctx).Model(&Space{}).Where("id = ? and is_delete = 0", id).Updates(updates)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateSpaceLimit: update space limit failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// QuerySpaceById - query space based on given id
+// params:
+//   - spaceId
+//
+// returns:
+//   - space
+//   - error: nil on success, non-nil on failure
+func QuerySpaceById(ctx context.Context, id int64) (*Space, error) {
+	space := &Space{}
+	res := db.WithContext(ctx).Where("id = ? and is_delete = 0", id).First(space)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QuerySpaceById: query space by id failed, %s\n", err)
+		return nil, err
+	}
+	return space, nil
+}
+
+// QuerySpaceByUserId - query the space of given type created by a user
+// params:
+//   - userId
+//   - spaceType
+//
+// returns:
+//   - space
+//   - error: gorm.ErrRecordNotFound when the user has no such space
+func QuerySpaceByUserId(ctx context.Context, userId int64, spaceType int) (*Space, error) {
+	space := &Space{}
+	res := db.WithContext(ctx).Where("user_id = ? and space_type = ? and is_delete = 0", userId, spaceType).First(space)
+	if err := res.Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			hlog.Errorf("dal - QuerySpaceByUserId: query space by user id failed, %s\n", err)
+		}
+		return nil, err
+	}
+	return space, nil
+}
+
+// CountSpaceByUserId - count undeleted spaces of given type created by a user
+// params:
+//   - userId
+//   - spaceType
+//
+// returns:
+//   - total
+//   - error: nil on success, non-nil on failure
+func CountSpaceByUserId(ctx context.Context, userId int64, spaceType int) (int64, error) {
+	var total int64
+	res := db.WithContext(ctx).Model(&Space{}).Where("user_id = ? and space_type = ? and is_delete = 0", userId, spaceType).Count(&total)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - CountSpaceByUserId: count space failed, %s\n", err)
+		return 0, err
+	}
+	return total, nil
+}
+
+// QuerySpace - query spaces based on the given filters
+// params:
+//   - space
+//     required: spaceLevel, spaceType, -1 means all
+//     optional: id, spaceName, userId
+//   - currentPage (required)
+//   - pageSize (required)
+//
+// returns:
+//   - total: total number of matched spaces
+//   - spaces: list of spaces matching the criteria
+//   - error: nil on success, non-nil on failure
+func QuerySpace(ctx context.Context, space *Space, currentPage, pageSize int64) (int64, []*Space, error) {
+	var spaces []*Space
+	res := db.WithContext(ctx).Model(&Space{}).Where("is_delete = 0")
+	if space.Id != 0 {
+		res = res.Where("id = ?", space.Id)
+	}
+	if space.UserId != 0 {
+		res = res.Where("user_id = ?", space.UserId)
+	}
+	if space.SpaceLevel != -1 {
+		res = res.Where("space_level = ?", space.SpaceLevel)
+	}
+	if space.SpaceType != -1 {
+		res = res.Where("space_type = ?", space.SpaceType)
+	}
+	if space.SpaceName != "" {
+		res = res.Where("space_name like ?", "%"+space.SpaceName+"%")
+	}
+
+	var total int64
+	if err := res.Count(&total).Error; err != nil {
+		hlog.Errorf("dal - QuerySpace: count match space failed, %s\n", err)
+		return 0, nil, err
+	}
+
+	offset := (currentPage - 1) * pageSize
+	if err := res.Offset(int(offset)).Limit(int(pageSize)).Find(&spaces).Error; err != nil {
+		hlog.Errorf("dal - QuerySpace: query space failed, %s\n", err)
+		return 0, nil, err
+	}
+	return total, spaces, nil
+}
+
+// IncrSpaceUsage - change the storage usage of a space, the change is rejected when it exceeds the limit
+// params:
+//   - required: id
+//   - count, size: usage delta, negative for release, usage never drops below 0
+//
+// returns:
+//   - ok: false when the limit is exceeded
+//   - error: nil on success, non-nil on failure
+func IncrSpaceUsage(ctx context.Context, id, count, size int64) (bool, error) {
+	res := db.WithContext(ctx).Model(&Space{}).Where("id = ? and is_delete = 0", id)
+	if count > 0 {
+		res = res.Where("(max_count = 0 or total_count + ? <= max_count)", count)
+	}
+	if size > 0 {
+		res = res.Where("(max_size = 0 or total_size + ? <= max_size)", size)
+	}
+	res = res.Updates(map[string]interface{}{
+		"total_count": gorm.Expr("greatest(total_count + ?, 0)", count),
+		"total_size":  gorm.Expr("greatest(total_size + ?, 0)", size),
+	})
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - IncrSpaceUsage: update space usage failed, %s\n", err)
+		return false, err
+	}
+	// 仅释放时可能未产生变更
+	return res.RowsAffected > 0 || (count <= 0 && size <= 0), nil
+}
@@ -0,0 +1,149 @@
+package db_space
+
+import (
+	"context"
+	"errors"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"time"
+)
+
+type SpaceInvite struct {
+	Id         int64     `json:"id"`
+	SpaceId    int64     `json:"space_id"`
+	UserId     int64     `json:"user_id"`
+	InviterId  int64     `json:"inviter_id"`
+	SpaceRole  string    `json:"space_role"`
+	Status     int       `json:"status"`
+	CreateTime time.Time `json:"create_time" gorm:"<-:false"`
+	UpdateTime time.Time `json:"update_time" gorm:"<-:false"`
+}
+
+func (s SpaceInvite) TableName() string {
+	return constants.SpaceInviteTableName
+}
+
+// CreateSpaceInvite - invite a user to a space
+// params:
+//   - spaceInvite
+//     required: spaceId, userId, inviterId, spaceRole
+//
+// returns:
+//   - spaceInviteId
+//   - error: gorm.ErrDuplicatedKey when the user has already been invited
+func CreateSpaceInvite(ctx context.Context, spaceInvite *SpaceInvite) (int64, error) {
+	id, err := utils.GenerateId()
+	if err != nil {
+		hlog.Errorf("dal - CreateSpaceInvite: generate space invite id failed, %s\n", err)
+		return 0, err
+	}
+	spaceInvite.Id = id
+	spaceInvite.Status = constants.SpaceInviteStatusPending
+	res := db.WithContext(ctx).Create(spaceInvite)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - CreateSpaceInvite: create space invite into db failed, %s\n", err)
+		return 0, err
+	}
+	return id, nil
+}
+
+// RenewSpaceInvite - reset an existing invite to pending with a new inviter and role
+// params:
+//   - id
+//   - inviterId
+//   - spaceRole
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func RenewSpaceInvite(ctx context.Context, id, inviterId int64, spaceRole string) error {
+	res := db.WithContext(ctx).Model(&SpaceInvite{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"inviter_id": inviterId,
+		"space_role": spaceRole,
+		"status":     constants.SpaceInviteStatusPending,
+	})
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - RenewSpaceInvite: update space invite failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateSpaceInviteStatus - change the invite status only if it is still in the expected status
+// params:
+//   - id
+//   - from: expected current status
+//   - to: new status
+//
+// returns:
+//   - ok: false if the invite is not in the expected status
+//   - error: nil on success, non-nil on failure
+func UpdateSpaceInviteStatus(ctx context.Context, id int64, from, to int) (bool, error) {
+	res := db.WithContext(ctx).Model(&SpaceInvite{}).Where("id = ? and status = ?", id, from).Update("status", to)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateSpaceInviteStatus: update space invite status failed, %s\n", err)
+		return false, err
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// QuerySpaceInviteById - query an invite by id
+// params:
+//   - id
+//
+// returns:
+//   - spaceInvite
+//   - error: gorm.ErrRecordNotFound when the invite does not exist
+func QuerySpaceInviteById(ctx context.Context, id int64) (*SpaceInvite, error) {
+	spaceInvite := &SpaceInvite{}
+	res := db.WithContext(ctx).Where("id = ?", id).First(spaceInvite)
+	if err := res.Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			hlog.Errorf("dal - QuerySpaceInviteById: query space invite failed, %s\n", err)
+		}
+		return nil, err
+	}
+	return spaceInvite, nil
+}
+
+// QuerySpaceInvite - query the invite of a user to a space
+// params:
+//   - spaceId
+//   - userId
+//
+// returns:
+//   - spaceInvite
+//   - error: gorm.ErrRecordNotFound when the user has not been invited
+func QuerySpaceInvite(ctx context.Context, spaceId, userId int64) (*SpaceInvite, error) {
+	spaceInvite := &SpaceInvite{}
+	res := db.WithContext(ctx).Where("space_id = ? and user_id = ?", spaceId, userId).First(spaceInvite)
+	if err := res.Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			hlog.Errorf("dal - QuerySpaceInvite: query space invite failed, %s\n", err)
+		}
+		return nil, err
+	}
+	return spaceInvite, nil
+}
+
+// QueryPendingSpaceInviteByUserId - query pending invites of a user to undeleted spaces, newest first
+// params:
+//   - userId
+//
+// returns:
+//   - spaceInvites
+//   - error: nil on success, non-nil on failure
+func QueryPendingSpaceInviteByUserId(ctx context.Context, userId int64) ([]*SpaceInvite, error) {
+	var spaceInvites []*SpaceInvite
+	res := db.WithContext(ctx).
+		Where("user_id = ? and status = ? and space_id in (?)", userId, constants.SpaceInviteStatusPending,
+			db.WithContext(ctx).Model(&Space{}).Select("id").Where("is_delete = 0")).
+		Order("update_time desc, id desc").Find(&spaceInvites)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QueryPendingSpaceInviteByUserId: query space invite failed, %s\n", err)
+		return nil, err
+	}
+	return spaceInvites, nil
+}
@@ -0,0 +1,138 @@
+package db_space
+
+import (
+	"context"
+	"errors"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"time"
+)
+
+type SpaceUser struct {
+	Id         int64     `json:"id"`
+	SpaceId    int64     `json:"space_id"`
+	UserId     int64     `json:"user_id"`
+	SpaceRole  string    `json:"space_role"`
+	CreateTime time.Time `json:"create_time" gorm:"<-:false"`
+	UpdateTime time.Time `json:"update_time" gorm:"<-:false"`
+}
+
+func (s SpaceUser) TableName() string {
+	return constants.SpaceUserTableName
+}
+
+// CreateSpaceUser - add a member to a space
+// params:
+//   - spaceUser
+//     required: spaceId, userId, spaceRole
+//
+// returns:
+//   - spaceUserId
+//   - error: gorm.ErrDuplicatedKey when the user is already a member
+func CreateSpaceUser(ctx context.Context, spaceUser *SpaceUser) (int64, error) {
+	id, err := utils.GenerateId()
+	if err != nil {
+		hlog.Errorf("dal - CreateSpaceUser: generate space user id failed, %s\n", err)
+		return 0, err
+	}
+	spaceUser.Id = id
+	res := db.WithContext(ctx).Create(spaceUser)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - CreateSpaceUser: create space user into db failed, %s\n", err)
+		return 0, err
+	}
+	return id, nil
+}
+
+// DeleteSpaceUser - remove a member from a space
+// params:
+//   - spaceId
+//   - userId
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func DeleteSpaceUser(ctx context.Context, spaceId, userId int64) error {
+	res := db.WithContext(ctx).Where("space_id = ? and user_id = ?", spaceId, userId).Delete(&SpaceUser{})
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - DeleteSpaceUser: delete space user failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateSpaceUserRole - change the role of a member
+// params:
+//   - spaceId
+//   - userId
+//   - spaceRole
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func UpdateSpaceUserRole(ctx context.Context, spaceId, userId int64, spaceRole string) error {
+	res := db.WithContext(ctx).Model(&SpaceUser{}).Where("space_id = ? and user_id = ?", spaceId, userId).
+		Update("space_role", spaceRole)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateSpaceUserRole: update space user role failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// QuerySpaceUser - query the membership of a user in a space
+// params:
+//   - spaceId
+//   - userId
+//
+// returns:
+//   - spaceUser
+//   - error: gorm.ErrRecordNotFound when the user is not a member
+func QuerySpaceUser(ctx context.Context, spaceId, userId int64) (*SpaceUser, error) {
+	spaceUser := &SpaceUser{}
+	res := db.WithContext(ctx).Where("space_id = ? and user_id = ?", spaceId, userId).First(spaceUser)
+	if err := res.Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			hlog.Errorf("dal - QuerySpaceUser: query space user failed, %s\n", err)
+		}
+		return nil, err
+	}
+	return spaceUser, nil
+}
+
+// QuerySpaceUserBySpaceId - query all members of a space ordered by join time
+// params:
+//   - spaceId
+//
+// returns:
+//   - spaceUsers
+//   - error: nil on success, non-nil on failure
+func QuerySpaceUserBySpaceId(ctx context.Context, spaceId int64) ([]*SpaceUser, error) {
+	var spaceUsers []*SpaceUser
+	res := db.WithContext(ctx).Where("space_id = ?", spaceId).Order("create_time, id").Find(&spaceUsers)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QuerySpaceUserBySpaceId: query space user failed, %s\n", err)
+		return nil, err
+	}
+	return spaceUsers, nil
+}
+
+// QuerySpaceByMember - query undeleted team spaces the user is a member of
+// params:
+//   - userId
+//
+// returns:
+//   - spaces
+//   - error: nil on success, non-nil on failure
+func QuerySpaceByMember(ctx context.Context, userId int64) ([]*Space, error) {
+	var spaces []*Space
+	res := db.WithContext(ctx).Model(&Space{}).
+		Where("is_delete = 0 and id in (?)", db.WithContext(ctx).Model(&SpaceUser{}).Select("space_id").Where("user_id = ?", userId)).
+		Order("create_time, id").Find(&spaces)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QuerySpaceByMember: query space by member failed, %s\n", err)
+		return nil, err
+	}
+	return spaces, nil
+}
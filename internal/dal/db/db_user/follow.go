@@ -0,0 +1,175 @@
+package db_user
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/utils"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"time"
+)
+
+type UserFollow struct {
+	Id           int64     `json:"id"`
+	UserId       int64     `json:"user_id"`
+	FollowUserId int64     `json:"follow_user_id"`
+	CreateTime   time.Time `json:"create_time" gorm:"<-:false"`
+}
+
+func (f UserFollow) TableName() string {
+	return constants.FollowTableName
+}
+
+// CreateFollow - follow the user, following an already followed user changes nothing
+// params:
+//   - userId: follower
+//   - followUserId: followed user
+//
+// returns:
+//   - followerCount: follower count of the followed user
+//   - error: nil on success, non-nil on failure
+func CreateFollow(ctx context.Context, userId, followUserId int64) (int64, error) {
+	var followerCount int64
+	err := db.Transaction(ctx, func(ctx context.Context) error {
+		id, err := utils.GenerateId()
+		if err != nil {
+			return err
+		}
+		// 并发请求已创建关系时不再计数
+		res := db.WithContext(ctx).Exec("insert ignore into "+constants.FollowTableName+" (id, user_id, follow_user_id) values (?, ?, ?)",
+			id, userId, followUserId)
+		if err := res.Error; err != nil {
+			return err
+		}
+		if err := incrFollowCount(ctx, userId, followUserId, res.RowsAffected); err != nil {
+			return err
+		}
+		return db.WithContext(ctx).Model(&User{}).Select("follower_count").Where("id = ?", followUserId).Scan(&followerCount).Error
+	})
+	if err != nil {
+		hlog.Errorf("dal - CreateFollow: create follow failed, %s\n", err)
+		return 0, err
+	}
+	return followerCount, nil
+}
+
+// DeleteFollow - unfollow the user, unfollowing a user not followed changes nothing
+// params:
+//   - userId: follower
+//   - followUserId: followed user
+//
+// returns:
+//   - followerCount: follower count of the followed user
+//   - error: nil on success, non-nil on failure
+func DeleteFollow(ctx context.Context, userId, followUserId int64) (int64, error) {
+	var followerCount int64
+	err := db.Transaction(ctx, func(ctx context.Context) error {
+		res := db.WithContext(ctx).Exec("delete from "+constants.FollowTableName+" where user_id = ? and follow_user_id = ?",
+			userId, followUserId)
+		if err := res.Error; err != nil {
+			return err
+		}
+		if err := incrFollowCount(ctx, userId, followUserId, -res.RowsAffected); err != nil {
+			return err
+		}
+		return db.WithContext(ctx).Model(&User{}).Select("follower_count").Where("id = ?", followUserId).Scan(&followerCount).Error
+	})
+	if err != nil {
+		hlog.Errorf("dal - DeleteFollow: delete follow failed, %s\n", err)
+		return 0, err
+	}
+	return followerCount, nil
+}
+
+// incrFollowCount - keep the following count of the follower and the follower count of the followed user in step
+// the counters only change with the affected rows, so concurrent requests never count twice
+func incrFollowCount(ctx context.Context, userId, followUserId, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+	res := db.WithContext(ctx).Model(&User{}).Where("id = ?", userId).
+		UpdateColumn("following_count", gorm.Expr("greatest(following_count + ?, 0)", delta))
+	if err := res.Error; err != nil {
+		return err
+	}
+	res = db.WithContext(ctx).Model(&User{}).Where("id = ?", followUserId).
+		UpdateColumn("follower_count", gorm.Expr("greatest(follower_count + ?, 0)", delta))
+	return res.Error
+}
+
+// QueryFollowedUserIds - query which of the given users are followed by the user
+// params:
+//   - userId
+//   - followUserIds
+//
+// returns:
+//   - followedIds
+//   - error: nil on success, non-nil on failure
+func QueryFollowedUserIds(ctx context.Context, userId int64, followUserIds []int64) ([]int64, error) {
+	var ids []int64
+	if userId == 0 || len(followUserIds) == 0 {
+		return ids, nil
+	}
+	res := db.WithContext(ctx).Model(&UserFollow{}).Where("user_id = ? and follow_user_id in ?", userId, followUserIds).
+		Pluck("follow_user_id", &ids)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - QueryFollowedUserIds: query follow failed, %s\n", err)
+		return nil, err
+	}
+	return ids, nil
+}
+
+// QueryFollower - query undeleted users following the user, latest follow first
+// params:
+//   - userId
+//   - currentPage (required)
+//   - pageSize (required)
+//
+// returns:
+//   - total: total number of followers
+//   - users
+//   - error: nil on success, non-nil on failure
+func QueryFollower(ctx context.Context, userId int64, currentPage, pageSize int64) (int64, []*User, error) {
+	return queryFollowUser(ctx, "user_id", "follow_user_id", userId, currentPage, pageSize)
+}
+
+// QueryFollowing - query undeleted users followed by the user, latest follow first
+// params:
+//   - userId
+//   - currentPage (required)
+//   - pageSize (required)
+//
+// returns:
+//   - total: total number of followed users
+//   - users
+//   - error: nil on success, non-nil on failure
+func QueryFollowing(ctx context.Context, userId int64, currentPage, pageSize int64) (int64, []*User, error) {
+	return queryFollowUser(ctx, "follow_user_id", "user_id", userId, currentPage, pageSize)
+}
+
+// queryFollowUser - join the users on joinColumn of the follow relations whose whereColumn is userId
+func queryFollowUser(ctx context.Context, joinColumn, whereColumn string, userId, currentPage, pageSize int64) (int64, []*User, error) {
+	var users []*User
+	t := constants.UserTableName
+	// count 会改写查询的 select, 统计与查询分别构造
+	query := func() *gorm.DB {
+		return db.WithContext(ctx).Model(&User{}).
+			Joins("join "+constants.FollowTableName+" f on f."+joinColumn+" = "+t+".id").
+			Where("f."+whereColumn+" = ? and "+t+".is_delete = 0", userId)
+	}
+
+	var total int64
+	if err := query().Count(&total).Error; err != nil {
+		hlog.Errorf("dal - queryFollowUser: count follow user failed, %s\n", err)
+		return 0, nil, err
+	}
+
+	offset := (currentPage - 1) * pageSize
+	if err := query().Select(t + ".*").Order("f.create_time desc, f.id desc").
+		Offset(int(offset)).Limit(int(pageSize)).Find(&users).Error; err != nil {
+		hlog.Errorf("dal - queryFollowUser: query follow user failed, %s\n", err)
+		return 0, nil, err
+	}
+	return total, users, nil
+}
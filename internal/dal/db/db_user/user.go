@@ -6,6 +6,8 @@ import (
 	"github.com/Alf-Grindel/clide/pkg/constants"
 	"github.com/Alf-Grindel/clide/pkg/utils"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 )
 
@@ -20,6 +22,14 @@ type User struct {
 	CreateTime   time.Time `json:"create_time" gorm:"<-:false"`
 	UpdateTime   time.Time `json:"update_time" gorm:"<-:false"`
 	IsDelete     int       `json:"is_delete"`
+	// 配额为空时使用默认配额
+	QuotaCount *int64 `json:"quota_count"`
+	QuotaSize  *int64 `json:"quota_size"`
+	UsedCount  int64  `json:"used_count"`
+	UsedSize   int64  `json:"used_size"`
+	// 粉丝数与关注数, 仅随关注关系变化
+	FollowerCount  int64 `json:"follower_count"`
+	FollowingCount int64 `json:"following_count"`
 }
 
 func (u User) TableName() string {
@@ -134,6 +144,24 @@ func QueryUserById(ctx context.Context, id int64) (*User, error) {
 	return user, nil
 }
 
+// LockUserById - lock the user row until the transaction ends, serializing operations of the same user
+// params:
+//   - ctx: transaction context
+//   - required: id
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func LockUserById(ctx context.Context, id int64) error {
+	user := &User{}
+	res := db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").
+		Where("id = ? and is_delete = 0", id).First(user)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - LockUserById: lock user failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
 // QueryUserByAccount - query user based on given user account
 // params:
 //   - required: userAccount
@@ -189,3 +217,66 @@ func QueryUser(ctx context.Context, user *User, currentPage, pageSize int64) (in
 	}
 	return total, users, nil
 }
+
+// IncrUserUsage - change the storage usage of a user, the change is rejected when it exceeds the quota
+// params:
+//   - required: id
+//   - count, size: usage delta, negative for release, usage never drops below 0
+//   - defaultCount, defaultSize: quota used when the user has no override, 0 means unlimited
+//
+// returns:
+//   - ok: false when the quota is exceeded
+//   - error: nil on success, non-nil on failure
+func IncrUserUsage(ctx context.Context, id, count, size, defaultCount, defaultSize int64) (bool, error) {
+	res := db.WithContext(ctx).Model(&User{}).Where("id = ? and is_delete = 0", id)
+	if count > 0 {
+		res = res.Where("(coalesce(quota_count, ?) = 0 or used_count + ? <= coalesce(quota_count, ?))", defaultCount, count, defaultCount)
+	}
+	if size > 0 {
+		res = res.Where("(coalesce(quota_size, ?) = 0 or used_size + ? <= coalesce(quota_size, ?))", defaultSize, size, defaultSize)
+	}
+	res = res.Updates(map[string]interface{}{
+		"used_count": gorm.Expr("greatest(used_count + ?, 0)", count),
+		"used_size":  gorm.Expr("greatest(used_size + ?, 0)", size),
+	})
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - IncrUserUsage: update user usage failed, %s\n", err)
+		return false, err
+	}
+	// 仅释放时可能未产生变更
+	return res.RowsAffected > 0 || (count <= 0 && size <= 0), nil
+}
+
+// UpdateUserQuota - override the quota of a user
+// params:
+//   - required: id
+//   - optional: quotaCount, quotaSize, nil keeps the current value, negative resets to the default quota
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func UpdateUserQuota(ctx context.Context, id int64, quotaCount, quotaSize *int64) error {
+	updates := map[string]interface{}{}
+	if quotaCount != nil {
+		updates["quota_count"] = quotaOverride(*quotaCount)
+	}
+	if quotaSize != nil {
+		updates["quota_size"] = quotaOverride(*quotaSize)
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	res := db.WithContext(ctx).Model(&User{}).Where("id = ? and is_delete = 0", id).Updates(updates)
+	if err := res.Error; err != nil {
+		hlog.Errorf("dal - UpdateUserQuota: update user quota failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+// quotaOverride - 负数写入为 NULL, 即使用默认配额
+func quotaOverride(v int64) interface{} {
+	if v < 0 {
+		return nil
+	}
+	return v
+}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"github.com/Alf-Grindel/clide/config"
 	"github.com/Alf-Grindel/clide/pkg/constants"
@@ -24,3 +25,26 @@ func Init() {
 		panic(err)
 	}
 }
+
+type txKey struct{}
+
+// Transaction - 在同一事务中执行 fn, fn 中通过 WithContext 获取的连接均属于该事务
+// params:
+//   - ctx
+//   - fn: 返回错误时回滚
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// WithContext - 获取数据库连接, ctx 处于事务中时返回事务连接
+func WithContext(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return DB.WithContext(ctx)
+}
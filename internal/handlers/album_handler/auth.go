@@ -0,0 +1,121 @@
+package album_handler
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/model/clide/album"
+	"github.com/Alf-Grindel/clide/internal/services/album_services"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func AddAlbum(ctx context.Context, c *app.RequestContext) {
+	var req album.AddAlbumReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	id, err := album_services.NewAlbumService(ctx).AddAlbum(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &album.AddAlbumResp{
+		ID:   id,
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func EditAlbum(ctx context.Context, c *app.RequestContext) {
+	var req album.EditAlbumReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	current, err := album_services.NewAlbumService(ctx).EditAlbum(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &album.EditAlbumResp{
+		Album: current,
+		Base:  errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func DeleteAlbum(ctx context.Context, c *app.RequestContext) {
+	var req album.DeleteAlbumReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := album_services.NewAlbumService(ctx).DeleteAlbum(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &album.DeleteAlbumResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func AddAlbumPicture(ctx context.Context, c *app.RequestContext) {
+	var req album.AddAlbumPictureReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := album_services.NewAlbumService(ctx).AddAlbumPicture(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &album.AddAlbumPictureResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func RemoveAlbumPicture(ctx context.Context, c *app.RequestContext) {
+	var req album.RemoveAlbumPictureReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := album_services.NewAlbumService(ctx).RemoveAlbumPicture(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &album.RemoveAlbumPictureResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func SortAlbumPicture(ctx context.Context, c *app.RequestContext) {
+	var req album.SortAlbumPictureReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := album_services.NewAlbumService(ctx).SortAlbumPicture(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &album.SortAlbumPictureResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
@@ -0,0 +1,71 @@
+package album_handler
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/model/clide/album"
+	"github.com/Alf-Grindel/clide/internal/services/album_services"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func GetAlbum(ctx context.Context, c *app.RequestContext) {
+	var req album.GetAlbumReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	current, err := album_services.NewAlbumService(ctx).GetAlbum(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &album.GetAlbumResp{
+		Album: current,
+		Base:  errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func ListAlbumPicture(ctx context.Context, c *app.RequestContext) {
+	var req album.ListAlbumPictureReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	total, currents, err := album_services.NewAlbumService(ctx).ListAlbumPicture(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &album.ListAlbumPictureResp{
+		Total:    total,
+		Pictures: currents,
+		Base:     errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func ListAlbum(ctx context.Context, c *app.RequestContext) {
+	var req album.ListAlbumReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	total, currents, err := album_services.NewAlbumService(ctx).ListAlbum(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &album.ListAlbumResp{
+		Total:  total,
+		Albums: currents,
+		Base:   errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
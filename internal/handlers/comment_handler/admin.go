@@ -0,0 +1,48 @@
+package comment_handler
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/model/clide/comment"
+	"github.com/Alf-Grindel/clide/internal/services/comment_services"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func QueryComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.QueryCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	total, currents, err := comment_services.NewCommentService(ctx).QueryComment(&req)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &comment.QueryCommentResp{
+		Total:    total,
+		Comments: currents,
+		Base:     errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func ReviewComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.ReviewCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := comment_services.NewCommentService(ctx).ReviewComment(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &comment.ReviewCommentResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
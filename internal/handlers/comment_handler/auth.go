@@ -0,0 +1,83 @@
+package comment_handler
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/model/clide/comment"
+	"github.com/Alf-Grindel/clide/internal/services/comment_services"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func AddComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.AddCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	id, err := comment_services.NewCommentService(ctx).AddComment(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &comment.AddCommentResp{
+		ID:   id,
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func EditComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.EditCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := comment_services.NewCommentService(ctx).EditComment(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &comment.EditCommentResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func DeleteComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.DeleteCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := comment_services.NewCommentService(ctx).DeleteComment(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &comment.DeleteCommentResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func HideComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.HideCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := comment_services.NewCommentService(ctx).HideComment(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &comment.HideCommentResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
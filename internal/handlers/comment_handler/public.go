@@ -0,0 +1,51 @@
+package comment_handler
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/model/clide/comment"
+	"github.com/Alf-Grindel/clide/internal/services/comment_services"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func ListComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.ListCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	total, currents, err := comment_services.NewCommentService(ctx).ListComment(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &comment.ListCommentResp{
+		Total:    total,
+		Comments: currents,
+		Base:     errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func ListCommentReply(ctx context.Context, c *app.RequestContext) {
+	var req comment.ListCommentReplyReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	total, currents, err := comment_services.NewCommentService(ctx).ListCommentReply(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &comment.ListCommentReplyResp{
+		Total:   total,
+		Replies: currents,
+		Base:    errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/Alf-Grindel/clide/internal/services/picture_services"
 	"github.com/Alf-Grindel/clide/pkg/errno"
 	"github.com/cloudwego/hertz/pkg/app"
+	"time"
 )
 
 func PictureEdit(ctx context.Context, c *app.RequestContext) {
@@ -28,6 +29,119 @@ func PictureEdit(ctx context.Context, c *app.RequestContext) {
 	c.JSON(200, resp)
 }
 
+func PictureDelete(ctx context.Context, c *app.RequestContext) {
+	var req picture.PictureDeleteReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+
+	if err := picture_services.NewPictureService(ctx).PictureDelete(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+
+	resp := &picture.PictureDeleteResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func PictureLike(ctx context.Context, c *app.RequestContext) {
+	var req picture.PictureLikeReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+
+	liked, likeCount, err := picture_services.NewPictureService(ctx).PictureLike(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+
+	resp := &picture.PictureLikeResp{
+		Liked:     liked,
+		LikeCount: likeCount,
+		Base:      errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func PictureFavorite(ctx context.Context, c *app.RequestContext) {
+	var req picture.PictureFavoriteReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+
+	favorited, favoriteCount, err := picture_services.NewPictureService(ctx).PictureFavorite(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+
+	resp := &picture.PictureFavoriteResp{
+		Favorited:     favorited,
+		FavoriteCount: favoriteCount,
+		Base:          errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func ListFavoritePicture(ctx context.Context, c *app.RequestContext) {
+	var req picture.ListFavoritePictureReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+
+	total, currents, err := picture_services.NewPictureService(ctx).ListFavoritePicture(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+
+	resp := &picture.ListFavoritePictureResp{
+		Total:    total,
+		Pictures: currents,
+		Base:     errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func PictureFeed(ctx context.Context, c *app.RequestContext) {
+	var req picture.PictureFeedReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+
+	currents, nextCursor, err := picture_services.NewPictureService(ctx).PictureFeed(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+
+	resp := &picture.PictureFeedResp{
+		Pictures:   currents,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != "",
+		Base:       errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
 func UploadPicture(ctx context.Context, c *app.RequestContext) {
 	fileHeader, err := c.FormFile("file")
 	if err != nil {
@@ -73,3 +187,208 @@ func UploadPictureByUrl(ctx context.Context, c *app.RequestContext) {
 	}
 	c.JSON(200, resp)
 }
+
+func SearchSimilarPicture(ctx context.Context, c *app.RequestContext) {
+	var req picture.SearchSimilarPictureReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	// 未上传图片时按 id 搜索
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		fileHeader = nil
+	}
+	currents, err := picture_services.NewPictureService(ctx).SearchSimilarPicture(&req, fileHeader, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &picture.SearchSimilarPictureResp{
+		Pictures: currents,
+		Base:     errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func InitUploadSession(ctx context.Context, c *app.RequestContext) {
+	var req picture.InitUploadSessionReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	session, err := picture_services.NewPictureService(ctx).InitUploadSession(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &picture.InitUploadSessionResp{
+		UploadID:   session.Id,
+		ChunkSize:  session.ChunkSize,
+		ChunkCount: int32(session.ChunkCount),
+		ExpireTime: session.ExpireTime.Format(time.DateTime),
+		Base:       errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func UploadSessionChunk(ctx context.Context, c *app.RequestContext) {
+	var req picture.UploadSessionChunkReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	// 请求体即为分片内容
+	if err := picture_services.NewPictureService(ctx).UploadSessionChunk(&req, c.Request.Body(), c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &picture.UploadSessionChunkResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func GetUploadSession(ctx context.Context, c *app.RequestContext) {
+	var req picture.GetUploadSessionReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	session, uploadedChunks, err := picture_services.NewPictureService(ctx).GetUploadSession(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &picture.GetUploadSessionResp{
+		UploadID:       session.Id,
+		FileSize:       session.FileSize,
+		ChunkSize:      session.ChunkSize,
+		ChunkCount:     int32(session.ChunkCount),
+		UploadedChunks: uploadedChunks,
+		ExpireTime:     session.ExpireTime.Format(time.DateTime),
+		Base:           errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func CompleteUploadSession(ctx context.Context, c *app.RequestContext) {
+	var req picture.CompleteUploadSessionReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	id, err := picture_services.NewPictureService(ctx).CompleteUploadSession(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &picture.CompleteUploadSessionResp{
+		ID:   id,
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func AbortUploadSession(ctx context.Context, c *app.RequestContext) {
+	var req picture.AbortUploadSessionReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := picture_services.NewPictureService(ctx).AbortUploadSession(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &picture.AbortUploadSessionResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func PresignUpload(ctx context.Context, c *app.RequestContext) {
+	var req picture.PresignUploadReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	key, uploadUrl, expireTime, err := picture_services.NewPictureService(ctx).PresignUpload(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &picture.PresignUploadResp{
+		Key:        key,
+		UploadURL:  uploadUrl,
+		ExpireTime: expireTime.Format(time.DateTime),
+		Base:       errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func ConfirmUpload(ctx context.Context, c *app.RequestContext) {
+	var req picture.ConfirmUploadReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	id, err := picture_services.NewPictureService(ctx).ConfirmUpload(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &picture.ConfirmUploadResp{
+		ID:   id,
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func UploadPictureByArchive(ctx context.Context, c *app.RequestContext) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	var req picture.UploadPictureByArchiveReq
+	if err = c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	results, err := picture_services.NewPictureService(ctx).UploadPictureByArchive(&req, fileHeader, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &picture.UploadPictureByArchiveResp{
+		Results: results,
+		Base:    errno.BuildBaseResp(errno.Success),
+	}
+	for _, result := range results {
+		if result.Success {
+			resp.SuccessCount++
+		} else {
+			resp.FailCount++
+		}
+	}
+	c.JSON(200, resp)
+}
@@ -2,10 +2,13 @@ package file_handler
 
 import (
 	"context"
+	"fmt"
 	"github.com/Alf-Grindel/clide/internal/model/clide/picture"
 	"github.com/Alf-Grindel/clide/internal/services/picture_services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
 	"github.com/Alf-Grindel/clide/pkg/errno"
 	"github.com/cloudwego/hertz/pkg/app"
+	"net/http"
 )
 
 func PictureListTagCategory(ctx context.Context, c *app.RequestContext) {
@@ -28,7 +31,7 @@ func PictureSearch(ctx context.Context, c *app.RequestContext) {
 		c.JSON(200, resp)
 		return
 	}
-	total, currents, err := picture_services.NewPictureService(ctx).PictureSearch(&req)
+	total, currents, err := picture_services.NewPictureService(ctx).PictureSearch(&req, c)
 	if err != nil {
 		resp := errno.BuildBaseResp(err)
 		c.JSON(200, resp)
@@ -50,7 +53,7 @@ func PictureGetById(ctx context.Context, c *app.RequestContext) {
 		c.JSON(200, resp)
 		return
 	}
-	current, err := picture_services.NewPictureService(ctx).PictureGetById(&req)
+	current, err := picture_services.NewPictureService(ctx).PictureGetById(&req, c)
 	if err != nil {
 		resp := errno.BuildBaseResp(err)
 		c.JSON(200, resp)
@@ -63,3 +66,30 @@ func PictureGetById(ctx context.Context, c *app.RequestContext) {
 	}
 	c.JSON(200, resp)
 }
+
+func PictureImage(ctx context.Context, c *app.RequestContext) {
+	var req picture.PictureImageReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	image, err := picture_services.NewPictureService(ctx).PictureImage(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	cacheControl := "public"
+	if image.Private {
+		cacheControl = "private"
+	}
+	c.Header("Cache-Control", fmt.Sprintf("%s, max-age=%d", cacheControl, int(constants.ImageCacheMaxAge.Seconds())))
+	c.Header("ETag", image.ETag)
+	c.Header("Vary", "Cookie")
+	if image.NotModified {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, image.ContentType, image.Data)
+}
@@ -0,0 +1,50 @@
+package space_handler
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/model/clide/space"
+	"github.com/Alf-Grindel/clide/internal/services/space_services"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func UpdateSpace(ctx context.Context, c *app.RequestContext) {
+	var req space.UpdateSpaceReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	current, err := space_services.NewSpaceService(ctx).UpdateSpace(&req)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.UpdateSpaceResp{
+		Space: current,
+		Base:  errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func QuerySpace(ctx context.Context, c *app.RequestContext) {
+	var req space.QuerySpaceReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	total, currents, err := space_services.NewSpaceService(ctx).QuerySpace(&req)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.QuerySpaceResp{
+		Total:  total,
+		Spaces: currents,
+		Base:   errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
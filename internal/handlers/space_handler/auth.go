@@ -0,0 +1,98 @@
+package space_handler
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/model/clide/space"
+	"github.com/Alf-Grindel/clide/internal/services/space_services"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func AddSpace(ctx context.Context, c *app.RequestContext) {
+	var req space.AddSpaceReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	id, err := space_services.NewSpaceService(ctx).AddSpace(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.AddSpaceResp{
+		ID:   id,
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func GetSpace(ctx context.Context, c *app.RequestContext) {
+	var req space.GetSpaceReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	current, err := space_services.NewSpaceService(ctx).GetSpace(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.GetSpaceResp{
+		Space: current,
+		Base:  errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func EditSpace(ctx context.Context, c *app.RequestContext) {
+	var req space.EditSpaceReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	current, err := space_services.NewSpaceService(ctx).EditSpace(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.EditSpaceResp{
+		Space: current,
+		Base:  errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func ListSpaceLevel(ctx context.Context, c *app.RequestContext) {
+	resp := &space.ListSpaceLevelResp{
+		Levels: space_services.NewSpaceService(ctx).ListSpaceLevel(),
+		Base:   errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func SearchSpacePicture(ctx context.Context, c *app.RequestContext) {
+	var req space.SearchSpacePictureReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	total, currents, err := space_services.NewSpaceService(ctx).SearchSpacePicture(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.SearchSpacePictureResp{
+		Total:    total,
+		Pictures: currents,
+		Base:     errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
@@ -0,0 +1,153 @@
+package space_handler
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/model/clide/space"
+	"github.com/Alf-Grindel/clide/internal/services/space_services"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func ListMySpace(ctx context.Context, c *app.RequestContext) {
+	currents, err := space_services.NewSpaceService(ctx).ListMySpace(c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.ListMySpaceResp{
+		Spaces: currents,
+		Base:   errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func AddSpaceUser(ctx context.Context, c *app.RequestContext) {
+	var req space.AddSpaceUserReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	current, err := space_services.NewSpaceService(ctx).AddSpaceUser(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.AddSpaceUserResp{
+		SpaceInvite: current,
+		Base:        errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func DeleteSpaceUser(ctx context.Context, c *app.RequestContext) {
+	var req space.DeleteSpaceUserReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := space_services.NewSpaceService(ctx).DeleteSpaceUser(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.DeleteSpaceUserResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func EditSpaceUser(ctx context.Context, c *app.RequestContext) {
+	var req space.EditSpaceUserReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	current, err := space_services.NewSpaceService(ctx).EditSpaceUser(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.EditSpaceUserResp{
+		SpaceUser: current,
+		Base:      errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func ListSpaceUser(ctx context.Context, c *app.RequestContext) {
+	var req space.ListSpaceUserReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	currents, err := space_services.NewSpaceService(ctx).ListSpaceUser(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.ListSpaceUserResp{
+		SpaceUsers: currents,
+		Base:       errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func ListMySpaceInvite(ctx context.Context, c *app.RequestContext) {
+	currents, err := space_services.NewSpaceService(ctx).ListMySpaceInvite(c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.ListMySpaceInviteResp{
+		SpaceInvites: currents,
+		Base:         errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func AcceptSpaceInvite(ctx context.Context, c *app.RequestContext) {
+	var req space.AcceptSpaceInviteReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	current, err := space_services.NewSpaceService(ctx).AcceptSpaceInvite(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.AcceptSpaceInviteResp{
+		SpaceUser: current,
+		Base:      errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
+
+func DeclineSpaceInvite(ctx context.Context, c *app.RequestContext) {
+	var req space.DeclineSpaceInviteReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	if err := space_services.NewSpaceService(ctx).DeclineSpaceInvite(&req, c); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &space.DeclineSpaceInviteResp{
+		Base: errno.BuildBaseResp(errno.Success),
+	}
+	c.JSON(200, resp)
+}
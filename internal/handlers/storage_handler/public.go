@@ -0,0 +1,42 @@
+package storage_handler
+
+import (
+	"context"
+	tencentCos "github.com/Alf-Grindel/clide/internal/pkg/cos_client"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// PutObject - 本地存储驱动的预签名上传, 对应 LocalClient.PresignPutUrl 生成的地址
+func PutObject(ctx context.Context, c *app.RequestContext) {
+	client := tencentCos.NewLocalClient()
+	key := client.ObjKey(string(c.Path()))
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		resp := errno.BuildBaseResp(errno.NoAuthErr.WithMessage("上传地址无效或已过期"))
+		c.JSON(http.StatusForbidden, resp)
+		return
+	}
+	size, _ := strconv.ParseInt(c.Query("size"), 10, 64)
+	if !client.VerifySign(http.MethodPut, key, expires, size, c.Query("sign")) {
+		resp := errno.BuildBaseResp(errno.NoAuthErr.WithMessage("上传地址无效或已过期"))
+		c.JSON(http.StatusForbidden, resp)
+		return
+	}
+	// 内容长度需与签名时一致, 请求体以流的形式写入存储
+	if int64(c.Request.Header.ContentLength()) != size {
+		resp := errno.BuildBaseResp(errno.ParamErr.WithMessage("上传内容长度与申请时不一致"))
+		c.JSON(http.StatusBadRequest, resp)
+		return
+	}
+	if err = client.PutObj(ctx, key, io.LimitReader(c.Request.BodyStream(), size)); err != nil {
+		resp := errno.BuildBaseResp(errno.OperationErr.WithMessage("上传文件失败"))
+		c.JSON(http.StatusInternalServerError, resp)
+		return
+	}
+	resp := errno.BuildBaseResp(errno.Success)
+	c.JSON(http.StatusOK, resp)
+}
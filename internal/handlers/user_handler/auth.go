@@ -9,15 +9,16 @@ import (
 )
 
 func GetLoginUser(ctx context.Context, c *app.RequestContext) {
-	current, err := user_services.NewUserService(ctx).GetLoginUser(c)
+	current, quota, err := user_services.NewUserService(ctx).GetLoginUser(c)
 	if err != nil {
 		resp := errno.BuildBaseResp(err)
 		c.JSON(200, resp)
 		return
 	}
-	resp := &user.UserLoginResp{
-		Resp: errno.BuildBaseResp(errno.Success),
-		User: current,
+	resp := &user.GetLoginUserResp{
+		Resp:  errno.BuildBaseResp(errno.Success),
+		User:  current,
+		Quota: quota,
 	}
 	c.JSON(200, resp)
 }
@@ -62,7 +63,7 @@ func UserSearch(ctx context.Context, c *app.RequestContext) {
 		c.JSON(200, resp)
 		return
 	}
-	total, currents, err := user_services.NewUserService(ctx).UserSearch(&req)
+	total, currents, err := user_services.NewUserService(ctx).UserSearch(&req, c)
 	if err != nil {
 		resp := errno.BuildBaseResp(err)
 		c.JSON(200, resp)
@@ -75,3 +76,85 @@ func UserSearch(ctx context.Context, c *app.RequestContext) {
 	}
 	c.JSON(200, resp)
 }
+
+func FollowUser(ctx context.Context, c *app.RequestContext) {
+	var req user.FollowUserReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	followerCount, err := user_services.NewUserService(ctx).FollowUser(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &user.FollowUserResp{
+		Resp:          errno.BuildBaseResp(errno.Success),
+		FollowerCount: followerCount,
+	}
+	c.JSON(200, resp)
+}
+
+func UnfollowUser(ctx context.Context, c *app.RequestContext) {
+	var req user.UnfollowUserReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	followerCount, err := user_services.NewUserService(ctx).UnfollowUser(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &user.UnfollowUserResp{
+		Resp:          errno.BuildBaseResp(errno.Success),
+		FollowerCount: followerCount,
+	}
+	c.JSON(200, resp)
+}
+
+func ListFollower(ctx context.Context, c *app.RequestContext) {
+	var req user.ListFollowerReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	total, currents, err := user_services.NewUserService(ctx).ListFollower(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &user.ListFollowerResp{
+		Resp:  errno.BuildBaseResp(errno.Success),
+		Users: currents,
+		Total: total,
+	}
+	c.JSON(200, resp)
+}
+
+func ListFollowing(ctx context.Context, c *app.RequestContext) {
+	var req user.ListFollowingReq
+	if err := c.BindAndValidate(&req); err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	total, currents, err := user_services.NewUserService(ctx).ListFollowing(&req, c)
+	if err != nil {
+		resp := errno.BuildBaseResp(err)
+		c.JSON(200, resp)
+		return
+	}
+	resp := &user.ListFollowingResp{
+		Resp:  errno.BuildBaseResp(errno.Success),
+		Users: currents,
+		Total: total,
+	}
+	c.JSON(200, resp)
+}
@@ -368,20 +368,22 @@ func (p *PictureTagCategoryResp) String() string {
 }
 
 type PictureSearchReq struct {
-	ID           *int64   `thrift:"id,1,optional" form:"id" json:"id,omitempty" query:"id"`
-	PicName      *string  `thrift:"pic_name,2,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
-	Introduction *string  `thrift:"introduction,3,optional" form:"introduction" json:"introduction,omitempty" query:"introduction" vd:"$ == null || len($) < 800"`
-	Category     *string  `thrift:"category,4,optional" form:"category" json:"category,omitempty" query:"category"`
-	Tags         []string `thrift:"tags,5,optional" form:"tags" json:"tags,omitempty" query:"tags"`
-	PicSize      *int64   `thrift:"pic_size,6,optional" form:"pic_size" json:"pic_size,omitempty" query:"pic_size"`
-	PicWidth     *int32   `thrift:"pic_width,8,optional" form:"pic_width" json:"pic_width,omitempty" query:"pic_width"`
-	PicHeight    *int32   `thrift:"pic_height,9,optional" form:"pic_height" json:"pic_height,omitempty" query:"pic_height"`
-	PicScale     *float64 `thrift:"pic_scale,10,optional" form:"pic_scale" json:"pic_scale,omitempty" query:"pic_scale"`
-	PicFormat    *string  `thrift:"pic_format,11,optional" form:"pic_format" json:"pic_format,omitempty" query:"pic_format"`
-	SearchText   *string  `thrift:"search_text,12,optional" form:"search_text" json:"search_text,omitempty" query:"search_text"`
-	UserID       *int64   `thrift:"user_id,13,optional" form:"user_id" json:"user_id,omitempty" query:"user_id"`
-	CurrentPage  int64    `thrift:"current_page,14" form:"current_page" json:"current_page" query:"current_page"`
-	PageSize     int64    `thrift:"page_size,15" form:"page_size" json:"page_size" query:"page_size" vd:" $ <=  20"`
+	ID             *int64   `thrift:"id,1,optional" form:"id" json:"id,omitempty" query:"id"`
+	PicName        *string  `thrift:"pic_name,2,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
+	Introduction   *string  `thrift:"introduction,3,optional" form:"introduction" json:"introduction,omitempty" query:"introduction" vd:"$ == null || len($) < 800"`
+	Category       *string  `thrift:"category,4,optional" form:"category" json:"category,omitempty" query:"category"`
+	Tags           []string `thrift:"tags,5,optional" form:"tags" json:"tags,omitempty" query:"tags"`
+	PicSize        *int64   `thrift:"pic_size,6,optional" form:"pic_size" json:"pic_size,omitempty" query:"pic_size"`
+	PicWidth       *int32   `thrift:"pic_width,8,optional" form:"pic_width" json:"pic_width,omitempty" query:"pic_width"`
+	PicHeight      *int32   `thrift:"pic_height,9,optional" form:"pic_height" json:"pic_height,omitempty" query:"pic_height"`
+	PicScale       *float64 `thrift:"pic_scale,10,optional" form:"pic_scale" json:"pic_scale,omitempty" query:"pic_scale"`
+	PicFormat      *string  `thrift:"pic_format,11,optional" form:"pic_format" json:"pic_format,omitempty" query:"pic_format"`
+	SearchText     *string  `thrift:"search_text,12,optional" form:"search_text" json:"search_text,omitempty" query:"search_text"`
+	UserID         *int64   `thrift:"user_id,13,optional" form:"user_id" json:"user_id,omitempty" query:"user_id"`
+	CurrentPage    int64    `thrift:"current_page,14" form:"current_page" json:"current_page" query:"current_page"`
+	PageSize       int64    `thrift:"page_size,15" form:"page_size" json:"page_size" query:"page_size" vd:" $ <=  20"`
+	PicColor       *string  `thrift:"pic_color,16,optional" form:"pic_color" json:"pic_color,omitempty" query:"pic_color" vd:"$ == null || regexp('^#?[0-9a-fA-F]{6}$')"`
+	ColorTolerance *int32   `thrift:"color_tolerance,17,optional" form:"color_tolerance" json:"color_tolerance,omitempty" query:"color_tolerance" vd:"$ == null || ($ >= 0 && $ <= 441)"`
 }
 
 func NewPictureSearchReq() *PictureSearchReq {
@@ -507,6 +509,24 @@ func (p *PictureSearchReq) GetPageSize() (v int64) {
 	return p.PageSize
 }
 
+var PictureSearchReq_PicColor_DEFAULT string
+
+func (p *PictureSearchReq) GetPicColor() (v string) {
+	if !p.IsSetPicColor() {
+		return PictureSearchReq_PicColor_DEFAULT
+	}
+	return *p.PicColor
+}
+
+var PictureSearchReq_ColorTolerance_DEFAULT int32
+
+func (p *PictureSearchReq) GetColorTolerance() (v int32) {
+	if !p.IsSetColorTolerance() {
+		return PictureSearchReq_ColorTolerance_DEFAULT
+	}
+	return *p.ColorTolerance
+}
+
 var fieldIDToName_PictureSearchReq = map[int16]string{
 	1:  "id",
 	2:  "pic_name",
@@ -522,6 +542,8 @@ var fieldIDToName_PictureSearchReq = map[int16]string{
 	13: "user_id",
 	14: "current_page",
 	15: "page_size",
+	16: "pic_color",
+	17: "color_tolerance",
 }
 
 func (p *PictureSearchReq) IsSetID() bool {
@@ -572,6 +594,14 @@ func (p *PictureSearchReq) IsSetUserID() bool {
 	return p.UserID != nil
 }
 
+func (p *PictureSearchReq) IsSetPicColor() bool {
+	return p.PicColor != nil
+}
+
+func (p *PictureSearchReq) IsSetColorTolerance() bool {
+	return p.ColorTolerance != nil
+}
+
 func (p *PictureSearchReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -702,6 +732,22 @@ func (p *PictureSearchReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 16:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 17:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField17(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -897,6 +943,28 @@ func (p *PictureSearchReq) ReadField15(iprot thrift.TProtocol) error {
 	p.PageSize = _field
 	return nil
 }
+func (p *PictureSearchReq) ReadField16(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicColor = _field
+	return nil
+}
+func (p *PictureSearchReq) ReadField17(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ColorTolerance = _field
+	return nil
+}
 
 func (p *PictureSearchReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -960,6 +1028,14 @@ func (p *PictureSearchReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 15
 			goto WriteFieldError
 		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+		if err = p.writeField17(oprot); err != nil {
+			fieldId = 17
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1234,6 +1310,42 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
 }
+func (p *PictureSearchReq) writeField16(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicColor() {
+		if err = oprot.WriteFieldBegin("pic_color", thrift.STRING, 16); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PicColor); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
+}
+func (p *PictureSearchReq) writeField17(oprot thrift.TProtocol) (err error) {
+	if p.IsSetColorTolerance() {
+		if err = oprot.WriteFieldBegin("color_tolerance", thrift.I32, 17); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ColorTolerance); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+}
 
 func (p *PictureSearchReq) String() string {
 	if p == nil {
@@ -1833,86 +1945,101 @@ func (p *PictureGetByIdResp) String() string {
 
 }
 
-type PictureEditReq struct {
-	ID           int64    `thrift:"id,1" form:"id" json:"id" query:"id"`
-	PicName      *string  `thrift:"pic_name,2,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
-	Introduction *string  `thrift:"introduction,3,optional" form:"introduction" json:"introduction,omitempty" query:"introduction" vd:"$ == null || len($) < 800"`
-	Category     *string  `thrift:"category,4,optional" form:"category" json:"category,omitempty" query:"category"`
-	Tags         []string `thrift:"tags,5,optional" form:"tags" json:"tags,omitempty" query:"tags"`
+type PictureImageReq struct {
+	ID      int64   `thrift:"id,1" json:"id" path:"id"`
+	Width   *int32  `thrift:"width,2,optional" form:"width" json:"width,omitempty" query:"width" vd:"$ == null || ($ > 0 && $ <= 4096)"`
+	Height  *int32  `thrift:"height,3,optional" form:"height" json:"height,omitempty" query:"height" vd:"$ == null || ($ > 0 && $ <= 4096)"`
+	Fit     *string `thrift:"fit,4,optional" form:"fit" json:"fit,omitempty" query:"fit" vd:"$ == null || in($, 'inside', 'cover', 'contain', 'fill')"`
+	Quality *int32  `thrift:"quality,5,optional" form:"quality" json:"quality,omitempty" query:"quality" vd:"$ == null || ($ > 0 && $ <= 100)"`
+	Format  *string `thrift:"format,6,optional" form:"format" json:"format,omitempty" query:"format" vd:"$ == null || in($, 'jpeg', 'png', 'webp')"`
 }
 
-func NewPictureEditReq() *PictureEditReq {
-	return &PictureEditReq{}
+func NewPictureImageReq() *PictureImageReq {
+	return &PictureImageReq{}
 }
 
-func (p *PictureEditReq) InitDefault() {
+func (p *PictureImageReq) InitDefault() {
 }
 
-func (p *PictureEditReq) GetID() (v int64) {
+func (p *PictureImageReq) GetID() (v int64) {
 	return p.ID
 }
 
-var PictureEditReq_PicName_DEFAULT string
+var PictureImageReq_Width_DEFAULT int32
 
-func (p *PictureEditReq) GetPicName() (v string) {
-	if !p.IsSetPicName() {
-		return PictureEditReq_PicName_DEFAULT
+func (p *PictureImageReq) GetWidth() (v int32) {
+	if !p.IsSetWidth() {
+		return PictureImageReq_Width_DEFAULT
 	}
-	return *p.PicName
+	return *p.Width
 }
 
-var PictureEditReq_Introduction_DEFAULT string
+var PictureImageReq_Height_DEFAULT int32
 
-func (p *PictureEditReq) GetIntroduction() (v string) {
-	if !p.IsSetIntroduction() {
-		return PictureEditReq_Introduction_DEFAULT
+func (p *PictureImageReq) GetHeight() (v int32) {
+	if !p.IsSetHeight() {
+		return PictureImageReq_Height_DEFAULT
 	}
-	return *p.Introduction
+	return *p.Height
 }
 
-var PictureEditReq_Category_DEFAULT string
+var PictureImageReq_Fit_DEFAULT string
 
-func (p *PictureEditReq) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return PictureEditReq_Category_DEFAULT
+func (p *PictureImageReq) GetFit() (v string) {
+	if !p.IsSetFit() {
+		return PictureImageReq_Fit_DEFAULT
 	}
-	return *p.Category
+	return *p.Fit
 }
 
-var PictureEditReq_Tags_DEFAULT []string
+var PictureImageReq_Quality_DEFAULT int32
 
-func (p *PictureEditReq) GetTags() (v []string) {
-	if !p.IsSetTags() {
-		return PictureEditReq_Tags_DEFAULT
+func (p *PictureImageReq) GetQuality() (v int32) {
+	if !p.IsSetQuality() {
+		return PictureImageReq_Quality_DEFAULT
 	}
-	return p.Tags
+	return *p.Quality
 }
 
-var fieldIDToName_PictureEditReq = map[int16]string{
+var PictureImageReq_Format_DEFAULT string
+
+func (p *PictureImageReq) GetFormat() (v string) {
+	if !p.IsSetFormat() {
+		return PictureImageReq_Format_DEFAULT
+	}
+	return *p.Format
+}
+
+var fieldIDToName_PictureImageReq = map[int16]string{
 	1: "id",
-	2: "pic_name",
-	3: "introduction",
-	4: "category",
-	5: "tags",
+	2: "width",
+	3: "height",
+	4: "fit",
+	5: "quality",
+	6: "format",
 }
 
-func (p *PictureEditReq) IsSetPicName() bool {
-	return p.PicName != nil
+func (p *PictureImageReq) IsSetWidth() bool {
+	return p.Width != nil
 }
 
-func (p *PictureEditReq) IsSetIntroduction() bool {
-	return p.Introduction != nil
+func (p *PictureImageReq) IsSetHeight() bool {
+	return p.Height != nil
 }
 
-func (p *PictureEditReq) IsSetCategory() bool {
-	return p.Category != nil
+func (p *PictureImageReq) IsSetFit() bool {
+	return p.Fit != nil
 }
 
-func (p *PictureEditReq) IsSetTags() bool {
-	return p.Tags != nil
+func (p *PictureImageReq) IsSetQuality() bool {
+	return p.Quality != nil
 }
 
-func (p *PictureEditReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureImageReq) IsSetFormat() bool {
+	return p.Format != nil
+}
+
+func (p *PictureImageReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -1939,7 +2066,7 @@ func (p *PictureEditReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1947,7 +2074,7 @@ func (p *PictureEditReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -1963,13 +2090,21 @@ func (p *PictureEditReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1989,7 +2124,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureEditReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureImageReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1999,7 +2134,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureEditReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *PictureImageReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2010,29 +2145,29 @@ func (p *PictureEditReq) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *PictureEditReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *PictureImageReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.PicName = _field
+	p.Width = _field
 	return nil
 }
-func (p *PictureEditReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *PictureImageReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Introduction = _field
+	p.Height = _field
 	return nil
 }
-func (p *PictureEditReq) ReadField4(iprot thrift.TProtocol) error {
+func (p *PictureImageReq) ReadField4(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2040,36 +2175,35 @@ func (p *PictureEditReq) ReadField4(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.Category = _field
+	p.Fit = _field
 	return nil
 }
-func (p *PictureEditReq) ReadField5(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *PictureImageReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
+	p.Quality = _field
+	return nil
+}
+func (p *PictureImageReq) ReadField6(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Tags = _field
+	p.Format = _field
 	return nil
 }
 
-func (p *PictureEditReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureImageReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PictureEditReq"); err != nil {
+	if err = oprot.WriteStructBegin("PictureImageReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2093,6 +2227,10 @@ func (p *PictureEditReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2111,7 +2249,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureEditReq) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PictureImageReq) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2127,12 +2265,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *PictureEditReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPicName() {
-		if err = oprot.WriteFieldBegin("pic_name", thrift.STRING, 2); err != nil {
+func (p *PictureImageReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetWidth() {
+		if err = oprot.WriteFieldBegin("width", thrift.I32, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.PicName); err != nil {
+		if err := oprot.WriteI32(*p.Width); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2145,12 +2283,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *PictureEditReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIntroduction() {
-		if err = oprot.WriteFieldBegin("introduction", thrift.STRING, 3); err != nil {
+func (p *PictureImageReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHeight() {
+		if err = oprot.WriteFieldBegin("height", thrift.I32, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Introduction); err != nil {
+		if err := oprot.WriteI32(*p.Height); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2163,12 +2301,12 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *PictureEditReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 4); err != nil {
+func (p *PictureImageReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFit() {
+		if err = oprot.WriteFieldBegin("fit", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Category); err != nil {
+		if err := oprot.WriteString(*p.Fit); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2181,20 +2319,30 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
-func (p *PictureEditReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTags() {
-		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 5); err != nil {
+func (p *PictureImageReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQuality() {
+		if err = oprot.WriteFieldBegin("quality", thrift.I32, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+		if err := oprot.WriteI32(*p.Quality); err != nil {
 			return err
 		}
-		for _, v := range p.Tags {
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *PictureImageReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFormat() {
+		if err = oprot.WriteFieldBegin("format", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Format); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2203,48 +2351,49 @@ func (p *PictureEditReq) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *PictureEditReq) String() string {
+func (p *PictureImageReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureEditReq(%+v)", *p)
+	return fmt.Sprintf("PictureImageReq(%+v)", *p)
 
 }
 
-type PictureEditResp struct {
+// 成功时直接返回图片内容
+type PictureImageResp struct {
 	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
 
-func NewPictureEditResp() *PictureEditResp {
-	return &PictureEditResp{}
+func NewPictureImageResp() *PictureImageResp {
+	return &PictureImageResp{}
 }
 
-func (p *PictureEditResp) InitDefault() {
+func (p *PictureImageResp) InitDefault() {
 }
 
-var PictureEditResp_Base_DEFAULT *base.BaseResp
+var PictureImageResp_Base_DEFAULT *base.BaseResp
 
-func (p *PictureEditResp) GetBase() (v *base.BaseResp) {
+func (p *PictureImageResp) GetBase() (v *base.BaseResp) {
 	if !p.IsSetBase() {
-		return PictureEditResp_Base_DEFAULT
+		return PictureImageResp_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var fieldIDToName_PictureEditResp = map[int16]string{
+var fieldIDToName_PictureImageResp = map[int16]string{
 	255: "base",
 }
 
-func (p *PictureEditResp) IsSetBase() bool {
+func (p *PictureImageResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *PictureEditResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureImageResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2289,7 +2438,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureEditResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureImageResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2299,7 +2448,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureEditResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *PictureImageResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -2308,9 +2457,9 @@ func (p *PictureEditResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PictureEditResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureImageResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PictureEditResp"); err != nil {
+	if err = oprot.WriteStructBegin("PictureImageResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2336,7 +2485,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureEditResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *PictureImageResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2353,73 +2502,94 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *PictureEditResp) String() string {
+func (p *PictureImageResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureEditResp(%+v)", *p)
+	return fmt.Sprintf("PictureImageResp(%+v)", *p)
 
 }
 
-type UploadPictureReq struct {
-	ID      *int64  `thrift:"id,1,optional" form:"id" json:"id,omitempty" query:"id"`
-	FileURL *string `thrift:"file_url,2,optional" form:"file_url" json:"file_url,omitempty" query:"file_url"`
-	PicName *string `thrift:"pic_name,3,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
+type PictureEditReq struct {
+	ID           int64    `thrift:"id,1" form:"id" json:"id" query:"id"`
+	PicName      *string  `thrift:"pic_name,2,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
+	Introduction *string  `thrift:"introduction,3,optional" form:"introduction" json:"introduction,omitempty" query:"introduction" vd:"$ == null || len($) < 800"`
+	Category     *string  `thrift:"category,4,optional" form:"category" json:"category,omitempty" query:"category"`
+	Tags         []string `thrift:"tags,5,optional" form:"tags" json:"tags,omitempty" query:"tags"`
 }
 
-func NewUploadPictureReq() *UploadPictureReq {
-	return &UploadPictureReq{}
+func NewPictureEditReq() *PictureEditReq {
+	return &PictureEditReq{}
 }
 
-func (p *UploadPictureReq) InitDefault() {
+func (p *PictureEditReq) InitDefault() {
 }
 
-var UploadPictureReq_ID_DEFAULT int64
+func (p *PictureEditReq) GetID() (v int64) {
+	return p.ID
+}
 
-func (p *UploadPictureReq) GetID() (v int64) {
-	if !p.IsSetID() {
-		return UploadPictureReq_ID_DEFAULT
+var PictureEditReq_PicName_DEFAULT string
+
+func (p *PictureEditReq) GetPicName() (v string) {
+	if !p.IsSetPicName() {
+		return PictureEditReq_PicName_DEFAULT
 	}
-	return *p.ID
+	return *p.PicName
 }
 
-var UploadPictureReq_FileURL_DEFAULT string
+var PictureEditReq_Introduction_DEFAULT string
 
-func (p *UploadPictureReq) GetFileURL() (v string) {
-	if !p.IsSetFileURL() {
-		return UploadPictureReq_FileURL_DEFAULT
+func (p *PictureEditReq) GetIntroduction() (v string) {
+	if !p.IsSetIntroduction() {
+		return PictureEditReq_Introduction_DEFAULT
 	}
-	return *p.FileURL
+	return *p.Introduction
 }
 
-var UploadPictureReq_PicName_DEFAULT string
+var PictureEditReq_Category_DEFAULT string
 
-func (p *UploadPictureReq) GetPicName() (v string) {
-	if !p.IsSetPicName() {
-		return UploadPictureReq_PicName_DEFAULT
+func (p *PictureEditReq) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return PictureEditReq_Category_DEFAULT
 	}
-	return *p.PicName
+	return *p.Category
 }
 
-var fieldIDToName_UploadPictureReq = map[int16]string{
+var PictureEditReq_Tags_DEFAULT []string
+
+func (p *PictureEditReq) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return PictureEditReq_Tags_DEFAULT
+	}
+	return p.Tags
+}
+
+var fieldIDToName_PictureEditReq = map[int16]string{
 	1: "id",
-	2: "file_url",
-	3: "pic_name",
+	2: "pic_name",
+	3: "introduction",
+	4: "category",
+	5: "tags",
 }
 
-func (p *UploadPictureReq) IsSetID() bool {
-	return p.ID != nil
+func (p *PictureEditReq) IsSetPicName() bool {
+	return p.PicName != nil
 }
 
-func (p *UploadPictureReq) IsSetFileURL() bool {
-	return p.FileURL != nil
+func (p *PictureEditReq) IsSetIntroduction() bool {
+	return p.Introduction != nil
 }
 
-func (p *UploadPictureReq) IsSetPicName() bool {
-	return p.PicName != nil
+func (p *PictureEditReq) IsSetCategory() bool {
+	return p.Category != nil
 }
 
-func (p *UploadPictureReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureEditReq) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *PictureEditReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2461,6 +2631,22 @@ func (p *UploadPictureReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2480,7 +2666,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadPictureReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureEditReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2490,18 +2676,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UploadPictureReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *PictureEditReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
 	p.ID = _field
 	return nil
 }
-func (p *UploadPictureReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *PictureEditReq) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2509,10 +2695,10 @@ func (p *UploadPictureReq) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.FileURL = _field
+	p.PicName = _field
 	return nil
 }
-func (p *UploadPictureReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *PictureEditReq) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -2520,13 +2706,47 @@ func (p *UploadPictureReq) ReadField3(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.PicName = _field
+	p.Introduction = _field
 	return nil
 }
+func (p *PictureEditReq) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *UploadPictureReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Category = _field
+	return nil
+}
+func (p *PictureEditReq) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Tags = _field
+	return nil
+}
+
+func (p *PictureEditReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadPictureReq"); err != nil {
+	if err = oprot.WriteStructBegin("PictureEditReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2542,6 +2762,14 @@ func (p *UploadPictureReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2560,12 +2788,28 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UploadPictureReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *PictureEditReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *PictureEditReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicName() {
+		if err = oprot.WriteFieldBegin("pic_name", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ID); err != nil {
+		if err := oprot.WriteString(*p.PicName); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2574,16 +2818,16 @@ func (p *UploadPictureReq) writeField1(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *UploadPictureReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFileURL() {
-		if err = oprot.WriteFieldBegin("file_url", thrift.STRING, 2); err != nil {
+func (p *PictureEditReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntroduction() {
+		if err = oprot.WriteFieldBegin("introduction", thrift.STRING, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.FileURL); err != nil {
+		if err := oprot.WriteString(*p.Introduction); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2592,16 +2836,16 @@ func (p *UploadPictureReq) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *UploadPictureReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPicName() {
-		if err = oprot.WriteFieldBegin("pic_name", thrift.STRING, 3); err != nil {
+func (p *PictureEditReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCategory() {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.PicName); err != nil {
+		if err := oprot.WriteString(*p.Category); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -2610,54 +2854,74 @@ func (p *UploadPictureReq) writeField3(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *PictureEditReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTags() {
+		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+			return err
+		}
+		for _, v := range p.Tags {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *UploadPictureReq) String() string {
+func (p *PictureEditReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UploadPictureReq(%+v)", *p)
+	return fmt.Sprintf("PictureEditReq(%+v)", *p)
 
 }
 
-type UploadPictureResp struct {
-	ID   int64          `thrift:"id,1" form:"id" json:"id" query:"id"`
+type PictureEditResp struct {
 	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
 
-func NewUploadPictureResp() *UploadPictureResp {
-	return &UploadPictureResp{}
+func NewPictureEditResp() *PictureEditResp {
+	return &PictureEditResp{}
 }
 
-func (p *UploadPictureResp) InitDefault() {
+func (p *PictureEditResp) InitDefault() {
 }
 
-func (p *UploadPictureResp) GetID() (v int64) {
-	return p.ID
-}
-
-var UploadPictureResp_Base_DEFAULT *base.BaseResp
+var PictureEditResp_Base_DEFAULT *base.BaseResp
 
-func (p *UploadPictureResp) GetBase() (v *base.BaseResp) {
+func (p *PictureEditResp) GetBase() (v *base.BaseResp) {
 	if !p.IsSetBase() {
-		return UploadPictureResp_Base_DEFAULT
+		return PictureEditResp_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var fieldIDToName_UploadPictureResp = map[int16]string{
-	1:   "id",
+var fieldIDToName_PictureEditResp = map[int16]string{
 	255: "base",
 }
 
-func (p *UploadPictureResp) IsSetBase() bool {
+func (p *PictureEditResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *UploadPictureResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureEditResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2675,14 +2939,6 @@ func (p *UploadPictureResp) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -2710,7 +2966,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadPictureResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureEditResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2720,18 +2976,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UploadPictureResp) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.ID = _field
-	return nil
-}
-func (p *UploadPictureResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *PictureEditResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -2740,16 +2985,12 @@ func (p *UploadPictureResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UploadPictureResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureEditResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadPictureResp"); err != nil {
+	if err = oprot.WriteStructBegin("PictureEditResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -2772,23 +3013,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UploadPictureResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *UploadPictureResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *PictureEditResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2805,35 +3030,35 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *UploadPictureResp) String() string {
+func (p *PictureEditResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UploadPictureResp(%+v)", *p)
+	return fmt.Sprintf("PictureEditResp(%+v)", *p)
 
 }
 
-// # admin
-type DeletePictureReq struct {
+// 公共图库只能删除自己创建的, 空间内的图片需空间编辑者及以上角色
+type PictureDeleteReq struct {
 	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
 }
 
-func NewDeletePictureReq() *DeletePictureReq {
-	return &DeletePictureReq{}
+func NewPictureDeleteReq() *PictureDeleteReq {
+	return &PictureDeleteReq{}
 }
 
-func (p *DeletePictureReq) InitDefault() {
+func (p *PictureDeleteReq) InitDefault() {
 }
 
-func (p *DeletePictureReq) GetID() (v int64) {
+func (p *PictureDeleteReq) GetID() (v int64) {
 	return p.ID
 }
 
-var fieldIDToName_DeletePictureReq = map[int16]string{
+var fieldIDToName_PictureDeleteReq = map[int16]string{
 	1: "id",
 }
 
-func (p *DeletePictureReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureDeleteReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2878,7 +3103,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeletePictureReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureDeleteReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2888,7 +3113,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeletePictureReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *PictureDeleteReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2900,9 +3125,9 @@ func (p *DeletePictureReq) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DeletePictureReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureDeleteReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeletePictureReq"); err != nil {
+	if err = oprot.WriteStructBegin("PictureDeleteReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2928,7 +3153,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeletePictureReq) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PictureDeleteReq) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2945,43 +3170,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeletePictureReq) String() string {
+func (p *PictureDeleteReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeletePictureReq(%+v)", *p)
+	return fmt.Sprintf("PictureDeleteReq(%+v)", *p)
 
 }
 
-type DeletePictureResp struct {
+type PictureDeleteResp struct {
 	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
 
-func NewDeletePictureResp() *DeletePictureResp {
-	return &DeletePictureResp{}
+func NewPictureDeleteResp() *PictureDeleteResp {
+	return &PictureDeleteResp{}
 }
 
-func (p *DeletePictureResp) InitDefault() {
+func (p *PictureDeleteResp) InitDefault() {
 }
 
-var DeletePictureResp_Base_DEFAULT *base.BaseResp
+var PictureDeleteResp_Base_DEFAULT *base.BaseResp
 
-func (p *DeletePictureResp) GetBase() (v *base.BaseResp) {
+func (p *PictureDeleteResp) GetBase() (v *base.BaseResp) {
 	if !p.IsSetBase() {
-		return DeletePictureResp_Base_DEFAULT
+		return PictureDeleteResp_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var fieldIDToName_DeletePictureResp = map[int16]string{
+var fieldIDToName_PictureDeleteResp = map[int16]string{
 	255: "base",
 }
 
-func (p *DeletePictureResp) IsSetBase() bool {
+func (p *PictureDeleteResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *DeletePictureResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureDeleteResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3026,7 +3251,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeletePictureResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureDeleteResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3036,7 +3261,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeletePictureResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *PictureDeleteResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -3045,9 +3270,9 @@ func (p *DeletePictureResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DeletePictureResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureDeleteResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeletePictureResp"); err != nil {
+	if err = oprot.WriteStructBegin("PictureDeleteResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3073,7 +3298,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeletePictureResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *PictureDeleteResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3090,94 +3315,35 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *DeletePictureResp) String() string {
+func (p *PictureDeleteResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeletePictureResp(%+v)", *p)
+	return fmt.Sprintf("PictureDeleteResp(%+v)", *p)
 
 }
 
-type UpdatePictureReq struct {
-	ID           int64    `thrift:"id,1" form:"id" json:"id" query:"id"`
-	PicName      *string  `thrift:"pic_name,2,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
-	Introduction *string  `thrift:"introduction,3,optional" form:"introduction" json:"introduction,omitempty" query:"introduction" vd:"$ == null || len($) < 800"`
-	Category     *string  `thrift:"category,4,optional" form:"category" json:"category,omitempty" query:"category"`
-	Tags         []string `thrift:"tags,5,optional" form:"tags" json:"tags,omitempty" query:"tags"`
+// 已点赞时取消点赞
+type PictureLikeReq struct {
+	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
 }
 
-func NewUpdatePictureReq() *UpdatePictureReq {
-	return &UpdatePictureReq{}
+func NewPictureLikeReq() *PictureLikeReq {
+	return &PictureLikeReq{}
 }
 
-func (p *UpdatePictureReq) InitDefault() {
+func (p *PictureLikeReq) InitDefault() {
 }
 
-func (p *UpdatePictureReq) GetID() (v int64) {
+func (p *PictureLikeReq) GetID() (v int64) {
 	return p.ID
 }
 
-var UpdatePictureReq_PicName_DEFAULT string
-
-func (p *UpdatePictureReq) GetPicName() (v string) {
-	if !p.IsSetPicName() {
-		return UpdatePictureReq_PicName_DEFAULT
-	}
-	return *p.PicName
-}
-
-var UpdatePictureReq_Introduction_DEFAULT string
-
-func (p *UpdatePictureReq) GetIntroduction() (v string) {
-	if !p.IsSetIntroduction() {
-		return UpdatePictureReq_Introduction_DEFAULT
-	}
-	return *p.Introduction
-}
-
-var UpdatePictureReq_Category_DEFAULT string
-
-func (p *UpdatePictureReq) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return UpdatePictureReq_Category_DEFAULT
-	}
-	return *p.Category
-}
-
-var UpdatePictureReq_Tags_DEFAULT []string
-
-func (p *UpdatePictureReq) GetTags() (v []string) {
-	if !p.IsSetTags() {
-		return UpdatePictureReq_Tags_DEFAULT
-	}
-	return p.Tags
-}
-
-var fieldIDToName_UpdatePictureReq = map[int16]string{
+var fieldIDToName_PictureLikeReq = map[int16]string{
 	1: "id",
-	2: "pic_name",
-	3: "introduction",
-	4: "category",
-	5: "tags",
-}
-
-func (p *UpdatePictureReq) IsSetPicName() bool {
-	return p.PicName != nil
-}
-
-func (p *UpdatePictureReq) IsSetIntroduction() bool {
-	return p.Introduction != nil
-}
-
-func (p *UpdatePictureReq) IsSetCategory() bool {
-	return p.Category != nil
-}
-
-func (p *UpdatePictureReq) IsSetTags() bool {
-	return p.Tags != nil
 }
 
-func (p *UpdatePictureReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureLikeReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3203,38 +3369,6 @@ func (p *UpdatePictureReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3254,7 +3388,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdatePictureReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureLikeReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3264,7 +3398,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdatePictureReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *PictureLikeReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3275,66 +3409,205 @@ func (p *UpdatePictureReq) ReadField1(iprot thrift.TProtocol) error {
 	p.ID = _field
 	return nil
 }
-func (p *UpdatePictureReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *PictureLikeReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureLikeReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.PicName = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *UpdatePictureReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *PictureLikeReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Introduction = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdatePictureReq) ReadField4(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *PictureLikeReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.Category = _field
-	return nil
+	return fmt.Sprintf("PictureLikeReq(%+v)", *p)
+
 }
-func (p *UpdatePictureReq) ReadField5(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
+
+type PictureLikeResp struct {
+	Liked     bool           `thrift:"liked,1" form:"liked" json:"liked" query:"liked"`
+	LikeCount int64          `thrift:"like_count,2" form:"like_count" json:"like_count" query:"like_count"`
+	Base      *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewPictureLikeResp() *PictureLikeResp {
+	return &PictureLikeResp{}
+}
+
+func (p *PictureLikeResp) InitDefault() {
+}
+
+func (p *PictureLikeResp) GetLiked() (v bool) {
+	return p.Liked
+}
+
+func (p *PictureLikeResp) GetLikeCount() (v int64) {
+	return p.LikeCount
+}
+
+var PictureLikeResp_Base_DEFAULT *base.BaseResp
+
+func (p *PictureLikeResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return PictureLikeResp_Base_DEFAULT
 	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
+	return p.Base
+}
 
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
+var fieldIDToName_PictureLikeResp = map[int16]string{
+	1:   "liked",
+	2:   "like_count",
+	255: "base",
+}
+
+func (p *PictureLikeResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *PictureLikeResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
 		}
 
-		_field = append(_field, _elem)
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureLikeResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureLikeResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Tags = _field
+	p.Liked = _field
 	return nil
 }
+func (p *PictureLikeResp) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *UpdatePictureReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.LikeCount = _field
+	return nil
+}
+func (p *PictureLikeResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *PictureLikeResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdatePictureReq"); err != nil {
+	if err = oprot.WriteStructBegin("PictureLikeResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3346,16 +3619,8 @@ func (p *UpdatePictureReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
 			goto WriteFieldError
 		}
 	}
@@ -3376,11 +3641,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdatePictureReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *PictureLikeResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("liked", thrift.BOOL, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := oprot.WriteBool(p.Liked); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3392,17 +3657,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdatePictureReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPicName() {
-		if err = oprot.WriteFieldBegin("pic_name", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.PicName); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *PictureLikeResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("like_count", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.LikeCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -3410,106 +3673,52 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *UpdatePictureReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIntroduction() {
-		if err = oprot.WriteFieldBegin("introduction", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Introduction); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *PictureLikeResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *UpdatePictureReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Category); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *UpdatePictureReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTags() {
-		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
-			return err
-		}
-		for _, v := range p.Tags {
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *UpdatePictureReq) String() string {
+func (p *PictureLikeResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdatePictureReq(%+v)", *p)
-
-}
+	return fmt.Sprintf("PictureLikeResp(%+v)", *p)
 
-type UpdatePictureResp struct {
-	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
 
-func NewUpdatePictureResp() *UpdatePictureResp {
-	return &UpdatePictureResp{}
+// 已收藏时取消收藏
+type PictureFavoriteReq struct {
+	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
 }
 
-func (p *UpdatePictureResp) InitDefault() {
+func NewPictureFavoriteReq() *PictureFavoriteReq {
+	return &PictureFavoriteReq{}
 }
 
-var UpdatePictureResp_Base_DEFAULT *base.BaseResp
-
-func (p *UpdatePictureResp) GetBase() (v *base.BaseResp) {
-	if !p.IsSetBase() {
-		return UpdatePictureResp_Base_DEFAULT
-	}
-	return p.Base
+func (p *PictureFavoriteReq) InitDefault() {
 }
 
-var fieldIDToName_UpdatePictureResp = map[int16]string{
-	255: "base",
+func (p *PictureFavoriteReq) GetID() (v int64) {
+	return p.ID
 }
 
-func (p *UpdatePictureResp) IsSetBase() bool {
-	return p.Base != nil
+var fieldIDToName_PictureFavoriteReq = map[int16]string{
+	1: "id",
 }
 
-func (p *UpdatePictureResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureFavoriteReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3527,9 +3736,9 @@ func (p *UpdatePictureResp) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField255(iprot); err != nil {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -3554,7 +3763,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdatePictureResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureFavoriteReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3564,23 +3773,26 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdatePictureResp) ReadField255(iprot thrift.TProtocol) error {
-	_field := base.NewBaseResp()
-	if err := _field.Read(iprot); err != nil {
+func (p *PictureFavoriteReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Base = _field
+	p.ID = _field
 	return nil
 }
 
-func (p *UpdatePictureResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureFavoriteReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdatePictureResp"); err != nil {
+	if err = oprot.WriteStructBegin("PictureFavoriteReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField255(oprot); err != nil {
-			fieldId = 255
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
 			goto WriteFieldError
 		}
 	}
@@ -3601,11 +3813,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdatePictureResp) writeField255(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+func (p *PictureFavoriteReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Base.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3613,270 +3825,280 @@ func (p *UpdatePictureResp) writeField255(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UpdatePictureResp) String() string {
+func (p *PictureFavoriteReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdatePictureResp(%+v)", *p)
+	return fmt.Sprintf("PictureFavoriteReq(%+v)", *p)
 
 }
 
-type QueryPictureReq struct {
-	ID            *int64   `thrift:"id,1,optional" form:"id" json:"id,omitempty" query:"id"`
-	PicName       *string  `thrift:"pic_name,2,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
-	Introduction  *string  `thrift:"introduction,3,optional" form:"introduction" json:"introduction,omitempty" query:"introduction" vd:"$ == null || len($) < 800"`
-	Category      *string  `thrift:"category,4,optional" form:"category" json:"category,omitempty" query:"category"`
-	Tags          []string `thrift:"tags,5,optional" form:"tags" json:"tags,omitempty" query:"tags"`
-	PicSize       *int64   `thrift:"pic_size,6,optional" form:"pic_size" json:"pic_size,omitempty" query:"pic_size"`
-	PicWidth      *int32   `thrift:"pic_width,8,optional" form:"pic_width" json:"pic_width,omitempty" query:"pic_width"`
-	PicHeight     *int32   `thrift:"pic_height,9,optional" form:"pic_height" json:"pic_height,omitempty" query:"pic_height"`
-	PicScale      *float64 `thrift:"pic_scale,10,optional" form:"pic_scale" json:"pic_scale,omitempty" query:"pic_scale"`
-	PicFormat     *string  `thrift:"pic_format,11,optional" form:"pic_format" json:"pic_format,omitempty" query:"pic_format"`
-	SearchText    *string  `thrift:"search_text,12,optional" form:"search_text" json:"search_text,omitempty" query:"search_text"`
-	UserID        *int64   `thrift:"user_id,13,optional" form:"user_id" json:"user_id,omitempty" query:"user_id"`
-	ReviewStatus  *string  `thrift:"review_status,14,optional" form:"review_status" json:"review_status,omitempty" query:"review_status"`
-	ReviewMessage *string  `thrift:"review_message,15,optional" form:"review_message" json:"review_message,omitempty" query:"review_message"`
-	ReviewID      *int64   `thrift:"review_id,16,optional" form:"review_id" json:"review_id,omitempty" query:"review_id"`
-	CurrentPage   int64    `thrift:"current_page,17" form:"current_page" json:"current_page" query:"current_page"`
-	PageSize      int64    `thrift:"page_size,18" form:"page_size" json:"page_size" query:"page_size"`
+type PictureFavoriteResp struct {
+	Favorited     bool           `thrift:"favorited,1" form:"favorited" json:"favorited" query:"favorited"`
+	FavoriteCount int64          `thrift:"favorite_count,2" form:"favorite_count" json:"favorite_count" query:"favorite_count"`
+	Base          *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
 
-func NewQueryPictureReq() *QueryPictureReq {
-	return &QueryPictureReq{}
+func NewPictureFavoriteResp() *PictureFavoriteResp {
+	return &PictureFavoriteResp{}
 }
 
-func (p *QueryPictureReq) InitDefault() {
+func (p *PictureFavoriteResp) InitDefault() {
 }
 
-var QueryPictureReq_ID_DEFAULT int64
-
-func (p *QueryPictureReq) GetID() (v int64) {
-	if !p.IsSetID() {
-		return QueryPictureReq_ID_DEFAULT
-	}
-	return *p.ID
+func (p *PictureFavoriteResp) GetFavorited() (v bool) {
+	return p.Favorited
 }
 
-var QueryPictureReq_PicName_DEFAULT string
-
-func (p *QueryPictureReq) GetPicName() (v string) {
-	if !p.IsSetPicName() {
-		return QueryPictureReq_PicName_DEFAULT
-	}
-	return *p.PicName
+func (p *PictureFavoriteResp) GetFavoriteCount() (v int64) {
+	return p.FavoriteCount
 }
 
-var QueryPictureReq_Introduction_DEFAULT string
+var PictureFavoriteResp_Base_DEFAULT *base.BaseResp
 
-func (p *QueryPictureReq) GetIntroduction() (v string) {
-	if !p.IsSetIntroduction() {
-		return QueryPictureReq_Introduction_DEFAULT
+func (p *PictureFavoriteResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return PictureFavoriteResp_Base_DEFAULT
 	}
-	return *p.Introduction
+	return p.Base
 }
 
-var QueryPictureReq_Category_DEFAULT string
-
-func (p *QueryPictureReq) GetCategory() (v string) {
-	if !p.IsSetCategory() {
-		return QueryPictureReq_Category_DEFAULT
-	}
-	return *p.Category
+var fieldIDToName_PictureFavoriteResp = map[int16]string{
+	1:   "favorited",
+	2:   "favorite_count",
+	255: "base",
 }
 
-var QueryPictureReq_Tags_DEFAULT []string
-
-func (p *QueryPictureReq) GetTags() (v []string) {
-	if !p.IsSetTags() {
-		return QueryPictureReq_Tags_DEFAULT
-	}
-	return p.Tags
+func (p *PictureFavoriteResp) IsSetBase() bool {
+	return p.Base != nil
 }
 
-var QueryPictureReq_PicSize_DEFAULT int64
+func (p *PictureFavoriteResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *QueryPictureReq) GetPicSize() (v int64) {
-	if !p.IsSetPicSize() {
-		return QueryPictureReq_PicSize_DEFAULT
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return *p.PicSize
-}
 
-var QueryPictureReq_PicWidth_DEFAULT int32
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
 
-func (p *QueryPictureReq) GetPicWidth() (v int32) {
-	if !p.IsSetPicWidth() {
-		return QueryPictureReq_PicWidth_DEFAULT
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return *p.PicWidth
-}
-
-var QueryPictureReq_PicHeight_DEFAULT int32
-
-func (p *QueryPictureReq) GetPicHeight() (v int32) {
-	if !p.IsSetPicHeight() {
-		return QueryPictureReq_PicHeight_DEFAULT
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return *p.PicHeight
-}
 
-var QueryPictureReq_PicScale_DEFAULT float64
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureFavoriteResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
-func (p *QueryPictureReq) GetPicScale() (v float64) {
-	if !p.IsSetPicScale() {
-		return QueryPictureReq_PicScale_DEFAULT
-	}
-	return *p.PicScale
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-var QueryPictureReq_PicFormat_DEFAULT string
+func (p *PictureFavoriteResp) ReadField1(iprot thrift.TProtocol) error {
 
-func (p *QueryPictureReq) GetPicFormat() (v string) {
-	if !p.IsSetPicFormat() {
-		return QueryPictureReq_PicFormat_DEFAULT
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return *p.PicFormat
+	p.Favorited = _field
+	return nil
 }
+func (p *PictureFavoriteResp) ReadField2(iprot thrift.TProtocol) error {
 
-var QueryPictureReq_SearchText_DEFAULT string
-
-func (p *QueryPictureReq) GetSearchText() (v string) {
-	if !p.IsSetSearchText() {
-		return QueryPictureReq_SearchText_DEFAULT
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return *p.SearchText
+	p.FavoriteCount = _field
+	return nil
 }
-
-var QueryPictureReq_UserID_DEFAULT int64
-
-func (p *QueryPictureReq) GetUserID() (v int64) {
-	if !p.IsSetUserID() {
-		return QueryPictureReq_UserID_DEFAULT
+func (p *PictureFavoriteResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	return *p.UserID
+	p.Base = _field
+	return nil
 }
 
-var QueryPictureReq_ReviewStatus_DEFAULT string
-
-func (p *QueryPictureReq) GetReviewStatus() (v string) {
-	if !p.IsSetReviewStatus() {
-		return QueryPictureReq_ReviewStatus_DEFAULT
+func (p *PictureFavoriteResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureFavoriteResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	return *p.ReviewStatus
-}
-
-var QueryPictureReq_ReviewMessage_DEFAULT string
-
-func (p *QueryPictureReq) GetReviewMessage() (v string) {
-	if !p.IsSetReviewMessage() {
-		return QueryPictureReq_ReviewMessage_DEFAULT
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	return *p.ReviewMessage
-}
-
-var QueryPictureReq_ReviewID_DEFAULT int64
-
-func (p *QueryPictureReq) GetReviewID() (v int64) {
-	if !p.IsSetReviewID() {
-		return QueryPictureReq_ReviewID_DEFAULT
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return *p.ReviewID
-}
-
-func (p *QueryPictureReq) GetCurrentPage() (v int64) {
-	return p.CurrentPage
-}
-
-func (p *QueryPictureReq) GetPageSize() (v int64) {
-	return p.PageSize
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-var fieldIDToName_QueryPictureReq = map[int16]string{
-	1:  "id",
-	2:  "pic_name",
-	3:  "introduction",
-	4:  "category",
-	5:  "tags",
-	6:  "pic_size",
-	8:  "pic_width",
-	9:  "pic_height",
-	10: "pic_scale",
-	11: "pic_format",
-	12: "search_text",
-	13: "user_id",
-	14: "review_status",
-	15: "review_message",
-	16: "review_id",
-	17: "current_page",
-	18: "page_size",
-}
-
-func (p *QueryPictureReq) IsSetID() bool {
-	return p.ID != nil
-}
-
-func (p *QueryPictureReq) IsSetPicName() bool {
-	return p.PicName != nil
-}
-
-func (p *QueryPictureReq) IsSetIntroduction() bool {
-	return p.Introduction != nil
-}
-
-func (p *QueryPictureReq) IsSetCategory() bool {
-	return p.Category != nil
-}
-
-func (p *QueryPictureReq) IsSetTags() bool {
-	return p.Tags != nil
+func (p *PictureFavoriteResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("favorited", thrift.BOOL, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Favorited); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-
-func (p *QueryPictureReq) IsSetPicSize() bool {
-	return p.PicSize != nil
+func (p *PictureFavoriteResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("favorite_count", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.FavoriteCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-
-func (p *QueryPictureReq) IsSetPicWidth() bool {
-	return p.PicWidth != nil
+func (p *PictureFavoriteResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *QueryPictureReq) IsSetPicHeight() bool {
-	return p.PicHeight != nil
-}
+func (p *PictureFavoriteResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureFavoriteResp(%+v)", *p)
 
-func (p *QueryPictureReq) IsSetPicScale() bool {
-	return p.PicScale != nil
 }
 
-func (p *QueryPictureReq) IsSetPicFormat() bool {
-	return p.PicFormat != nil
+type ListFavoritePictureReq struct {
+	CurrentPage int64 `thrift:"current_page,1" form:"current_page" json:"current_page" query:"current_page"`
+	PageSize    int64 `thrift:"page_size,2" form:"page_size" json:"page_size" query:"page_size" vd:" $ <=  20"`
 }
 
-func (p *QueryPictureReq) IsSetSearchText() bool {
-	return p.SearchText != nil
+func NewListFavoritePictureReq() *ListFavoritePictureReq {
+	return &ListFavoritePictureReq{}
 }
 
-func (p *QueryPictureReq) IsSetUserID() bool {
-	return p.UserID != nil
+func (p *ListFavoritePictureReq) InitDefault() {
 }
 
-func (p *QueryPictureReq) IsSetReviewStatus() bool {
-	return p.ReviewStatus != nil
+func (p *ListFavoritePictureReq) GetCurrentPage() (v int64) {
+	return p.CurrentPage
 }
 
-func (p *QueryPictureReq) IsSetReviewMessage() bool {
-	return p.ReviewMessage != nil
+func (p *ListFavoritePictureReq) GetPageSize() (v int64) {
+	return p.PageSize
 }
 
-func (p *QueryPictureReq) IsSetReviewID() bool {
-	return p.ReviewID != nil
+var fieldIDToName_ListFavoritePictureReq = map[int16]string{
+	1: "current_page",
+	2: "page_size",
 }
 
-func (p *QueryPictureReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListFavoritePictureReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3903,128 +4125,8 @@ func (p *QueryPictureReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 8:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField8(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 9:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField9(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 10:
-			if fieldTypeId == thrift.DOUBLE {
-				if err = p.ReadField10(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 11:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField11(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 12:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField12(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 13:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField13(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 14:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField14(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 15:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField15(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 16:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField16(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 17:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField17(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 18:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField18(iprot); err != nil {
+				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -4049,7 +4151,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryPictureReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListFavoritePictureReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4059,195 +4161,214 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *QueryPictureReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListFavoritePictureReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.ID = _field
+	p.CurrentPage = _field
 	return nil
 }
-func (p *QueryPictureReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *ListFavoritePictureReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.PicName = _field
+	p.PageSize = _field
 	return nil
 }
-func (p *QueryPictureReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *ListFavoritePictureReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListFavoritePictureReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	p.Introduction = _field
-	return nil
-}
-func (p *QueryPictureReq) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	p.Category = _field
 	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *QueryPictureReq) ReadField5(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
 
-		_field = append(_field, _elem)
+func (p *ListFavoritePictureReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("current_page", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	if err := oprot.WriteI64(p.CurrentPage); err != nil {
 		return err
 	}
-	p.Tags = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *QueryPictureReq) ReadField6(iprot thrift.TProtocol) error {
-
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *ListFavoritePictureReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PageSize); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.PicSize = _field
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
 	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *QueryPictureReq) ReadField8(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
+func (p *ListFavoritePictureReq) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	p.PicWidth = _field
-	return nil
+	return fmt.Sprintf("ListFavoritePictureReq(%+v)", *p)
+
 }
-func (p *QueryPictureReq) ReadField9(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.PicHeight = _field
-	return nil
+type ListFavoritePictureResp struct {
+	Total    int64             `thrift:"total,1" form:"total" json:"total" query:"total"`
+	Pictures []*base.PictureVo `thrift:"pictures,2" form:"pictures" json:"pictures" query:"pictures"`
+	Base     *base.BaseResp    `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
-func (p *QueryPictureReq) ReadField10(iprot thrift.TProtocol) error {
 
-	var _field *float64
-	if v, err := iprot.ReadDouble(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.PicScale = _field
-	return nil
+func NewListFavoritePictureResp() *ListFavoritePictureResp {
+	return &ListFavoritePictureResp{}
 }
-func (p *QueryPictureReq) ReadField11(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.PicFormat = _field
-	return nil
+func (p *ListFavoritePictureResp) InitDefault() {
 }
-func (p *QueryPictureReq) ReadField12(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.SearchText = _field
-	return nil
+func (p *ListFavoritePictureResp) GetTotal() (v int64) {
+	return p.Total
 }
-func (p *QueryPictureReq) ReadField13(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserID = _field
-	return nil
+func (p *ListFavoritePictureResp) GetPictures() (v []*base.PictureVo) {
+	return p.Pictures
 }
-func (p *QueryPictureReq) ReadField14(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
+var ListFavoritePictureResp_Base_DEFAULT *base.BaseResp
+
+func (p *ListFavoritePictureResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return ListFavoritePictureResp_Base_DEFAULT
 	}
-	p.ReviewStatus = _field
-	return nil
+	return p.Base
 }
-func (p *QueryPictureReq) ReadField15(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ReviewMessage = _field
-	return nil
+var fieldIDToName_ListFavoritePictureResp = map[int16]string{
+	1:   "total",
+	2:   "pictures",
+	255: "base",
 }
-func (p *QueryPictureReq) ReadField16(iprot thrift.TProtocol) error {
 
-	var _field *int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.ReviewID = _field
-	return nil
+func (p *ListFavoritePictureResp) IsSetBase() bool {
+	return p.Base != nil
 }
-func (p *QueryPictureReq) ReadField17(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
+func (p *ListFavoritePictureResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	p.CurrentPage = _field
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
 	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListFavoritePictureResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *QueryPictureReq) ReadField18(iprot thrift.TProtocol) error {
+
+func (p *ListFavoritePictureResp) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4255,82 +4376,57 @@ func (p *QueryPictureReq) ReadField18(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.PageSize = _field
+	p.Total = _field
 	return nil
 }
-
-func (p *QueryPictureReq) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryPictureReq"); err != nil {
-		goto WriteStructBeginError
+func (p *ListFavoritePictureResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
 	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
-		if err = p.writeField8(oprot); err != nil {
-			fieldId = 8
-			goto WriteFieldError
-		}
-		if err = p.writeField9(oprot); err != nil {
-			fieldId = 9
-			goto WriteFieldError
-		}
-		if err = p.writeField10(oprot); err != nil {
-			fieldId = 10
-			goto WriteFieldError
-		}
-		if err = p.writeField11(oprot); err != nil {
-			fieldId = 11
-			goto WriteFieldError
-		}
-		if err = p.writeField12(oprot); err != nil {
-			fieldId = 12
-			goto WriteFieldError
-		}
-		if err = p.writeField13(oprot); err != nil {
-			fieldId = 13
-			goto WriteFieldError
-		}
-		if err = p.writeField14(oprot); err != nil {
-			fieldId = 14
-			goto WriteFieldError
-		}
-		if err = p.writeField15(oprot); err != nil {
-			fieldId = 15
-			goto WriteFieldError
+	_field := make([]*base.PictureVo, 0, size)
+	values := make([]base.PictureVo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
 		}
-		if err = p.writeField16(oprot); err != nil {
-			fieldId = 16
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Pictures = _field
+	return nil
+}
+func (p *ListFavoritePictureResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ListFavoritePictureResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListFavoritePictureResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField17(oprot); err != nil {
-			fieldId = 17
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField18(oprot); err != nil {
-			fieldId = 18
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
 			goto WriteFieldError
 		}
 	}
@@ -4351,17 +4447,15 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryPictureReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.ID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ListFavoritePictureResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -4369,17 +4463,23 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *QueryPictureReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPicName() {
-		if err = oprot.WriteFieldBegin("pic_name", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.PicName); err != nil {
+func (p *ListFavoritePictureResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pictures", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Pictures)); err != nil {
+		return err
+	}
+	for _, v := range p.Pictures {
+		if err := v.Write(oprot); err != nil {
 			return err
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -4387,236 +4487,190 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *QueryPictureReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIntroduction() {
-		if err = oprot.WriteFieldBegin("introduction", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Introduction); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ListFavoritePictureResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
-func (p *QueryPictureReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCategory() {
-		if err = oprot.WriteFieldBegin("category", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Category); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+func (p *ListFavoritePictureResp) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return fmt.Sprintf("ListFavoritePictureResp(%+v)", *p)
+
 }
-func (p *QueryPictureReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTags() {
-		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 5); err != nil {
-			goto WriteFieldBeginError
+
+// 游标为上一页返回的 next_cursor, 为空时从最新的图片开始
+type PictureFeedReq struct {
+	Cursor   *string `thrift:"cursor,1,optional" form:"cursor" json:"cursor,omitempty" query:"cursor"`
+	PageSize int64   `thrift:"page_size,2" form:"page_size" json:"page_size" query:"page_size" vd:" $ <=  20"`
+}
+
+func NewPictureFeedReq() *PictureFeedReq {
+	return &PictureFeedReq{}
+}
+
+func (p *PictureFeedReq) InitDefault() {
+}
+
+var PictureFeedReq_Cursor_DEFAULT string
+
+func (p *PictureFeedReq) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return PictureFeedReq_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *PictureFeedReq) GetPageSize() (v int64) {
+	return p.PageSize
+}
+
+var fieldIDToName_PictureFeedReq = map[int16]string{
+	1: "cursor",
+	2: "page_size",
+}
+
+func (p *PictureFeedReq) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *PictureFeedReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
-			return err
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		for _, v := range p.Tags {
-			if err := oprot.WriteString(v); err != nil {
-				return err
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
 			}
 		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
 		}
 	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
-}
-func (p *QueryPictureReq) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPicSize() {
-		if err = oprot.WriteFieldBegin("pic_size", thrift.I64, 6); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.PicSize); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
-}
-func (p *QueryPictureReq) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPicWidth() {
-		if err = oprot.WriteFieldBegin("pic_width", thrift.I32, 8); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.PicWidth); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
-}
-func (p *QueryPictureReq) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPicHeight() {
-		if err = oprot.WriteFieldBegin("pic_height", thrift.I32, 9); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.PicHeight); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureFeedReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *QueryPictureReq) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPicScale() {
-		if err = oprot.WriteFieldBegin("pic_scale", thrift.DOUBLE, 10); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteDouble(*p.PicScale); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+func (p *PictureFeedReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
+	p.Cursor = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
 }
-func (p *QueryPictureReq) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPicFormat() {
-		if err = oprot.WriteFieldBegin("pic_format", thrift.STRING, 11); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.PicFormat); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *PictureFeedReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
+	p.PageSize = _field
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
-func (p *QueryPictureReq) writeField12(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSearchText() {
-		if err = oprot.WriteFieldBegin("search_text", thrift.STRING, 12); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.SearchText); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+
+func (p *PictureFeedReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureFeedReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
-}
-func (p *QueryPictureReq) writeField13(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserID() {
-		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 13); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.UserID); err != nil {
-			return err
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
 		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
 		}
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
-}
-func (p *QueryPictureReq) writeField14(oprot thrift.TProtocol) (err error) {
-	if p.IsSetReviewStatus() {
-		if err = oprot.WriteFieldBegin("review_status", thrift.STRING, 14); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.ReviewStatus); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
-}
-func (p *QueryPictureReq) writeField15(oprot thrift.TProtocol) (err error) {
-	if p.IsSetReviewMessage() {
-		if err = oprot.WriteFieldBegin("review_message", thrift.STRING, 15); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.ReviewMessage); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
 	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *QueryPictureReq) writeField16(oprot thrift.TProtocol) (err error) {
-	if p.IsSetReviewID() {
-		if err = oprot.WriteFieldBegin("review_id", thrift.I64, 16); err != nil {
+
+func (p *PictureFeedReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCursor() {
+		if err = oprot.WriteFieldBegin("cursor", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.ReviewID); err != nil {
+		if err := oprot.WriteString(*p.Cursor); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4625,28 +4679,12 @@ func (p *QueryPictureReq) writeField16(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
-}
-func (p *QueryPictureReq) writeField17(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("current_page", thrift.I64, 17); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.CurrentPage); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *QueryPictureReq) writeField18(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("page_size", thrift.I64, 18); err != nil {
+func (p *PictureFeedReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := oprot.WriteI64(p.PageSize); err != nil {
@@ -4657,60 +4695,66 @@ func (p *QueryPictureReq) writeField18(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 18 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *QueryPictureReq) String() string {
+func (p *PictureFeedReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryPictureReq(%+v)", *p)
+	return fmt.Sprintf("PictureFeedReq(%+v)", *p)
 
 }
 
-type QueryPictureResp struct {
-	Total    int64           `thrift:"total,1" form:"total" json:"total" query:"total"`
-	Pictures []*base.Picture `thrift:"pictures,2" form:"pictures" json:"pictures" query:"pictures"`
-	Base     *base.BaseResp  `thrift:"base,255" form:"base" json:"base" query:"base"`
+type PictureFeedResp struct {
+	Pictures   []*base.PictureVo `thrift:"pictures,1" form:"pictures" json:"pictures" query:"pictures"`
+	NextCursor string            `thrift:"next_cursor,2" form:"next_cursor" json:"next_cursor" query:"next_cursor"`
+	HasMore    bool              `thrift:"has_more,3" form:"has_more" json:"has_more" query:"has_more"`
+	Base       *base.BaseResp    `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
 
-func NewQueryPictureResp() *QueryPictureResp {
-	return &QueryPictureResp{}
+func NewPictureFeedResp() *PictureFeedResp {
+	return &PictureFeedResp{}
 }
 
-func (p *QueryPictureResp) InitDefault() {
+func (p *PictureFeedResp) InitDefault() {
 }
 
-func (p *QueryPictureResp) GetTotal() (v int64) {
-	return p.Total
+func (p *PictureFeedResp) GetPictures() (v []*base.PictureVo) {
+	return p.Pictures
 }
 
-func (p *QueryPictureResp) GetPictures() (v []*base.Picture) {
-	return p.Pictures
+func (p *PictureFeedResp) GetNextCursor() (v string) {
+	return p.NextCursor
 }
 
-var QueryPictureResp_Base_DEFAULT *base.BaseResp
+func (p *PictureFeedResp) GetHasMore() (v bool) {
+	return p.HasMore
+}
 
-func (p *QueryPictureResp) GetBase() (v *base.BaseResp) {
+var PictureFeedResp_Base_DEFAULT *base.BaseResp
+
+func (p *PictureFeedResp) GetBase() (v *base.BaseResp) {
 	if !p.IsSetBase() {
-		return QueryPictureResp_Base_DEFAULT
+		return PictureFeedResp_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var fieldIDToName_QueryPictureResp = map[int16]string{
-	1:   "total",
-	2:   "pictures",
+var fieldIDToName_PictureFeedResp = map[int16]string{
+	1:   "pictures",
+	2:   "next_cursor",
+	3:   "has_more",
 	255: "base",
 }
 
-func (p *QueryPictureResp) IsSetBase() bool {
+func (p *PictureFeedResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *QueryPictureResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureFeedResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4729,7 +4773,7 @@ func (p *QueryPictureResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4737,16 +4781,24 @@ func (p *QueryPictureResp) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 255:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField255(iprot); err != nil {
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -4771,7 +4823,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryPictureResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureFeedResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4781,24 +4833,13 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *QueryPictureResp) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
-		return err
-	} else {
-		_field = v
-	}
-	p.Total = _field
-	return nil
-}
-func (p *QueryPictureResp) ReadField2(iprot thrift.TProtocol) error {
+func (p *PictureFeedResp) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*base.Picture, 0, size)
-	values := make([]base.Picture, size)
+	_field := make([]*base.PictureVo, 0, size)
+	values := make([]base.PictureVo, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -4815,7 +4856,29 @@ func (p *QueryPictureResp) ReadField2(iprot thrift.TProtocol) error {
 	p.Pictures = _field
 	return nil
 }
-func (p *QueryPictureResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *PictureFeedResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.NextCursor = _field
+	return nil
+}
+func (p *PictureFeedResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.HasMore = _field
+	return nil
+}
+func (p *PictureFeedResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4824,9 +4887,9 @@ func (p *QueryPictureResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryPictureResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureFeedResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryPictureResp"); err != nil {
+	if err = oprot.WriteStructBegin("PictureFeedResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4838,6 +4901,10 @@ func (p *QueryPictureResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -4860,11 +4927,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryPictureResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("total", thrift.I64, 1); err != nil {
+func (p *PictureFeedResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pictures", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.Total); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Pictures)); err != nil {
+		return err
+	}
+	for _, v := range p.Pictures {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4876,19 +4951,27 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *QueryPictureResp) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("pictures", thrift.LIST, 2); err != nil {
+func (p *PictureFeedResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("next_cursor", thrift.STRING, 2); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Pictures)); err != nil {
+	if err := oprot.WriteString(p.NextCursor); err != nil {
 		return err
 	}
-	for _, v := range p.Pictures {
-		if err := v.Write(oprot); err != nil {
-			return err
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err := oprot.WriteListEnd(); err != nil {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *PictureFeedResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("has_more", thrift.BOOL, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.HasMore); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4896,11 +4979,11 @@ func (p *QueryPictureResp) writeField2(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-func (p *QueryPictureResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *PictureFeedResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4917,34 +5000,104 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *QueryPictureResp) String() string {
+func (p *PictureFeedResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryPictureResp(%+v)", *p)
+	return fmt.Sprintf("PictureFeedResp(%+v)", *p)
 
 }
 
-type QueryPictureByIdReq struct {
-	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
+type UploadPictureReq struct {
+	ID      *int64  `thrift:"id,1,optional" form:"id" json:"id,omitempty" query:"id"`
+	FileURL *string `thrift:"file_url,2,optional" form:"file_url" json:"file_url,omitempty" query:"file_url"`
+	PicName *string `thrift:"pic_name,3,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
+	KeepGps *bool   `thrift:"keep_gps,4,optional" form:"keep_gps" json:"keep_gps,omitempty" query:"keep_gps"`
+	// 上传至私有空间, 为空时上传至公共图库
+	SpaceID *int64 `thrift:"space_id,5,optional" form:"space_id" json:"space_id,omitempty" query:"space_id"`
 }
 
-func NewQueryPictureByIdReq() *QueryPictureByIdReq {
-	return &QueryPictureByIdReq{}
+func NewUploadPictureReq() *UploadPictureReq {
+	return &UploadPictureReq{}
 }
 
-func (p *QueryPictureByIdReq) InitDefault() {
+func (p *UploadPictureReq) InitDefault() {
 }
 
-func (p *QueryPictureByIdReq) GetID() (v int64) {
-	return p.ID
+var UploadPictureReq_ID_DEFAULT int64
+
+func (p *UploadPictureReq) GetID() (v int64) {
+	if !p.IsSetID() {
+		return UploadPictureReq_ID_DEFAULT
+	}
+	return *p.ID
 }
 
-var fieldIDToName_QueryPictureByIdReq = map[int16]string{
+var UploadPictureReq_FileURL_DEFAULT string
+
+func (p *UploadPictureReq) GetFileURL() (v string) {
+	if !p.IsSetFileURL() {
+		return UploadPictureReq_FileURL_DEFAULT
+	}
+	return *p.FileURL
+}
+
+var UploadPictureReq_PicName_DEFAULT string
+
+func (p *UploadPictureReq) GetPicName() (v string) {
+	if !p.IsSetPicName() {
+		return UploadPictureReq_PicName_DEFAULT
+	}
+	return *p.PicName
+}
+
+var UploadPictureReq_KeepGps_DEFAULT bool
+
+func (p *UploadPictureReq) GetKeepGps() (v bool) {
+	if !p.IsSetKeepGps() {
+		return UploadPictureReq_KeepGps_DEFAULT
+	}
+	return *p.KeepGps
+}
+
+var UploadPictureReq_SpaceID_DEFAULT int64
+
+func (p *UploadPictureReq) GetSpaceID() (v int64) {
+	if !p.IsSetSpaceID() {
+		return UploadPictureReq_SpaceID_DEFAULT
+	}
+	return *p.SpaceID
+}
+
+var fieldIDToName_UploadPictureReq = map[int16]string{
 	1: "id",
+	2: "file_url",
+	3: "pic_name",
+	4: "keep_gps",
+	5: "space_id",
 }
 
-func (p *QueryPictureByIdReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *UploadPictureReq) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *UploadPictureReq) IsSetFileURL() bool {
+	return p.FileURL != nil
+}
+
+func (p *UploadPictureReq) IsSetPicName() bool {
+	return p.PicName != nil
+}
+
+func (p *UploadPictureReq) IsSetKeepGps() bool {
+	return p.KeepGps != nil
+}
+
+func (p *UploadPictureReq) IsSetSpaceID() bool {
+	return p.SpaceID != nil
+}
+
+func (p *UploadPictureReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4970,6 +5123,38 @@ func (p *QueryPictureByIdReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4989,7 +5174,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryPictureByIdReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadPictureReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4999,21 +5184,65 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *QueryPictureByIdReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *UploadPictureReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
 	p.ID = _field
 	return nil
 }
+func (p *UploadPictureReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *QueryPictureByIdReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FileURL = _field
+	return nil
+}
+func (p *UploadPictureReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicName = _field
+	return nil
+}
+func (p *UploadPictureReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KeepGps = _field
+	return nil
+}
+func (p *UploadPictureReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SpaceID = _field
+	return nil
+}
+
+func (p *UploadPictureReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryPictureByIdReq"); err != nil {
+	if err = oprot.WriteStructBegin("UploadPictureReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5021,6 +5250,22 @@ func (p *QueryPictureByIdReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5039,15 +5284,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryPictureByIdReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *UploadPictureReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -5055,59 +5302,122 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *UploadPictureReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFileURL() {
+		if err = oprot.WriteFieldBegin("file_url", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FileURL); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UploadPictureReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicName() {
+		if err = oprot.WriteFieldBegin("pic_name", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PicName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *UploadPictureReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeepGps() {
+		if err = oprot.WriteFieldBegin("keep_gps", thrift.BOOL, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.KeepGps); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *UploadPictureReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSpaceID() {
+		if err = oprot.WriteFieldBegin("space_id", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SpaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
 
-func (p *QueryPictureByIdReq) String() string {
+func (p *UploadPictureReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryPictureByIdReq(%+v)", *p)
+	return fmt.Sprintf("UploadPictureReq(%+v)", *p)
 
 }
 
-type QueryPictureByIdResp struct {
-	Picture *base.Picture  `thrift:"picture,1" form:"picture" json:"picture" query:"picture"`
-	Base    *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+type UploadPictureResp struct {
+	ID   int64          `thrift:"id,1" form:"id" json:"id" query:"id"`
+	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
 
-func NewQueryPictureByIdResp() *QueryPictureByIdResp {
-	return &QueryPictureByIdResp{}
+func NewUploadPictureResp() *UploadPictureResp {
+	return &UploadPictureResp{}
 }
 
-func (p *QueryPictureByIdResp) InitDefault() {
+func (p *UploadPictureResp) InitDefault() {
 }
 
-var QueryPictureByIdResp_Picture_DEFAULT *base.Picture
-
-func (p *QueryPictureByIdResp) GetPicture() (v *base.Picture) {
-	if !p.IsSetPicture() {
-		return QueryPictureByIdResp_Picture_DEFAULT
-	}
-	return p.Picture
+func (p *UploadPictureResp) GetID() (v int64) {
+	return p.ID
 }
 
-var QueryPictureByIdResp_Base_DEFAULT *base.BaseResp
+var UploadPictureResp_Base_DEFAULT *base.BaseResp
 
-func (p *QueryPictureByIdResp) GetBase() (v *base.BaseResp) {
+func (p *UploadPictureResp) GetBase() (v *base.BaseResp) {
 	if !p.IsSetBase() {
-		return QueryPictureByIdResp_Base_DEFAULT
+		return UploadPictureResp_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var fieldIDToName_QueryPictureByIdResp = map[int16]string{
-	1:   "picture",
+var fieldIDToName_UploadPictureResp = map[int16]string{
+	1:   "id",
 	255: "base",
 }
 
-func (p *QueryPictureByIdResp) IsSetPicture() bool {
-	return p.Picture != nil
-}
-
-func (p *QueryPictureByIdResp) IsSetBase() bool {
+func (p *UploadPictureResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *QueryPictureByIdResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *UploadPictureResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5126,7 +5436,7 @@ func (p *QueryPictureByIdResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5160,7 +5470,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryPictureByIdResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadPictureResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5170,15 +5480,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *QueryPictureByIdResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := base.NewPicture()
-	if err := _field.Read(iprot); err != nil {
+func (p *UploadPictureResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.Picture = _field
+	p.ID = _field
 	return nil
 }
-func (p *QueryPictureByIdResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *UploadPictureResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -5187,9 +5500,9 @@ func (p *QueryPictureByIdResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryPictureByIdResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *UploadPictureResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryPictureByIdResp"); err != nil {
+	if err = oprot.WriteStructBegin("UploadPictureResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5219,11 +5532,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryPictureByIdResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("picture", thrift.STRUCT, 1); err != nil {
+func (p *UploadPictureResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.Picture.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5235,7 +5548,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *QueryPictureByIdResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *UploadPictureResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5252,46 +5565,73 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *QueryPictureByIdResp) String() string {
+func (p *UploadPictureResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryPictureByIdResp(%+v)", *p)
+	return fmt.Sprintf("UploadPictureResp(%+v)", *p)
 
 }
 
-type ReviewPictureReq struct {
-	ID            int64  `thrift:"id,1" form:"id" json:"id" query:"id"`
-	ReviewStatus  string `thrift:"review_status,2" form:"review_status" json:"review_status" query:"review_status"`
-	ReviewMessage string `thrift:"review_message,3" form:"review_message" json:"review_message" query:"review_message"`
+type SearchSimilarPictureReq struct {
+	ID          *int64 `thrift:"id,1,optional" form:"id" json:"id,omitempty" query:"id"`
+	MaxDistance *int32 `thrift:"max_distance,2,optional" form:"max_distance" json:"max_distance,omitempty" query:"max_distance" vd:"$ == null || ($ >= 0 && $ <= 64)"`
+	Limit       *int32 `thrift:"limit,3,optional" form:"limit" json:"limit,omitempty" query:"limit" vd:"$ == null || ($ > 0 && $ <= 50)"`
 }
 
-func NewReviewPictureReq() *ReviewPictureReq {
-	return &ReviewPictureReq{}
+func NewSearchSimilarPictureReq() *SearchSimilarPictureReq {
+	return &SearchSimilarPictureReq{}
 }
 
-func (p *ReviewPictureReq) InitDefault() {
+func (p *SearchSimilarPictureReq) InitDefault() {
 }
 
-func (p *ReviewPictureReq) GetID() (v int64) {
-	return p.ID
+var SearchSimilarPictureReq_ID_DEFAULT int64
+
+func (p *SearchSimilarPictureReq) GetID() (v int64) {
+	if !p.IsSetID() {
+		return SearchSimilarPictureReq_ID_DEFAULT
+	}
+	return *p.ID
 }
 
-func (p *ReviewPictureReq) GetReviewStatus() (v string) {
-	return p.ReviewStatus
+var SearchSimilarPictureReq_MaxDistance_DEFAULT int32
+
+func (p *SearchSimilarPictureReq) GetMaxDistance() (v int32) {
+	if !p.IsSetMaxDistance() {
+		return SearchSimilarPictureReq_MaxDistance_DEFAULT
+	}
+	return *p.MaxDistance
 }
 
-func (p *ReviewPictureReq) GetReviewMessage() (v string) {
-	return p.ReviewMessage
+var SearchSimilarPictureReq_Limit_DEFAULT int32
+
+func (p *SearchSimilarPictureReq) GetLimit() (v int32) {
+	if !p.IsSetLimit() {
+		return SearchSimilarPictureReq_Limit_DEFAULT
+	}
+	return *p.Limit
 }
 
-var fieldIDToName_ReviewPictureReq = map[int16]string{
+var fieldIDToName_SearchSimilarPictureReq = map[int16]string{
 	1: "id",
-	2: "review_status",
-	3: "review_message",
+	2: "max_distance",
+	3: "limit",
 }
 
-func (p *ReviewPictureReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *SearchSimilarPictureReq) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *SearchSimilarPictureReq) IsSetMaxDistance() bool {
+	return p.MaxDistance != nil
+}
+
+func (p *SearchSimilarPictureReq) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *SearchSimilarPictureReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5318,7 +5658,7 @@ func (p *ReviewPictureReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5326,7 +5666,7 @@ func (p *ReviewPictureReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I32 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5352,7 +5692,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewPictureReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchSimilarPictureReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5362,43 +5702,43 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ReviewPictureReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *SearchSimilarPictureReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
+	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
 	p.ID = _field
 	return nil
 }
-func (p *ReviewPictureReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *SearchSimilarPictureReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.ReviewStatus = _field
+	p.MaxDistance = _field
 	return nil
 }
-func (p *ReviewPictureReq) ReadField3(iprot thrift.TProtocol) error {
+func (p *SearchSimilarPictureReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
 		return err
 	} else {
-		_field = v
+		_field = &v
 	}
-	p.ReviewMessage = _field
+	p.Limit = _field
 	return nil
 }
 
-func (p *ReviewPictureReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *SearchSimilarPictureReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReviewPictureReq"); err != nil {
+	if err = oprot.WriteStructBegin("SearchSimilarPictureReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5432,15 +5772,17 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReviewPictureReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteI64(p.ID); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *SearchSimilarPictureReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -5448,15 +5790,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *ReviewPictureReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("review_status", thrift.STRING, 2); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ReviewStatus); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *SearchSimilarPictureReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxDistance() {
+		if err = oprot.WriteFieldBegin("max_distance", thrift.I32, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.MaxDistance); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -5464,15 +5808,17 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *ReviewPictureReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("review_message", thrift.STRING, 3); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := oprot.WriteString(p.ReviewMessage); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *SearchSimilarPictureReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLimit() {
+		if err = oprot.WriteFieldBegin("limit", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Limit); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
@@ -5481,43 +5827,49 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *ReviewPictureReq) String() string {
+func (p *SearchSimilarPictureReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReviewPictureReq(%+v)", *p)
+	return fmt.Sprintf("SearchSimilarPictureReq(%+v)", *p)
 
 }
 
-type ReviewPictureResp struct {
-	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+type SearchSimilarPictureResp struct {
+	Pictures []*base.PictureVo `thrift:"pictures,1" form:"pictures" json:"pictures" query:"pictures"`
+	Base     *base.BaseResp    `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
 
-func NewReviewPictureResp() *ReviewPictureResp {
-	return &ReviewPictureResp{}
+func NewSearchSimilarPictureResp() *SearchSimilarPictureResp {
+	return &SearchSimilarPictureResp{}
 }
 
-func (p *ReviewPictureResp) InitDefault() {
+func (p *SearchSimilarPictureResp) InitDefault() {
 }
 
-var ReviewPictureResp_Base_DEFAULT *base.BaseResp
+func (p *SearchSimilarPictureResp) GetPictures() (v []*base.PictureVo) {
+	return p.Pictures
+}
 
-func (p *ReviewPictureResp) GetBase() (v *base.BaseResp) {
+var SearchSimilarPictureResp_Base_DEFAULT *base.BaseResp
+
+func (p *SearchSimilarPictureResp) GetBase() (v *base.BaseResp) {
 	if !p.IsSetBase() {
-		return ReviewPictureResp_Base_DEFAULT
+		return SearchSimilarPictureResp_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var fieldIDToName_ReviewPictureResp = map[int16]string{
+var fieldIDToName_SearchSimilarPictureResp = map[int16]string{
+	1:   "pictures",
 	255: "base",
 }
 
-func (p *ReviewPictureResp) IsSetBase() bool {
+func (p *SearchSimilarPictureResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *ReviewPictureResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *SearchSimilarPictureResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5535,6 +5887,14 @@ func (p *ReviewPictureResp) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -5562,7 +5922,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewPictureResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SearchSimilarPictureResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5572,7 +5932,30 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ReviewPictureResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *SearchSimilarPictureResp) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*base.PictureVo, 0, size)
+	values := make([]base.PictureVo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Pictures = _field
+	return nil
+}
+func (p *SearchSimilarPictureResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -5581,12 +5964,16 @@ func (p *ReviewPictureResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ReviewPictureResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *SearchSimilarPictureResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ReviewPictureResp"); err != nil {
+	if err = oprot.WriteStructBegin("SearchSimilarPictureResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -5609,7 +5996,31 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ReviewPictureResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *SearchSimilarPictureResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pictures", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Pictures)); err != nil {
+		return err
+	}
+	for _, v := range p.Pictures {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *SearchSimilarPictureResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5626,49 +6037,100 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *ReviewPictureResp) String() string {
+func (p *SearchSimilarPictureResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReviewPictureResp(%+v)", *p)
+	return fmt.Sprintf("SearchSimilarPictureResp(%+v)", *p)
 
 }
 
-type UploadPictureByBatchReq struct {
-	SearchText  string `thrift:"search_text,1" form:"search_text" json:"search_text" query:"search_text"`
-	UploadCount *int64 `thrift:"upload_count,2,optional" form:"upload_count" json:"upload_count,omitempty" query:"upload_count" vd:" $ == null || $ < 30 "`
+type InitUploadSessionReq struct {
+	FileName  string  `thrift:"file_name,1" form:"file_name" json:"file_name" query:"file_name" vd:"len($) > 0"`
+	FileSize  int64   `thrift:"file_size,2" form:"file_size" json:"file_size" query:"file_size" vd:"$ > 0"`
+	ChunkSize *int64  `thrift:"chunk_size,3,optional" form:"chunk_size" json:"chunk_size,omitempty" query:"chunk_size"`
+	ID        *int64  `thrift:"id,4,optional" form:"id" json:"id,omitempty" query:"id"`
+	PicName   *string `thrift:"pic_name,5,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
+	KeepGps   *bool   `thrift:"keep_gps,6,optional" form:"keep_gps" json:"keep_gps,omitempty" query:"keep_gps"`
 }
 
-func NewUploadPictureByBatchReq() *UploadPictureByBatchReq {
-	return &UploadPictureByBatchReq{}
+func NewInitUploadSessionReq() *InitUploadSessionReq {
+	return &InitUploadSessionReq{}
 }
 
-func (p *UploadPictureByBatchReq) InitDefault() {
+func (p *InitUploadSessionReq) InitDefault() {
 }
 
-func (p *UploadPictureByBatchReq) GetSearchText() (v string) {
-	return p.SearchText
+func (p *InitUploadSessionReq) GetFileName() (v string) {
+	return p.FileName
 }
 
-var UploadPictureByBatchReq_UploadCount_DEFAULT int64
+func (p *InitUploadSessionReq) GetFileSize() (v int64) {
+	return p.FileSize
+}
 
-func (p *UploadPictureByBatchReq) GetUploadCount() (v int64) {
-	if !p.IsSetUploadCount() {
-		return UploadPictureByBatchReq_UploadCount_DEFAULT
+var InitUploadSessionReq_ChunkSize_DEFAULT int64
+
+func (p *InitUploadSessionReq) GetChunkSize() (v int64) {
+	if !p.IsSetChunkSize() {
+		return InitUploadSessionReq_ChunkSize_DEFAULT
 	}
-	return *p.UploadCount
+	return *p.ChunkSize
 }
 
-var fieldIDToName_UploadPictureByBatchReq = map[int16]string{
-	1: "search_text",
-	2: "upload_count",
+var InitUploadSessionReq_ID_DEFAULT int64
+
+func (p *InitUploadSessionReq) GetID() (v int64) {
+	if !p.IsSetID() {
+		return InitUploadSessionReq_ID_DEFAULT
+	}
+	return *p.ID
 }
 
-func (p *UploadPictureByBatchReq) IsSetUploadCount() bool {
-	return p.UploadCount != nil
+var InitUploadSessionReq_PicName_DEFAULT string
+
+func (p *InitUploadSessionReq) GetPicName() (v string) {
+	if !p.IsSetPicName() {
+		return InitUploadSessionReq_PicName_DEFAULT
+	}
+	return *p.PicName
 }
 
-func (p *UploadPictureByBatchReq) Read(iprot thrift.TProtocol) (err error) {
+var InitUploadSessionReq_KeepGps_DEFAULT bool
+
+func (p *InitUploadSessionReq) GetKeepGps() (v bool) {
+	if !p.IsSetKeepGps() {
+		return InitUploadSessionReq_KeepGps_DEFAULT
+	}
+	return *p.KeepGps
+}
+
+var fieldIDToName_InitUploadSessionReq = map[int16]string{
+	1: "file_name",
+	2: "file_size",
+	3: "chunk_size",
+	4: "id",
+	5: "pic_name",
+	6: "keep_gps",
+}
+
+func (p *InitUploadSessionReq) IsSetChunkSize() bool {
+	return p.ChunkSize != nil
+}
+
+func (p *InitUploadSessionReq) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *InitUploadSessionReq) IsSetPicName() bool {
+	return p.PicName != nil
+}
+
+func (p *InitUploadSessionReq) IsSetKeepGps() bool {
+	return p.KeepGps != nil
+}
+
+func (p *InitUploadSessionReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5702,6 +6164,38 @@ func (p *UploadPictureByBatchReq) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5721,7 +6215,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadPictureByBatchReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InitUploadSessionReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5731,7 +6225,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UploadPictureByBatchReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *InitUploadSessionReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5739,10 +6233,21 @@ func (p *UploadPictureByBatchReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.SearchText = _field
+	p.FileName = _field
 	return nil
 }
-func (p *UploadPictureByBatchReq) ReadField2(iprot thrift.TProtocol) error {
+func (p *InitUploadSessionReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FileSize = _field
+	return nil
+}
+func (p *InitUploadSessionReq) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5750,13 +6255,46 @@ func (p *UploadPictureByBatchReq) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.UploadCount = _field
+	p.ChunkSize = _field
 	return nil
 }
+func (p *InitUploadSessionReq) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *UploadPictureByBatchReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *InitUploadSessionReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicName = _field
+	return nil
+}
+func (p *InitUploadSessionReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KeepGps = _field
+	return nil
+}
+
+func (p *InitUploadSessionReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadPictureByBatchReq"); err != nil {
+	if err = oprot.WriteStructBegin("InitUploadSessionReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5768,6 +6306,22 @@ func (p *UploadPictureByBatchReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5786,11 +6340,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UploadPictureByBatchReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("search_text", thrift.STRING, 1); err != nil {
+func (p *InitUploadSessionReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("file_name", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.SearchText); err != nil {
+	if err := oprot.WriteString(p.FileName); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5802,12 +6356,28 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UploadPictureByBatchReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUploadCount() {
-		if err = oprot.WriteFieldBegin("upload_count", thrift.I64, 2); err != nil {
+func (p *InitUploadSessionReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("file_size", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.FileSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *InitUploadSessionReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChunkSize() {
+		if err = oprot.WriteFieldBegin("chunk_size", thrift.I64, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteI64(*p.UploadCount); err != nil {
+		if err := oprot.WriteI64(*p.ChunkSize); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5816,54 +6386,126 @@ func (p *UploadPictureByBatchReq) writeField2(oprot thrift.TProtocol) (err error
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
-
-func (p *UploadPictureByBatchReq) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("UploadPictureByBatchReq(%+v)", *p)
-
+func (p *InitUploadSessionReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *InitUploadSessionReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicName() {
+		if err = oprot.WriteFieldBegin("pic_name", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PicName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *InitUploadSessionReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeepGps() {
+		if err = oprot.WriteFieldBegin("keep_gps", thrift.BOOL, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.KeepGps); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-type UploadPictureByBatchResp struct {
-	UploadCount int64          `thrift:"upload_count,1" form:"upload_count" json:"upload_count" query:"upload_count"`
-	Base        *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+func (p *InitUploadSessionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InitUploadSessionReq(%+v)", *p)
+
 }
 
-func NewUploadPictureByBatchResp() *UploadPictureByBatchResp {
-	return &UploadPictureByBatchResp{}
+type InitUploadSessionResp struct {
+	UploadID   int64          `thrift:"upload_id,1" form:"upload_id" json:"upload_id" query:"upload_id"`
+	ChunkSize  int64          `thrift:"chunk_size,2" form:"chunk_size" json:"chunk_size" query:"chunk_size"`
+	ChunkCount int32          `thrift:"chunk_count,3" form:"chunk_count" json:"chunk_count" query:"chunk_count"`
+	ExpireTime string         `thrift:"expire_time,4" form:"expire_time" json:"expire_time" query:"expire_time"`
+	Base       *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
 
-func (p *UploadPictureByBatchResp) InitDefault() {
+func NewInitUploadSessionResp() *InitUploadSessionResp {
+	return &InitUploadSessionResp{}
 }
 
-func (p *UploadPictureByBatchResp) GetUploadCount() (v int64) {
-	return p.UploadCount
+func (p *InitUploadSessionResp) InitDefault() {
 }
 
-var UploadPictureByBatchResp_Base_DEFAULT *base.BaseResp
+func (p *InitUploadSessionResp) GetUploadID() (v int64) {
+	return p.UploadID
+}
 
-func (p *UploadPictureByBatchResp) GetBase() (v *base.BaseResp) {
+func (p *InitUploadSessionResp) GetChunkSize() (v int64) {
+	return p.ChunkSize
+}
+
+func (p *InitUploadSessionResp) GetChunkCount() (v int32) {
+	return p.ChunkCount
+}
+
+func (p *InitUploadSessionResp) GetExpireTime() (v string) {
+	return p.ExpireTime
+}
+
+var InitUploadSessionResp_Base_DEFAULT *base.BaseResp
+
+func (p *InitUploadSessionResp) GetBase() (v *base.BaseResp) {
 	if !p.IsSetBase() {
-		return UploadPictureByBatchResp_Base_DEFAULT
+		return InitUploadSessionResp_Base_DEFAULT
 	}
 	return p.Base
 }
 
-var fieldIDToName_UploadPictureByBatchResp = map[int16]string{
-	1:   "upload_count",
+var fieldIDToName_InitUploadSessionResp = map[int16]string{
+	1:   "upload_id",
+	2:   "chunk_size",
+	3:   "chunk_count",
+	4:   "expire_time",
 	255: "base",
 }
 
-func (p *UploadPictureByBatchResp) IsSetBase() bool {
+func (p *InitUploadSessionResp) IsSetBase() bool {
 	return p.Base != nil
 }
 
-func (p *UploadPictureByBatchResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *InitUploadSessionResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5889,6 +6531,30 @@ func (p *UploadPictureByBatchResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -5916,7 +6582,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadPictureByBatchResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_InitUploadSessionResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5926,7 +6592,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UploadPictureByBatchResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *InitUploadSessionResp) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -5934,10 +6600,43 @@ func (p *UploadPictureByBatchResp) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.UploadCount = _field
+	p.UploadID = _field
 	return nil
 }
-func (p *UploadPictureByBatchResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *InitUploadSessionResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ChunkSize = _field
+	return nil
+}
+func (p *InitUploadSessionResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ChunkCount = _field
+	return nil
+}
+func (p *InitUploadSessionResp) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExpireTime = _field
+	return nil
+}
+func (p *InitUploadSessionResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -5946,9 +6645,9 @@ func (p *UploadPictureByBatchResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UploadPictureByBatchResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *InitUploadSessionResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadPictureByBatchResp"); err != nil {
+	if err = oprot.WriteStructBegin("InitUploadSessionResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5956,6 +6655,18 @@ func (p *UploadPictureByBatchResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -5978,11 +6689,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UploadPictureByBatchResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("upload_count", thrift.I64, 1); err != nil {
+func (p *InitUploadSessionResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("upload_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.UploadCount); err != nil {
+	if err := oprot.WriteI64(p.UploadID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -5994,7 +6705,55 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UploadPictureByBatchResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *InitUploadSessionResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("chunk_size", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ChunkSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *InitUploadSessionResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("chunk_count", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ChunkCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *InitUploadSessionResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expire_time", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ExpireTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *InitUploadSessionResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6011,773 +6770,12574 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *UploadPictureByBatchResp) String() string {
+func (p *InitUploadSessionResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UploadPictureByBatchResp(%+v)", *p)
+	return fmt.Sprintf("InitUploadSessionResp(%+v)", *p)
 
 }
 
-type PictureService interface {
-	//# public
-	PictureListTagCategory(ctx context.Context, req *PictureTagCategoryReq) (r *PictureTagCategoryResp, err error)
-
-	PictureSearch(ctx context.Context, req *PictureSearchReq) (r *PictureSearchResp, err error)
-
-	PictureGetById(ctx context.Context, req *PictureGetByIdReq) (r *PictureGetByIdResp, err error)
-	//# auth
-	PictureEdit(ctx context.Context, req *PictureEditReq) (r *PictureEditResp, err error)
-
-	UploadPicture(ctx context.Context, req *UploadPictureReq) (r *UploadPictureResp, err error)
-	//# admin
-	DeletePicture(ctx context.Context, req *DeletePictureReq) (r *DeletePictureResp, err error)
-
-	UpdatePicture(ctx context.Context, req *UpdatePictureReq) (r *UpdatePictureResp, err error)
-
-	QueryPicture(ctx context.Context, req *QueryPictureReq) (r *QueryPictureResp, err error)
-
-	QueryPictureById(ctx context.Context, req *QueryPictureByIdReq) (r *QueryPictureByIdResp, err error)
-
-	ReviewPicture(ctx context.Context, req *ReviewPictureReq) (r *ReviewPictureResp, err error)
-
-	UploadPictureByBatch(ctx context.Context, req *UploadPictureByBatchReq) (r *UploadPictureByBatchResp, err error)
+type UploadSessionChunkReq struct {
+	UploadID int64 `thrift:"upload_id,1" form:"upload_id" json:"upload_id" query:"upload_id"`
+	Index    int32 `thrift:"index,2" form:"index" json:"index" query:"index" vd:"$ >= 0"`
 }
 
-type PictureServiceClient struct {
-	c thrift.TClient
+func NewUploadSessionChunkReq() *UploadSessionChunkReq {
+	return &UploadSessionChunkReq{}
 }
 
-func NewPictureServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *PictureServiceClient {
-	return &PictureServiceClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *UploadSessionChunkReq) InitDefault() {
 }
 
-func NewPictureServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *PictureServiceClient {
-	return &PictureServiceClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *UploadSessionChunkReq) GetUploadID() (v int64) {
+	return p.UploadID
 }
 
-func NewPictureServiceClient(c thrift.TClient) *PictureServiceClient {
-	return &PictureServiceClient{
-		c: c,
-	}
+func (p *UploadSessionChunkReq) GetIndex() (v int32) {
+	return p.Index
 }
 
-func (p *PictureServiceClient) Client_() thrift.TClient {
-	return p.c
+var fieldIDToName_UploadSessionChunkReq = map[int16]string{
+	1: "upload_id",
+	2: "index",
 }
 
-func (p *PictureServiceClient) PictureListTagCategory(ctx context.Context, req *PictureTagCategoryReq) (r *PictureTagCategoryResp, err error) {
-	var _args PictureServicePictureListTagCategoryArgs
-	_args.Req = req
-	var _result PictureServicePictureListTagCategoryResult
-	if err = p.Client_().Call(ctx, "PictureListTagCategory", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *PictureServiceClient) PictureSearch(ctx context.Context, req *PictureSearchReq) (r *PictureSearchResp, err error) {
-	var _args PictureServicePictureSearchArgs
-	_args.Req = req
-	var _result PictureServicePictureSearchResult
-	if err = p.Client_().Call(ctx, "PictureSearch", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *PictureServiceClient) PictureGetById(ctx context.Context, req *PictureGetByIdReq) (r *PictureGetByIdResp, err error) {
-	var _args PictureServicePictureGetByIdArgs
-	_args.Req = req
-	var _result PictureServicePictureGetByIdResult
-	if err = p.Client_().Call(ctx, "PictureGetById", &_args, &_result); err != nil {
-		return
+func (p *UploadSessionChunkReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *PictureServiceClient) PictureEdit(ctx context.Context, req *PictureEditReq) (r *PictureEditResp, err error) {
-	var _args PictureServicePictureEditArgs
-	_args.Req = req
-	var _result PictureServicePictureEditResult
-	if err = p.Client_().Call(ctx, "PictureEdit", &_args, &_result); err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *PictureServiceClient) UploadPicture(ctx context.Context, req *UploadPictureReq) (r *UploadPictureResp, err error) {
-	var _args PictureServiceUploadPictureArgs
-	_args.Req = req
-	var _result PictureServiceUploadPictureResult
-	if err = p.Client_().Call(ctx, "UploadPicture", &_args, &_result); err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return _result.GetSuccess(), nil
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadSessionChunkReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *PictureServiceClient) DeletePicture(ctx context.Context, req *DeletePictureReq) (r *DeletePictureResp, err error) {
-	var _args PictureServiceDeletePictureArgs
-	_args.Req = req
-	var _result PictureServiceDeletePictureResult
-	if err = p.Client_().Call(ctx, "DeletePicture", &_args, &_result); err != nil {
-		return
+
+func (p *UploadSessionChunkReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.UploadID = _field
+	return nil
 }
-func (p *PictureServiceClient) UpdatePicture(ctx context.Context, req *UpdatePictureReq) (r *UpdatePictureResp, err error) {
-	var _args PictureServiceUpdatePictureArgs
-	_args.Req = req
-	var _result PictureServiceUpdatePictureResult
-	if err = p.Client_().Call(ctx, "UpdatePicture", &_args, &_result); err != nil {
-		return
+func (p *UploadSessionChunkReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.Index = _field
+	return nil
 }
-func (p *PictureServiceClient) QueryPicture(ctx context.Context, req *QueryPictureReq) (r *QueryPictureResp, err error) {
-	var _args PictureServiceQueryPictureArgs
-	_args.Req = req
-	var _result PictureServiceQueryPictureResult
-	if err = p.Client_().Call(ctx, "QueryPicture", &_args, &_result); err != nil {
-		return
+
+func (p *UploadSessionChunkReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadSessionChunkReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *PictureServiceClient) QueryPictureById(ctx context.Context, req *QueryPictureByIdReq) (r *QueryPictureByIdResp, err error) {
-	var _args PictureServiceQueryPictureByIdArgs
-	_args.Req = req
-	var _result PictureServiceQueryPictureByIdResult
-	if err = p.Client_().Call(ctx, "QueryPictureById", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *PictureServiceClient) ReviewPicture(ctx context.Context, req *ReviewPictureReq) (r *ReviewPictureResp, err error) {
-	var _args PictureServiceReviewPictureArgs
-	_args.Req = req
-	var _result PictureServiceReviewPictureResult
-	if err = p.Client_().Call(ctx, "ReviewPicture", &_args, &_result); err != nil {
-		return
+
+func (p *UploadSessionChunkReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("upload_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
+	if err := oprot.WriteI64(p.UploadID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *PictureServiceClient) UploadPictureByBatch(ctx context.Context, req *UploadPictureByBatchReq) (r *UploadPictureByBatchResp, err error) {
-	var _args PictureServiceUploadPictureByBatchArgs
-	_args.Req = req
-	var _result PictureServiceUploadPictureByBatchResult
-	if err = p.Client_().Call(ctx, "UploadPictureByBatch", &_args, &_result); err != nil {
-		return
+func (p *UploadSessionChunkReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("index", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
+	if err := oprot.WriteI32(p.Index); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-type PictureServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      PictureService
-}
+func (p *UploadSessionChunkReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadSessionChunkReq(%+v)", *p)
 
-func (p *PictureServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
 }
 
-func (p *PictureServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+type UploadSessionChunkResp struct {
+	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
 }
 
-func (p *PictureServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func NewUploadSessionChunkResp() *UploadSessionChunkResp {
+	return &UploadSessionChunkResp{}
 }
 
-func NewPictureServiceProcessor(handler PictureService) *PictureServiceProcessor {
-	self := &PictureServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("PictureListTagCategory", &pictureServiceProcessorPictureListTagCategory{handler: handler})
-	self.AddToProcessorMap("PictureSearch", &pictureServiceProcessorPictureSearch{handler: handler})
-	self.AddToProcessorMap("PictureGetById", &pictureServiceProcessorPictureGetById{handler: handler})
-	self.AddToProcessorMap("PictureEdit", &pictureServiceProcessorPictureEdit{handler: handler})
-	self.AddToProcessorMap("UploadPicture", &pictureServiceProcessorUploadPicture{handler: handler})
-	self.AddToProcessorMap("DeletePicture", &pictureServiceProcessorDeletePicture{handler: handler})
-	self.AddToProcessorMap("UpdatePicture", &pictureServiceProcessorUpdatePicture{handler: handler})
-	self.AddToProcessorMap("QueryPicture", &pictureServiceProcessorQueryPicture{handler: handler})
-	self.AddToProcessorMap("QueryPictureById", &pictureServiceProcessorQueryPictureById{handler: handler})
-	self.AddToProcessorMap("ReviewPicture", &pictureServiceProcessorReviewPicture{handler: handler})
-	self.AddToProcessorMap("UploadPictureByBatch", &pictureServiceProcessorUploadPictureByBatch{handler: handler})
-	return self
+func (p *UploadSessionChunkResp) InitDefault() {
 }
-func (p *PictureServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+
+var UploadSessionChunkResp_Base_DEFAULT *base.BaseResp
+
+func (p *UploadSessionChunkResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return UploadSessionChunkResp_Base_DEFAULT
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return p.Base
 }
 
-type pictureServiceProcessorPictureListTagCategory struct {
-	handler PictureService
+var fieldIDToName_UploadSessionChunkResp = map[int16]string{
+	255: "base",
 }
 
-func (p *pictureServiceProcessorPictureListTagCategory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServicePictureListTagCategoryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("PictureListTagCategory", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *UploadSessionChunkResp) IsSetBase() bool {
+	return p.Base != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServicePictureListTagCategoryResult{}
-	var retval *PictureTagCategoryResp
-	if retval, err2 = p.handler.PictureListTagCategory(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureListTagCategory: "+err2.Error())
-		oprot.WriteMessageBegin("PictureListTagCategory", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *UploadSessionChunkResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("PictureListTagCategory", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type pictureServiceProcessorPictureSearch struct {
-	handler PictureService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadSessionChunkResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *pictureServiceProcessorPictureSearch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServicePictureSearchArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("PictureSearch", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UploadSessionChunkResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Base = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServicePictureSearchResult{}
-	var retval *PictureSearchResp
-	if retval, err2 = p.handler.PictureSearch(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureSearch: "+err2.Error())
-		oprot.WriteMessageBegin("PictureSearch", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("PictureSearch", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *UploadSessionChunkResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadSessionChunkResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
-}
-
-type pictureServiceProcessorPictureGetById struct {
-	handler PictureService
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *pictureServiceProcessorPictureGetById) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServicePictureGetByIdArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("PictureGetById", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServicePictureGetByIdResult{}
-	var retval *PictureGetByIdResp
-	if retval, err2 = p.handler.PictureGetById(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureGetById: "+err2.Error())
-		oprot.WriteMessageBegin("PictureGetById", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("PictureGetById", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *UploadSessionChunkResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := p.Base.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UploadSessionChunkResp) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("UploadSessionChunkResp(%+v)", *p)
+
 }
 
-type pictureServiceProcessorPictureEdit struct {
-	handler PictureService
+type GetUploadSessionReq struct {
+	UploadID int64 `thrift:"upload_id,1" form:"upload_id" json:"upload_id" query:"upload_id"`
 }
 
-func (p *pictureServiceProcessorPictureEdit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServicePictureEditArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("PictureEdit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewGetUploadSessionReq() *GetUploadSessionReq {
+	return &GetUploadSessionReq{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServicePictureEditResult{}
-	var retval *PictureEditResp
-	if retval, err2 = p.handler.PictureEdit(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureEdit: "+err2.Error())
-		oprot.WriteMessageBegin("PictureEdit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("PictureEdit", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func (p *GetUploadSessionReq) InitDefault() {
 }
 
-type pictureServiceProcessorUploadPicture struct {
-	handler PictureService
+func (p *GetUploadSessionReq) GetUploadID() (v int64) {
+	return p.UploadID
 }
 
-func (p *pictureServiceProcessorUploadPicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServiceUploadPictureArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UploadPicture", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var fieldIDToName_GetUploadSessionReq = map[int16]string{
+	1: "upload_id",
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServiceUploadPictureResult{}
-	var retval *UploadPictureResp
-	if retval, err2 = p.handler.UploadPicture(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadPicture: "+err2.Error())
-		oprot.WriteMessageBegin("UploadPicture", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UploadPicture", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+func (p *GetUploadSessionReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUploadSessionReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetUploadSessionReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UploadID = _field
+	return nil
+}
+
+func (p *GetUploadSessionReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUploadSessionReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUploadSessionReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("upload_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UploadID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetUploadSessionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUploadSessionReq(%+v)", *p)
+
+}
+
+type GetUploadSessionResp struct {
+	UploadID       int64          `thrift:"upload_id,1" form:"upload_id" json:"upload_id" query:"upload_id"`
+	FileSize       int64          `thrift:"file_size,2" form:"file_size" json:"file_size" query:"file_size"`
+	ChunkSize      int64          `thrift:"chunk_size,3" form:"chunk_size" json:"chunk_size" query:"chunk_size"`
+	ChunkCount     int32          `thrift:"chunk_count,4" form:"chunk_count" json:"chunk_count" query:"chunk_count"`
+	UploadedChunks []int32        `thrift:"uploaded_chunks,5" form:"uploaded_chunks" json:"uploaded_chunks" query:"uploaded_chunks"`
+	ExpireTime     string         `thrift:"expire_time,6" form:"expire_time" json:"expire_time" query:"expire_time"`
+	Base           *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewGetUploadSessionResp() *GetUploadSessionResp {
+	return &GetUploadSessionResp{}
+}
+
+func (p *GetUploadSessionResp) InitDefault() {
+}
+
+func (p *GetUploadSessionResp) GetUploadID() (v int64) {
+	return p.UploadID
+}
+
+func (p *GetUploadSessionResp) GetFileSize() (v int64) {
+	return p.FileSize
+}
+
+func (p *GetUploadSessionResp) GetChunkSize() (v int64) {
+	return p.ChunkSize
+}
+
+func (p *GetUploadSessionResp) GetChunkCount() (v int32) {
+	return p.ChunkCount
+}
+
+func (p *GetUploadSessionResp) GetUploadedChunks() (v []int32) {
+	return p.UploadedChunks
+}
+
+func (p *GetUploadSessionResp) GetExpireTime() (v string) {
+	return p.ExpireTime
+}
+
+var GetUploadSessionResp_Base_DEFAULT *base.BaseResp
+
+func (p *GetUploadSessionResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return GetUploadSessionResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_GetUploadSessionResp = map[int16]string{
+	1:   "upload_id",
+	2:   "file_size",
+	3:   "chunk_size",
+	4:   "chunk_count",
+	5:   "uploaded_chunks",
+	6:   "expire_time",
+	255: "base",
+}
+
+func (p *GetUploadSessionResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetUploadSessionResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUploadSessionResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetUploadSessionResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UploadID = _field
+	return nil
+}
+func (p *GetUploadSessionResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FileSize = _field
+	return nil
+}
+func (p *GetUploadSessionResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ChunkSize = _field
+	return nil
+}
+func (p *GetUploadSessionResp) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ChunkCount = _field
+	return nil
+}
+func (p *GetUploadSessionResp) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]int32, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.UploadedChunks = _field
+	return nil
+}
+func (p *GetUploadSessionResp) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExpireTime = _field
+	return nil
+}
+func (p *GetUploadSessionResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *GetUploadSessionResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUploadSessionResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUploadSessionResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("upload_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UploadID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetUploadSessionResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("file_size", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.FileSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *GetUploadSessionResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("chunk_size", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ChunkSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *GetUploadSessionResp) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("chunk_count", thrift.I32, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.ChunkCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *GetUploadSessionResp) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("uploaded_chunks", thrift.LIST, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.I32, len(p.UploadedChunks)); err != nil {
+		return err
+	}
+	for _, v := range p.UploadedChunks {
+		if err := oprot.WriteI32(v); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *GetUploadSessionResp) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expire_time", thrift.STRING, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ExpireTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *GetUploadSessionResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetUploadSessionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUploadSessionResp(%+v)", *p)
+
+}
+
+type CompleteUploadSessionReq struct {
+	UploadID int64 `thrift:"upload_id,1" form:"upload_id" json:"upload_id" query:"upload_id"`
+}
+
+func NewCompleteUploadSessionReq() *CompleteUploadSessionReq {
+	return &CompleteUploadSessionReq{}
+}
+
+func (p *CompleteUploadSessionReq) InitDefault() {
+}
+
+func (p *CompleteUploadSessionReq) GetUploadID() (v int64) {
+	return p.UploadID
+}
+
+var fieldIDToName_CompleteUploadSessionReq = map[int16]string{
+	1: "upload_id",
+}
+
+func (p *CompleteUploadSessionReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompleteUploadSessionReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CompleteUploadSessionReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UploadID = _field
+	return nil
+}
+
+func (p *CompleteUploadSessionReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CompleteUploadSessionReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CompleteUploadSessionReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("upload_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UploadID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *CompleteUploadSessionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompleteUploadSessionReq(%+v)", *p)
+
+}
+
+type CompleteUploadSessionResp struct {
+	ID   int64          `thrift:"id,1" form:"id" json:"id" query:"id"`
+	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewCompleteUploadSessionResp() *CompleteUploadSessionResp {
+	return &CompleteUploadSessionResp{}
+}
+
+func (p *CompleteUploadSessionResp) InitDefault() {
+}
+
+func (p *CompleteUploadSessionResp) GetID() (v int64) {
+	return p.ID
+}
+
+var CompleteUploadSessionResp_Base_DEFAULT *base.BaseResp
+
+func (p *CompleteUploadSessionResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return CompleteUploadSessionResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_CompleteUploadSessionResp = map[int16]string{
+	1:   "id",
+	255: "base",
+}
+
+func (p *CompleteUploadSessionResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CompleteUploadSessionResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CompleteUploadSessionResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *CompleteUploadSessionResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *CompleteUploadSessionResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *CompleteUploadSessionResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CompleteUploadSessionResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *CompleteUploadSessionResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *CompleteUploadSessionResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *CompleteUploadSessionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompleteUploadSessionResp(%+v)", *p)
+
+}
+
+type AbortUploadSessionReq struct {
+	UploadID int64 `thrift:"upload_id,1" form:"upload_id" json:"upload_id" query:"upload_id"`
+}
+
+func NewAbortUploadSessionReq() *AbortUploadSessionReq {
+	return &AbortUploadSessionReq{}
+}
+
+func (p *AbortUploadSessionReq) InitDefault() {
+}
+
+func (p *AbortUploadSessionReq) GetUploadID() (v int64) {
+	return p.UploadID
+}
+
+var fieldIDToName_AbortUploadSessionReq = map[int16]string{
+	1: "upload_id",
+}
+
+func (p *AbortUploadSessionReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AbortUploadSessionReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AbortUploadSessionReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UploadID = _field
+	return nil
+}
+
+func (p *AbortUploadSessionReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AbortUploadSessionReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AbortUploadSessionReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("upload_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UploadID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AbortUploadSessionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AbortUploadSessionReq(%+v)", *p)
+
+}
+
+type AbortUploadSessionResp struct {
+	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewAbortUploadSessionResp() *AbortUploadSessionResp {
+	return &AbortUploadSessionResp{}
+}
+
+func (p *AbortUploadSessionResp) InitDefault() {
+}
+
+var AbortUploadSessionResp_Base_DEFAULT *base.BaseResp
+
+func (p *AbortUploadSessionResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return AbortUploadSessionResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_AbortUploadSessionResp = map[int16]string{
+	255: "base",
+}
+
+func (p *AbortUploadSessionResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *AbortUploadSessionResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AbortUploadSessionResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AbortUploadSessionResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *AbortUploadSessionResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AbortUploadSessionResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AbortUploadSessionResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *AbortUploadSessionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AbortUploadSessionResp(%+v)", *p)
+
+}
+
+// 上传内容的长度需与 file_size 一致
+type PresignUploadReq struct {
+	FileName string `thrift:"file_name,1" form:"file_name" json:"file_name" query:"file_name" vd:"len($) > 0"`
+	FileSize int64  `thrift:"file_size,2" form:"file_size" json:"file_size" query:"file_size" vd:"$ > 0"`
+}
+
+func NewPresignUploadReq() *PresignUploadReq {
+	return &PresignUploadReq{}
+}
+
+func (p *PresignUploadReq) InitDefault() {
+}
+
+func (p *PresignUploadReq) GetFileName() (v string) {
+	return p.FileName
+}
+
+func (p *PresignUploadReq) GetFileSize() (v int64) {
+	return p.FileSize
+}
+
+var fieldIDToName_PresignUploadReq = map[int16]string{
+	1: "file_name",
+	2: "file_size",
+}
+
+func (p *PresignUploadReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PresignUploadReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PresignUploadReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FileName = _field
+	return nil
+}
+func (p *PresignUploadReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FileSize = _field
+	return nil
+}
+
+func (p *PresignUploadReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PresignUploadReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PresignUploadReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("file_name", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.FileName); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *PresignUploadReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("file_size", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.FileSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *PresignUploadReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PresignUploadReq(%+v)", *p)
+
+}
+
+type PresignUploadResp struct {
+	Key        string         `thrift:"key,1" form:"key" json:"key" query:"key"`
+	UploadURL  string         `thrift:"upload_url,2" form:"upload_url" json:"upload_url" query:"upload_url"`
+	ExpireTime string         `thrift:"expire_time,3" form:"expire_time" json:"expire_time" query:"expire_time"`
+	Base       *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewPresignUploadResp() *PresignUploadResp {
+	return &PresignUploadResp{}
+}
+
+func (p *PresignUploadResp) InitDefault() {
+}
+
+func (p *PresignUploadResp) GetKey() (v string) {
+	return p.Key
+}
+
+func (p *PresignUploadResp) GetUploadURL() (v string) {
+	return p.UploadURL
+}
+
+func (p *PresignUploadResp) GetExpireTime() (v string) {
+	return p.ExpireTime
+}
+
+var PresignUploadResp_Base_DEFAULT *base.BaseResp
+
+func (p *PresignUploadResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return PresignUploadResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_PresignUploadResp = map[int16]string{
+	1:   "key",
+	2:   "upload_url",
+	3:   "expire_time",
+	255: "base",
+}
+
+func (p *PresignUploadResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *PresignUploadResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PresignUploadResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PresignUploadResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Key = _field
+	return nil
+}
+func (p *PresignUploadResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UploadURL = _field
+	return nil
+}
+func (p *PresignUploadResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ExpireTime = _field
+	return nil
+}
+func (p *PresignUploadResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *PresignUploadResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PresignUploadResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PresignUploadResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Key); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *PresignUploadResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("upload_url", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.UploadURL); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *PresignUploadResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("expire_time", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ExpireTime); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *PresignUploadResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *PresignUploadResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PresignUploadResp(%+v)", *p)
+
+}
+
+type ConfirmUploadReq struct {
+	Key     string  `thrift:"key,1" form:"key" json:"key" query:"key" vd:"len($) > 0"`
+	ID      *int64  `thrift:"id,2,optional" form:"id" json:"id,omitempty" query:"id"`
+	PicName *string `thrift:"pic_name,3,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
+	KeepGps *bool   `thrift:"keep_gps,4,optional" form:"keep_gps" json:"keep_gps,omitempty" query:"keep_gps"`
+}
+
+func NewConfirmUploadReq() *ConfirmUploadReq {
+	return &ConfirmUploadReq{}
+}
+
+func (p *ConfirmUploadReq) InitDefault() {
+}
+
+func (p *ConfirmUploadReq) GetKey() (v string) {
+	return p.Key
+}
+
+var ConfirmUploadReq_ID_DEFAULT int64
+
+func (p *ConfirmUploadReq) GetID() (v int64) {
+	if !p.IsSetID() {
+		return ConfirmUploadReq_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var ConfirmUploadReq_PicName_DEFAULT string
+
+func (p *ConfirmUploadReq) GetPicName() (v string) {
+	if !p.IsSetPicName() {
+		return ConfirmUploadReq_PicName_DEFAULT
+	}
+	return *p.PicName
+}
+
+var ConfirmUploadReq_KeepGps_DEFAULT bool
+
+func (p *ConfirmUploadReq) GetKeepGps() (v bool) {
+	if !p.IsSetKeepGps() {
+		return ConfirmUploadReq_KeepGps_DEFAULT
+	}
+	return *p.KeepGps
+}
+
+var fieldIDToName_ConfirmUploadReq = map[int16]string{
+	1: "key",
+	2: "id",
+	3: "pic_name",
+	4: "keep_gps",
+}
+
+func (p *ConfirmUploadReq) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *ConfirmUploadReq) IsSetPicName() bool {
+	return p.PicName != nil
+}
+
+func (p *ConfirmUploadReq) IsSetKeepGps() bool {
+	return p.KeepGps != nil
+}
+
+func (p *ConfirmUploadReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConfirmUploadReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ConfirmUploadReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Key = _field
+	return nil
+}
+func (p *ConfirmUploadReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ConfirmUploadReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicName = _field
+	return nil
+}
+func (p *ConfirmUploadReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KeepGps = _field
+	return nil
+}
+
+func (p *ConfirmUploadReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfirmUploadReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ConfirmUploadReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Key); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ConfirmUploadReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ConfirmUploadReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicName() {
+		if err = oprot.WriteFieldBegin("pic_name", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PicName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ConfirmUploadReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeepGps() {
+		if err = oprot.WriteFieldBegin("keep_gps", thrift.BOOL, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.KeepGps); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ConfirmUploadReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfirmUploadReq(%+v)", *p)
+
+}
+
+type ConfirmUploadResp struct {
+	ID   int64          `thrift:"id,1" form:"id" json:"id" query:"id"`
+	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewConfirmUploadResp() *ConfirmUploadResp {
+	return &ConfirmUploadResp{}
+}
+
+func (p *ConfirmUploadResp) InitDefault() {
+}
+
+func (p *ConfirmUploadResp) GetID() (v int64) {
+	return p.ID
+}
+
+var ConfirmUploadResp_Base_DEFAULT *base.BaseResp
+
+func (p *ConfirmUploadResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return ConfirmUploadResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_ConfirmUploadResp = map[int16]string{
+	1:   "id",
+	255: "base",
+}
+
+func (p *ConfirmUploadResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ConfirmUploadResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ConfirmUploadResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ConfirmUploadResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ConfirmUploadResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ConfirmUploadResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ConfirmUploadResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ConfirmUploadResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ConfirmUploadResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ConfirmUploadResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfirmUploadResp(%+v)", *p)
+
+}
+
+type UploadPictureByArchiveReq struct {
+	KeepGps *bool  `thrift:"keep_gps,1,optional" form:"keep_gps" json:"keep_gps,omitempty" query:"keep_gps"`
+	SpaceID *int64 `thrift:"space_id,2,optional" form:"space_id" json:"space_id,omitempty" query:"space_id"`
+}
+
+func NewUploadPictureByArchiveReq() *UploadPictureByArchiveReq {
+	return &UploadPictureByArchiveReq{}
+}
+
+func (p *UploadPictureByArchiveReq) InitDefault() {
+}
+
+var UploadPictureByArchiveReq_KeepGps_DEFAULT bool
+
+func (p *UploadPictureByArchiveReq) GetKeepGps() (v bool) {
+	if !p.IsSetKeepGps() {
+		return UploadPictureByArchiveReq_KeepGps_DEFAULT
+	}
+	return *p.KeepGps
+}
+
+var UploadPictureByArchiveReq_SpaceID_DEFAULT int64
+
+func (p *UploadPictureByArchiveReq) GetSpaceID() (v int64) {
+	if !p.IsSetSpaceID() {
+		return UploadPictureByArchiveReq_SpaceID_DEFAULT
+	}
+	return *p.SpaceID
+}
+
+var fieldIDToName_UploadPictureByArchiveReq = map[int16]string{
+	1: "keep_gps",
+	2: "space_id",
+}
+
+func (p *UploadPictureByArchiveReq) IsSetKeepGps() bool {
+	return p.KeepGps != nil
+}
+
+func (p *UploadPictureByArchiveReq) IsSetSpaceID() bool {
+	return p.SpaceID != nil
+}
+
+func (p *UploadPictureByArchiveReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadPictureByArchiveReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UploadPictureByArchiveReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.KeepGps = _field
+	return nil
+}
+func (p *UploadPictureByArchiveReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SpaceID = _field
+	return nil
+}
+
+func (p *UploadPictureByArchiveReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadPictureByArchiveReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UploadPictureByArchiveReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeepGps() {
+		if err = oprot.WriteFieldBegin("keep_gps", thrift.BOOL, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.KeepGps); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UploadPictureByArchiveReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSpaceID() {
+		if err = oprot.WriteFieldBegin("space_id", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SpaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UploadPictureByArchiveReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadPictureByArchiveReq(%+v)", *p)
+
+}
+
+type ArchiveEntryResult struct {
+	Path    string `thrift:"path,1" form:"path" json:"path" query:"path"`
+	Success bool   `thrift:"success,2" form:"success" json:"success" query:"success"`
+	ID      int64  `thrift:"id,3" form:"id" json:"id" query:"id"`
+	Message string `thrift:"message,4" form:"message" json:"message" query:"message"`
+}
+
+func NewArchiveEntryResult() *ArchiveEntryResult {
+	return &ArchiveEntryResult{}
+}
+
+func (p *ArchiveEntryResult) InitDefault() {
+}
+
+func (p *ArchiveEntryResult) GetPath() (v string) {
+	return p.Path
+}
+
+func (p *ArchiveEntryResult) GetSuccess() (v bool) {
+	return p.Success
+}
+
+func (p *ArchiveEntryResult) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *ArchiveEntryResult) GetMessage() (v string) {
+	return p.Message
+}
+
+var fieldIDToName_ArchiveEntryResult = map[int16]string{
+	1: "path",
+	2: "success",
+	3: "id",
+	4: "message",
+}
+
+func (p *ArchiveEntryResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ArchiveEntryResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ArchiveEntryResult) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Path = _field
+	return nil
+}
+func (p *ArchiveEntryResult) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *ArchiveEntryResult) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ArchiveEntryResult) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *ArchiveEntryResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ArchiveEntryResult"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ArchiveEntryResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("path", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Path); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ArchiveEntryResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success", thrift.BOOL, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteBool(p.Success); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ArchiveEntryResult) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *ArchiveEntryResult) writeField4(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ArchiveEntryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ArchiveEntryResult(%+v)", *p)
+
+}
+
+type UploadPictureByArchiveResp struct {
+	Results      []*ArchiveEntryResult `thrift:"results,1" form:"results" json:"results" query:"results"`
+	SuccessCount int32                 `thrift:"success_count,2" form:"success_count" json:"success_count" query:"success_count"`
+	FailCount    int32                 `thrift:"fail_count,3" form:"fail_count" json:"fail_count" query:"fail_count"`
+	Base         *base.BaseResp        `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewUploadPictureByArchiveResp() *UploadPictureByArchiveResp {
+	return &UploadPictureByArchiveResp{}
+}
+
+func (p *UploadPictureByArchiveResp) InitDefault() {
+}
+
+func (p *UploadPictureByArchiveResp) GetResults() (v []*ArchiveEntryResult) {
+	return p.Results
+}
+
+func (p *UploadPictureByArchiveResp) GetSuccessCount() (v int32) {
+	return p.SuccessCount
+}
+
+func (p *UploadPictureByArchiveResp) GetFailCount() (v int32) {
+	return p.FailCount
+}
+
+var UploadPictureByArchiveResp_Base_DEFAULT *base.BaseResp
+
+func (p *UploadPictureByArchiveResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return UploadPictureByArchiveResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_UploadPictureByArchiveResp = map[int16]string{
+	1:   "results",
+	2:   "success_count",
+	3:   "fail_count",
+	255: "base",
+}
+
+func (p *UploadPictureByArchiveResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UploadPictureByArchiveResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadPictureByArchiveResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UploadPictureByArchiveResp) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ArchiveEntryResult, 0, size)
+	values := make([]ArchiveEntryResult, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Results = _field
+	return nil
+}
+func (p *UploadPictureByArchiveResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SuccessCount = _field
+	return nil
+}
+func (p *UploadPictureByArchiveResp) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FailCount = _field
+	return nil
+}
+func (p *UploadPictureByArchiveResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *UploadPictureByArchiveResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadPictureByArchiveResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UploadPictureByArchiveResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("results", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Results)); err != nil {
+		return err
+	}
+	for _, v := range p.Results {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UploadPictureByArchiveResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("success_count", thrift.I32, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.SuccessCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UploadPictureByArchiveResp) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("fail_count", thrift.I32, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI32(p.FailCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *UploadPictureByArchiveResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UploadPictureByArchiveResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadPictureByArchiveResp(%+v)", *p)
+
+}
+
+// # admin
+type DeletePictureReq struct {
+	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
+}
+
+func NewDeletePictureReq() *DeletePictureReq {
+	return &DeletePictureReq{}
+}
+
+func (p *DeletePictureReq) InitDefault() {
+}
+
+func (p *DeletePictureReq) GetID() (v int64) {
+	return p.ID
+}
+
+var fieldIDToName_DeletePictureReq = map[int16]string{
+	1: "id",
+}
+
+func (p *DeletePictureReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeletePictureReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeletePictureReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+
+func (p *DeletePictureReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeletePictureReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeletePictureReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DeletePictureReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeletePictureReq(%+v)", *p)
+
+}
+
+type DeletePictureResp struct {
+	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewDeletePictureResp() *DeletePictureResp {
+	return &DeletePictureResp{}
+}
+
+func (p *DeletePictureResp) InitDefault() {
+}
+
+var DeletePictureResp_Base_DEFAULT *base.BaseResp
+
+func (p *DeletePictureResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return DeletePictureResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_DeletePictureResp = map[int16]string{
+	255: "base",
+}
+
+func (p *DeletePictureResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DeletePictureResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeletePictureResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DeletePictureResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *DeletePictureResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeletePictureResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeletePictureResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *DeletePictureResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeletePictureResp(%+v)", *p)
+
+}
+
+type UpdatePictureReq struct {
+	ID           int64    `thrift:"id,1" form:"id" json:"id" query:"id"`
+	PicName      *string  `thrift:"pic_name,2,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
+	Introduction *string  `thrift:"introduction,3,optional" form:"introduction" json:"introduction,omitempty" query:"introduction" vd:"$ == null || len($) < 800"`
+	Category     *string  `thrift:"category,4,optional" form:"category" json:"category,omitempty" query:"category"`
+	Tags         []string `thrift:"tags,5,optional" form:"tags" json:"tags,omitempty" query:"tags"`
+}
+
+func NewUpdatePictureReq() *UpdatePictureReq {
+	return &UpdatePictureReq{}
+}
+
+func (p *UpdatePictureReq) InitDefault() {
+}
+
+func (p *UpdatePictureReq) GetID() (v int64) {
+	return p.ID
+}
+
+var UpdatePictureReq_PicName_DEFAULT string
+
+func (p *UpdatePictureReq) GetPicName() (v string) {
+	if !p.IsSetPicName() {
+		return UpdatePictureReq_PicName_DEFAULT
+	}
+	return *p.PicName
+}
+
+var UpdatePictureReq_Introduction_DEFAULT string
+
+func (p *UpdatePictureReq) GetIntroduction() (v string) {
+	if !p.IsSetIntroduction() {
+		return UpdatePictureReq_Introduction_DEFAULT
+	}
+	return *p.Introduction
+}
+
+var UpdatePictureReq_Category_DEFAULT string
+
+func (p *UpdatePictureReq) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return UpdatePictureReq_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var UpdatePictureReq_Tags_DEFAULT []string
+
+func (p *UpdatePictureReq) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return UpdatePictureReq_Tags_DEFAULT
+	}
+	return p.Tags
+}
+
+var fieldIDToName_UpdatePictureReq = map[int16]string{
+	1: "id",
+	2: "pic_name",
+	3: "introduction",
+	4: "category",
+	5: "tags",
+}
+
+func (p *UpdatePictureReq) IsSetPicName() bool {
+	return p.PicName != nil
+}
+
+func (p *UpdatePictureReq) IsSetIntroduction() bool {
+	return p.Introduction != nil
+}
+
+func (p *UpdatePictureReq) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *UpdatePictureReq) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *UpdatePictureReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdatePictureReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdatePictureReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *UpdatePictureReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicName = _field
+	return nil
+}
+func (p *UpdatePictureReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Introduction = _field
+	return nil
+}
+func (p *UpdatePictureReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Category = _field
+	return nil
+}
+func (p *UpdatePictureReq) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Tags = _field
+	return nil
+}
+
+func (p *UpdatePictureReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdatePictureReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdatePictureReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdatePictureReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicName() {
+		if err = oprot.WriteFieldBegin("pic_name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PicName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UpdatePictureReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntroduction() {
+		if err = oprot.WriteFieldBegin("introduction", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Introduction); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *UpdatePictureReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCategory() {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Category); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *UpdatePictureReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTags() {
+		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+			return err
+		}
+		for _, v := range p.Tags {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UpdatePictureReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdatePictureReq(%+v)", *p)
+
+}
+
+type UpdatePictureResp struct {
+	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewUpdatePictureResp() *UpdatePictureResp {
+	return &UpdatePictureResp{}
+}
+
+func (p *UpdatePictureResp) InitDefault() {
+}
+
+var UpdatePictureResp_Base_DEFAULT *base.BaseResp
+
+func (p *UpdatePictureResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdatePictureResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_UpdatePictureResp = map[int16]string{
+	255: "base",
+}
+
+func (p *UpdatePictureResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdatePictureResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdatePictureResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdatePictureResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *UpdatePictureResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdatePictureResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdatePictureResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdatePictureResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdatePictureResp(%+v)", *p)
+
+}
+
+type QueryPictureReq struct {
+	ID            *int64   `thrift:"id,1,optional" form:"id" json:"id,omitempty" query:"id"`
+	PicName       *string  `thrift:"pic_name,2,optional" form:"pic_name" json:"pic_name,omitempty" query:"pic_name"`
+	Introduction  *string  `thrift:"introduction,3,optional" form:"introduction" json:"introduction,omitempty" query:"introduction" vd:"$ == null || len($) < 800"`
+	Category      *string  `thrift:"category,4,optional" form:"category" json:"category,omitempty" query:"category"`
+	Tags          []string `thrift:"tags,5,optional" form:"tags" json:"tags,omitempty" query:"tags"`
+	PicSize       *int64   `thrift:"pic_size,6,optional" form:"pic_size" json:"pic_size,omitempty" query:"pic_size"`
+	PicWidth      *int32   `thrift:"pic_width,8,optional" form:"pic_width" json:"pic_width,omitempty" query:"pic_width"`
+	PicHeight     *int32   `thrift:"pic_height,9,optional" form:"pic_height" json:"pic_height,omitempty" query:"pic_height"`
+	PicScale      *float64 `thrift:"pic_scale,10,optional" form:"pic_scale" json:"pic_scale,omitempty" query:"pic_scale"`
+	PicFormat     *string  `thrift:"pic_format,11,optional" form:"pic_format" json:"pic_format,omitempty" query:"pic_format"`
+	SearchText    *string  `thrift:"search_text,12,optional" form:"search_text" json:"search_text,omitempty" query:"search_text"`
+	UserID        *int64   `thrift:"user_id,13,optional" form:"user_id" json:"user_id,omitempty" query:"user_id"`
+	ReviewStatus  *string  `thrift:"review_status,14,optional" form:"review_status" json:"review_status,omitempty" query:"review_status"`
+	ReviewMessage *string  `thrift:"review_message,15,optional" form:"review_message" json:"review_message,omitempty" query:"review_message"`
+	ReviewID      *int64   `thrift:"review_id,16,optional" form:"review_id" json:"review_id,omitempty" query:"review_id"`
+	CurrentPage   int64    `thrift:"current_page,17" form:"current_page" json:"current_page" query:"current_page"`
+	PageSize      int64    `thrift:"page_size,18" form:"page_size" json:"page_size" query:"page_size"`
+	// 为空时查询公共图库
+	SpaceID *int64 `thrift:"space_id,19,optional" form:"space_id" json:"space_id,omitempty" query:"space_id"`
+}
+
+func NewQueryPictureReq() *QueryPictureReq {
+	return &QueryPictureReq{}
+}
+
+func (p *QueryPictureReq) InitDefault() {
+}
+
+var QueryPictureReq_ID_DEFAULT int64
+
+func (p *QueryPictureReq) GetID() (v int64) {
+	if !p.IsSetID() {
+		return QueryPictureReq_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var QueryPictureReq_PicName_DEFAULT string
+
+func (p *QueryPictureReq) GetPicName() (v string) {
+	if !p.IsSetPicName() {
+		return QueryPictureReq_PicName_DEFAULT
+	}
+	return *p.PicName
+}
+
+var QueryPictureReq_Introduction_DEFAULT string
+
+func (p *QueryPictureReq) GetIntroduction() (v string) {
+	if !p.IsSetIntroduction() {
+		return QueryPictureReq_Introduction_DEFAULT
+	}
+	return *p.Introduction
+}
+
+var QueryPictureReq_Category_DEFAULT string
+
+func (p *QueryPictureReq) GetCategory() (v string) {
+	if !p.IsSetCategory() {
+		return QueryPictureReq_Category_DEFAULT
+	}
+	return *p.Category
+}
+
+var QueryPictureReq_Tags_DEFAULT []string
+
+func (p *QueryPictureReq) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return QueryPictureReq_Tags_DEFAULT
+	}
+	return p.Tags
+}
+
+var QueryPictureReq_PicSize_DEFAULT int64
+
+func (p *QueryPictureReq) GetPicSize() (v int64) {
+	if !p.IsSetPicSize() {
+		return QueryPictureReq_PicSize_DEFAULT
+	}
+	return *p.PicSize
+}
+
+var QueryPictureReq_PicWidth_DEFAULT int32
+
+func (p *QueryPictureReq) GetPicWidth() (v int32) {
+	if !p.IsSetPicWidth() {
+		return QueryPictureReq_PicWidth_DEFAULT
+	}
+	return *p.PicWidth
+}
+
+var QueryPictureReq_PicHeight_DEFAULT int32
+
+func (p *QueryPictureReq) GetPicHeight() (v int32) {
+	if !p.IsSetPicHeight() {
+		return QueryPictureReq_PicHeight_DEFAULT
+	}
+	return *p.PicHeight
+}
+
+var QueryPictureReq_PicScale_DEFAULT float64
+
+func (p *QueryPictureReq) GetPicScale() (v float64) {
+	if !p.IsSetPicScale() {
+		return QueryPictureReq_PicScale_DEFAULT
+	}
+	return *p.PicScale
+}
+
+var QueryPictureReq_PicFormat_DEFAULT string
+
+func (p *QueryPictureReq) GetPicFormat() (v string) {
+	if !p.IsSetPicFormat() {
+		return QueryPictureReq_PicFormat_DEFAULT
+	}
+	return *p.PicFormat
+}
+
+var QueryPictureReq_SearchText_DEFAULT string
+
+func (p *QueryPictureReq) GetSearchText() (v string) {
+	if !p.IsSetSearchText() {
+		return QueryPictureReq_SearchText_DEFAULT
+	}
+	return *p.SearchText
+}
+
+var QueryPictureReq_UserID_DEFAULT int64
+
+func (p *QueryPictureReq) GetUserID() (v int64) {
+	if !p.IsSetUserID() {
+		return QueryPictureReq_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var QueryPictureReq_ReviewStatus_DEFAULT string
+
+func (p *QueryPictureReq) GetReviewStatus() (v string) {
+	if !p.IsSetReviewStatus() {
+		return QueryPictureReq_ReviewStatus_DEFAULT
+	}
+	return *p.ReviewStatus
+}
+
+var QueryPictureReq_ReviewMessage_DEFAULT string
+
+func (p *QueryPictureReq) GetReviewMessage() (v string) {
+	if !p.IsSetReviewMessage() {
+		return QueryPictureReq_ReviewMessage_DEFAULT
+	}
+	return *p.ReviewMessage
+}
+
+var QueryPictureReq_ReviewID_DEFAULT int64
+
+func (p *QueryPictureReq) GetReviewID() (v int64) {
+	if !p.IsSetReviewID() {
+		return QueryPictureReq_ReviewID_DEFAULT
+	}
+	return *p.ReviewID
+}
+
+func (p *QueryPictureReq) GetCurrentPage() (v int64) {
+	return p.CurrentPage
+}
+
+func (p *QueryPictureReq) GetPageSize() (v int64) {
+	return p.PageSize
+}
+
+var QueryPictureReq_SpaceID_DEFAULT int64
+
+func (p *QueryPictureReq) GetSpaceID() (v int64) {
+	if !p.IsSetSpaceID() {
+		return QueryPictureReq_SpaceID_DEFAULT
+	}
+	return *p.SpaceID
+}
+
+var fieldIDToName_QueryPictureReq = map[int16]string{
+	1:  "id",
+	2:  "pic_name",
+	3:  "introduction",
+	4:  "category",
+	5:  "tags",
+	6:  "pic_size",
+	8:  "pic_width",
+	9:  "pic_height",
+	10: "pic_scale",
+	11: "pic_format",
+	12: "search_text",
+	13: "user_id",
+	14: "review_status",
+	15: "review_message",
+	16: "review_id",
+	17: "current_page",
+	18: "page_size",
+	19: "space_id",
+}
+
+func (p *QueryPictureReq) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *QueryPictureReq) IsSetPicName() bool {
+	return p.PicName != nil
+}
+
+func (p *QueryPictureReq) IsSetIntroduction() bool {
+	return p.Introduction != nil
+}
+
+func (p *QueryPictureReq) IsSetCategory() bool {
+	return p.Category != nil
+}
+
+func (p *QueryPictureReq) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *QueryPictureReq) IsSetPicSize() bool {
+	return p.PicSize != nil
+}
+
+func (p *QueryPictureReq) IsSetPicWidth() bool {
+	return p.PicWidth != nil
+}
+
+func (p *QueryPictureReq) IsSetPicHeight() bool {
+	return p.PicHeight != nil
+}
+
+func (p *QueryPictureReq) IsSetPicScale() bool {
+	return p.PicScale != nil
+}
+
+func (p *QueryPictureReq) IsSetPicFormat() bool {
+	return p.PicFormat != nil
+}
+
+func (p *QueryPictureReq) IsSetSearchText() bool {
+	return p.SearchText != nil
+}
+
+func (p *QueryPictureReq) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *QueryPictureReq) IsSetReviewStatus() bool {
+	return p.ReviewStatus != nil
+}
+
+func (p *QueryPictureReq) IsSetReviewMessage() bool {
+	return p.ReviewMessage != nil
+}
+
+func (p *QueryPictureReq) IsSetReviewID() bool {
+	return p.ReviewID != nil
+}
+
+func (p *QueryPictureReq) IsSetSpaceID() bool {
+	return p.SpaceID != nil
+}
+
+func (p *QueryPictureReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.DOUBLE {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 16:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 17:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField17(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 18:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField18(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 19:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField19(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryPictureReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryPictureReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicName = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Introduction = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Category = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Tags = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicSize = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicWidth = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicHeight = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *float64
+	if v, err := iprot.ReadDouble(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicScale = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PicFormat = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SearchText = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReviewStatus = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField15(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReviewMessage = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField16(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ReviewID = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField17(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CurrentPage = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField18(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+func (p *QueryPictureReq) ReadField19(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SpaceID = _field
+	return nil
+}
+
+func (p *QueryPictureReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryPictureReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+		if err = p.writeField17(oprot); err != nil {
+			fieldId = 17
+			goto WriteFieldError
+		}
+		if err = p.writeField18(oprot); err != nil {
+			fieldId = 18
+			goto WriteFieldError
+		}
+		if err = p.writeField19(oprot); err != nil {
+			fieldId = 19
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryPictureReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicName() {
+		if err = oprot.WriteFieldBegin("pic_name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PicName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIntroduction() {
+		if err = oprot.WriteFieldBegin("introduction", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Introduction); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCategory() {
+		if err = oprot.WriteFieldBegin("category", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Category); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTags() {
+		if err = oprot.WriteFieldBegin("tags", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Tags)); err != nil {
+			return err
+		}
+		for _, v := range p.Tags {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicSize() {
+		if err = oprot.WriteFieldBegin("pic_size", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.PicSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicWidth() {
+		if err = oprot.WriteFieldBegin("pic_width", thrift.I32, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PicWidth); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicHeight() {
+		if err = oprot.WriteFieldBegin("pic_height", thrift.I32, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.PicHeight); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicScale() {
+		if err = oprot.WriteFieldBegin("pic_scale", thrift.DOUBLE, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteDouble(*p.PicScale); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPicFormat() {
+		if err = oprot.WriteFieldBegin("pic_format", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PicFormat); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSearchText() {
+		if err = oprot.WriteFieldBegin("search_text", thrift.STRING, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SearchText); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("user_id", thrift.I64, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UserID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReviewStatus() {
+		if err = oprot.WriteFieldBegin("review_status", thrift.STRING, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ReviewStatus); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField15(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReviewMessage() {
+		if err = oprot.WriteFieldBegin("review_message", thrift.STRING, 15); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ReviewMessage); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField16(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReviewID() {
+		if err = oprot.WriteFieldBegin("review_id", thrift.I64, 16); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ReviewID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField17(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("current_page", thrift.I64, 17); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CurrentPage); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 17 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField18(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I64, 18); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 18 end error: ", p), err)
+}
+func (p *QueryPictureReq) writeField19(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSpaceID() {
+		if err = oprot.WriteFieldBegin("space_id", thrift.I64, 19); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.SpaceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 19 end error: ", p), err)
+}
+
+func (p *QueryPictureReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryPictureReq(%+v)", *p)
+
+}
+
+type QueryPictureResp struct {
+	Total    int64           `thrift:"total,1" form:"total" json:"total" query:"total"`
+	Pictures []*base.Picture `thrift:"pictures,2" form:"pictures" json:"pictures" query:"pictures"`
+	Base     *base.BaseResp  `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewQueryPictureResp() *QueryPictureResp {
+	return &QueryPictureResp{}
+}
+
+func (p *QueryPictureResp) InitDefault() {
+}
+
+func (p *QueryPictureResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+func (p *QueryPictureResp) GetPictures() (v []*base.Picture) {
+	return p.Pictures
+}
+
+var QueryPictureResp_Base_DEFAULT *base.BaseResp
+
+func (p *QueryPictureResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return QueryPictureResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_QueryPictureResp = map[int16]string{
+	1:   "total",
+	2:   "pictures",
+	255: "base",
+}
+
+func (p *QueryPictureResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *QueryPictureResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryPictureResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryPictureResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *QueryPictureResp) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*base.Picture, 0, size)
+	values := make([]base.Picture, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Pictures = _field
+	return nil
+}
+func (p *QueryPictureResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *QueryPictureResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryPictureResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryPictureResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *QueryPictureResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("pictures", thrift.LIST, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Pictures)); err != nil {
+		return err
+	}
+	for _, v := range p.Pictures {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *QueryPictureResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryPictureResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryPictureResp(%+v)", *p)
+
+}
+
+type QueryPictureByIdReq struct {
+	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
+}
+
+func NewQueryPictureByIdReq() *QueryPictureByIdReq {
+	return &QueryPictureByIdReq{}
+}
+
+func (p *QueryPictureByIdReq) InitDefault() {
+}
+
+func (p *QueryPictureByIdReq) GetID() (v int64) {
+	return p.ID
+}
+
+var fieldIDToName_QueryPictureByIdReq = map[int16]string{
+	1: "id",
+}
+
+func (p *QueryPictureByIdReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryPictureByIdReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryPictureByIdReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+
+func (p *QueryPictureByIdReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryPictureByIdReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryPictureByIdReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *QueryPictureByIdReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryPictureByIdReq(%+v)", *p)
+
+}
+
+type QueryPictureByIdResp struct {
+	Picture *base.Picture  `thrift:"picture,1" form:"picture" json:"picture" query:"picture"`
+	Base    *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewQueryPictureByIdResp() *QueryPictureByIdResp {
+	return &QueryPictureByIdResp{}
+}
+
+func (p *QueryPictureByIdResp) InitDefault() {
+}
+
+var QueryPictureByIdResp_Picture_DEFAULT *base.Picture
+
+func (p *QueryPictureByIdResp) GetPicture() (v *base.Picture) {
+	if !p.IsSetPicture() {
+		return QueryPictureByIdResp_Picture_DEFAULT
+	}
+	return p.Picture
+}
+
+var QueryPictureByIdResp_Base_DEFAULT *base.BaseResp
+
+func (p *QueryPictureByIdResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return QueryPictureByIdResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_QueryPictureByIdResp = map[int16]string{
+	1:   "picture",
+	255: "base",
+}
+
+func (p *QueryPictureByIdResp) IsSetPicture() bool {
+	return p.Picture != nil
+}
+
+func (p *QueryPictureByIdResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *QueryPictureByIdResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryPictureByIdResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryPictureByIdResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := base.NewPicture()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Picture = _field
+	return nil
+}
+func (p *QueryPictureByIdResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *QueryPictureByIdResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryPictureByIdResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryPictureByIdResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("picture", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Picture.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *QueryPictureByIdResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryPictureByIdResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryPictureByIdResp(%+v)", *p)
+
+}
+
+type ReviewPictureReq struct {
+	ID            int64  `thrift:"id,1" form:"id" json:"id" query:"id"`
+	ReviewStatus  string `thrift:"review_status,2" form:"review_status" json:"review_status" query:"review_status"`
+	ReviewMessage string `thrift:"review_message,3" form:"review_message" json:"review_message" query:"review_message"`
+}
+
+func NewReviewPictureReq() *ReviewPictureReq {
+	return &ReviewPictureReq{}
+}
+
+func (p *ReviewPictureReq) InitDefault() {
+}
+
+func (p *ReviewPictureReq) GetID() (v int64) {
+	return p.ID
+}
+
+func (p *ReviewPictureReq) GetReviewStatus() (v string) {
+	return p.ReviewStatus
+}
+
+func (p *ReviewPictureReq) GetReviewMessage() (v string) {
+	return p.ReviewMessage
+}
+
+var fieldIDToName_ReviewPictureReq = map[int16]string{
+	1: "id",
+	2: "review_status",
+	3: "review_message",
+}
+
+func (p *ReviewPictureReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewPictureReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewPictureReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *ReviewPictureReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewStatus = _field
+	return nil
+}
+func (p *ReviewPictureReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ReviewMessage = _field
+	return nil
+}
+
+func (p *ReviewPictureReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReviewPictureReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewPictureReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *ReviewPictureReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_status", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ReviewStatus); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ReviewPictureReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("review_message", thrift.STRING, 3); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.ReviewMessage); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ReviewPictureReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewPictureReq(%+v)", *p)
+
+}
+
+type ReviewPictureResp struct {
+	Base *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewReviewPictureResp() *ReviewPictureResp {
+	return &ReviewPictureResp{}
+}
+
+func (p *ReviewPictureResp) InitDefault() {
+}
+
+var ReviewPictureResp_Base_DEFAULT *base.BaseResp
+
+func (p *ReviewPictureResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return ReviewPictureResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_ReviewPictureResp = map[int16]string{
+	255: "base",
+}
+
+func (p *ReviewPictureResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ReviewPictureResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ReviewPictureResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ReviewPictureResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *ReviewPictureResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReviewPictureResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ReviewPictureResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *ReviewPictureResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReviewPictureResp(%+v)", *p)
+
+}
+
+type UploadPictureByBatchReq struct {
+	SearchText  string `thrift:"search_text,1" form:"search_text" json:"search_text" query:"search_text"`
+	UploadCount *int64 `thrift:"upload_count,2,optional" form:"upload_count" json:"upload_count,omitempty" query:"upload_count" vd:" $ == null || $ < 30 "`
+}
+
+func NewUploadPictureByBatchReq() *UploadPictureByBatchReq {
+	return &UploadPictureByBatchReq{}
+}
+
+func (p *UploadPictureByBatchReq) InitDefault() {
+}
+
+func (p *UploadPictureByBatchReq) GetSearchText() (v string) {
+	return p.SearchText
+}
+
+var UploadPictureByBatchReq_UploadCount_DEFAULT int64
+
+func (p *UploadPictureByBatchReq) GetUploadCount() (v int64) {
+	if !p.IsSetUploadCount() {
+		return UploadPictureByBatchReq_UploadCount_DEFAULT
+	}
+	return *p.UploadCount
+}
+
+var fieldIDToName_UploadPictureByBatchReq = map[int16]string{
+	1: "search_text",
+	2: "upload_count",
+}
+
+func (p *UploadPictureByBatchReq) IsSetUploadCount() bool {
+	return p.UploadCount != nil
+}
+
+func (p *UploadPictureByBatchReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadPictureByBatchReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UploadPictureByBatchReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.SearchText = _field
+	return nil
+}
+func (p *UploadPictureByBatchReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UploadCount = _field
+	return nil
+}
+
+func (p *UploadPictureByBatchReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadPictureByBatchReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UploadPictureByBatchReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("search_text", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.SearchText); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UploadPictureByBatchReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUploadCount() {
+		if err = oprot.WriteFieldBegin("upload_count", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UploadCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UploadPictureByBatchReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadPictureByBatchReq(%+v)", *p)
+
+}
+
+type UploadPictureByBatchResp struct {
+	UploadCount int64          `thrift:"upload_count,1" form:"upload_count" json:"upload_count" query:"upload_count"`
+	Base        *base.BaseResp `thrift:"base,255" form:"base" json:"base" query:"base"`
+}
+
+func NewUploadPictureByBatchResp() *UploadPictureByBatchResp {
+	return &UploadPictureByBatchResp{}
+}
+
+func (p *UploadPictureByBatchResp) InitDefault() {
+}
+
+func (p *UploadPictureByBatchResp) GetUploadCount() (v int64) {
+	return p.UploadCount
+}
+
+var UploadPictureByBatchResp_Base_DEFAULT *base.BaseResp
+
+func (p *UploadPictureByBatchResp) GetBase() (v *base.BaseResp) {
+	if !p.IsSetBase() {
+		return UploadPictureByBatchResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var fieldIDToName_UploadPictureByBatchResp = map[int16]string{
+	1:   "upload_count",
+	255: "base",
+}
+
+func (p *UploadPictureByBatchResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UploadPictureByBatchResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadPictureByBatchResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UploadPictureByBatchResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.UploadCount = _field
+	return nil
+}
+func (p *UploadPictureByBatchResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Base = _field
+	return nil
+}
+
+func (p *UploadPictureByBatchResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadPictureByBatchResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UploadPictureByBatchResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("upload_count", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.UploadCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UploadPictureByBatchResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("base", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Base.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UploadPictureByBatchResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadPictureByBatchResp(%+v)", *p)
+
+}
+
+type PictureService interface {
+	//# public
+	PictureListTagCategory(ctx context.Context, req *PictureTagCategoryReq) (r *PictureTagCategoryResp, err error)
+
+	PictureSearch(ctx context.Context, req *PictureSearchReq) (r *PictureSearchResp, err error)
+
+	PictureGetById(ctx context.Context, req *PictureGetByIdReq) (r *PictureGetByIdResp, err error)
+
+	PictureImage(ctx context.Context, req *PictureImageReq) (r *PictureImageResp, err error)
+	//# auth
+	PictureEdit(ctx context.Context, req *PictureEditReq) (r *PictureEditResp, err error)
+
+	PictureDelete(ctx context.Context, req *PictureDeleteReq) (r *PictureDeleteResp, err error)
+
+	PictureLike(ctx context.Context, req *PictureLikeReq) (r *PictureLikeResp, err error)
+
+	PictureFavorite(ctx context.Context, req *PictureFavoriteReq) (r *PictureFavoriteResp, err error)
+
+	ListFavoritePicture(ctx context.Context, req *ListFavoritePictureReq) (r *ListFavoritePictureResp, err error)
+
+	PictureFeed(ctx context.Context, req *PictureFeedReq) (r *PictureFeedResp, err error)
+
+	UploadPicture(ctx context.Context, req *UploadPictureReq) (r *UploadPictureResp, err error)
+
+	SearchSimilarPicture(ctx context.Context, req *SearchSimilarPictureReq) (r *SearchSimilarPictureResp, err error)
+
+	InitUploadSession(ctx context.Context, req *InitUploadSessionReq) (r *InitUploadSessionResp, err error)
+
+	UploadSessionChunk(ctx context.Context, req *UploadSessionChunkReq) (r *UploadSessionChunkResp, err error)
+
+	GetUploadSession(ctx context.Context, req *GetUploadSessionReq) (r *GetUploadSessionResp, err error)
+
+	CompleteUploadSession(ctx context.Context, req *CompleteUploadSessionReq) (r *CompleteUploadSessionResp, err error)
+
+	AbortUploadSession(ctx context.Context, req *AbortUploadSessionReq) (r *AbortUploadSessionResp, err error)
+
+	PresignUpload(ctx context.Context, req *PresignUploadReq) (r *PresignUploadResp, err error)
+
+	ConfirmUpload(ctx context.Context, req *ConfirmUploadReq) (r *ConfirmUploadResp, err error)
+
+	UploadPictureByArchive(ctx context.Context, req *UploadPictureByArchiveReq) (r *UploadPictureByArchiveResp, err error)
+	//# admin
+	DeletePicture(ctx context.Context, req *DeletePictureReq) (r *DeletePictureResp, err error)
+
+	UpdatePicture(ctx context.Context, req *UpdatePictureReq) (r *UpdatePictureResp, err error)
+
+	QueryPicture(ctx context.Context, req *QueryPictureReq) (r *QueryPictureResp, err error)
+
+	QueryPictureById(ctx context.Context, req *QueryPictureByIdReq) (r *QueryPictureByIdResp, err error)
+
+	ReviewPicture(ctx context.Context, req *ReviewPictureReq) (r *ReviewPictureResp, err error)
+
+	UploadPictureByBatch(ctx context.Context, req *UploadPictureByBatchReq) (r *UploadPictureByBatchResp, err error)
+}
+
+type PictureServiceClient struct {
+	c thrift.TClient
+}
+
+func NewPictureServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *PictureServiceClient {
+	return &PictureServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewPictureServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *PictureServiceClient {
+	return &PictureServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewPictureServiceClient(c thrift.TClient) *PictureServiceClient {
+	return &PictureServiceClient{
+		c: c,
+	}
+}
+
+func (p *PictureServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *PictureServiceClient) PictureListTagCategory(ctx context.Context, req *PictureTagCategoryReq) (r *PictureTagCategoryResp, err error) {
+	var _args PictureServicePictureListTagCategoryArgs
+	_args.Req = req
+	var _result PictureServicePictureListTagCategoryResult
+	if err = p.Client_().Call(ctx, "PictureListTagCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) PictureSearch(ctx context.Context, req *PictureSearchReq) (r *PictureSearchResp, err error) {
+	var _args PictureServicePictureSearchArgs
+	_args.Req = req
+	var _result PictureServicePictureSearchResult
+	if err = p.Client_().Call(ctx, "PictureSearch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) PictureGetById(ctx context.Context, req *PictureGetByIdReq) (r *PictureGetByIdResp, err error) {
+	var _args PictureServicePictureGetByIdArgs
+	_args.Req = req
+	var _result PictureServicePictureGetByIdResult
+	if err = p.Client_().Call(ctx, "PictureGetById", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) PictureImage(ctx context.Context, req *PictureImageReq) (r *PictureImageResp, err error) {
+	var _args PictureServicePictureImageArgs
+	_args.Req = req
+	var _result PictureServicePictureImageResult
+	if err = p.Client_().Call(ctx, "PictureImage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) PictureEdit(ctx context.Context, req *PictureEditReq) (r *PictureEditResp, err error) {
+	var _args PictureServicePictureEditArgs
+	_args.Req = req
+	var _result PictureServicePictureEditResult
+	if err = p.Client_().Call(ctx, "PictureEdit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) PictureDelete(ctx context.Context, req *PictureDeleteReq) (r *PictureDeleteResp, err error) {
+	var _args PictureServicePictureDeleteArgs
+	_args.Req = req
+	var _result PictureServicePictureDeleteResult
+	if err = p.Client_().Call(ctx, "PictureDelete", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) PictureLike(ctx context.Context, req *PictureLikeReq) (r *PictureLikeResp, err error) {
+	var _args PictureServicePictureLikeArgs
+	_args.Req = req
+	var _result PictureServicePictureLikeResult
+	if err = p.Client_().Call(ctx, "PictureLike", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) PictureFavorite(ctx context.Context, req *PictureFavoriteReq) (r *PictureFavoriteResp, err error) {
+	var _args PictureServicePictureFavoriteArgs
+	_args.Req = req
+	var _result PictureServicePictureFavoriteResult
+	if err = p.Client_().Call(ctx, "PictureFavorite", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) ListFavoritePicture(ctx context.Context, req *ListFavoritePictureReq) (r *ListFavoritePictureResp, err error) {
+	var _args PictureServiceListFavoritePictureArgs
+	_args.Req = req
+	var _result PictureServiceListFavoritePictureResult
+	if err = p.Client_().Call(ctx, "ListFavoritePicture", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) PictureFeed(ctx context.Context, req *PictureFeedReq) (r *PictureFeedResp, err error) {
+	var _args PictureServicePictureFeedArgs
+	_args.Req = req
+	var _result PictureServicePictureFeedResult
+	if err = p.Client_().Call(ctx, "PictureFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) UploadPicture(ctx context.Context, req *UploadPictureReq) (r *UploadPictureResp, err error) {
+	var _args PictureServiceUploadPictureArgs
+	_args.Req = req
+	var _result PictureServiceUploadPictureResult
+	if err = p.Client_().Call(ctx, "UploadPicture", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) SearchSimilarPicture(ctx context.Context, req *SearchSimilarPictureReq) (r *SearchSimilarPictureResp, err error) {
+	var _args PictureServiceSearchSimilarPictureArgs
+	_args.Req = req
+	var _result PictureServiceSearchSimilarPictureResult
+	if err = p.Client_().Call(ctx, "SearchSimilarPicture", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) InitUploadSession(ctx context.Context, req *InitUploadSessionReq) (r *InitUploadSessionResp, err error) {
+	var _args PictureServiceInitUploadSessionArgs
+	_args.Req = req
+	var _result PictureServiceInitUploadSessionResult
+	if err = p.Client_().Call(ctx, "InitUploadSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) UploadSessionChunk(ctx context.Context, req *UploadSessionChunkReq) (r *UploadSessionChunkResp, err error) {
+	var _args PictureServiceUploadSessionChunkArgs
+	_args.Req = req
+	var _result PictureServiceUploadSessionChunkResult
+	if err = p.Client_().Call(ctx, "UploadSessionChunk", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) GetUploadSession(ctx context.Context, req *GetUploadSessionReq) (r *GetUploadSessionResp, err error) {
+	var _args PictureServiceGetUploadSessionArgs
+	_args.Req = req
+	var _result PictureServiceGetUploadSessionResult
+	if err = p.Client_().Call(ctx, "GetUploadSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) CompleteUploadSession(ctx context.Context, req *CompleteUploadSessionReq) (r *CompleteUploadSessionResp, err error) {
+	var _args PictureServiceCompleteUploadSessionArgs
+	_args.Req = req
+	var _result PictureServiceCompleteUploadSessionResult
+	if err = p.Client_().Call(ctx, "CompleteUploadSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) AbortUploadSession(ctx context.Context, req *AbortUploadSessionReq) (r *AbortUploadSessionResp, err error) {
+	var _args PictureServiceAbortUploadSessionArgs
+	_args.Req = req
+	var _result PictureServiceAbortUploadSessionResult
+	if err = p.Client_().Call(ctx, "AbortUploadSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) PresignUpload(ctx context.Context, req *PresignUploadReq) (r *PresignUploadResp, err error) {
+	var _args PictureServicePresignUploadArgs
+	_args.Req = req
+	var _result PictureServicePresignUploadResult
+	if err = p.Client_().Call(ctx, "PresignUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) ConfirmUpload(ctx context.Context, req *ConfirmUploadReq) (r *ConfirmUploadResp, err error) {
+	var _args PictureServiceConfirmUploadArgs
+	_args.Req = req
+	var _result PictureServiceConfirmUploadResult
+	if err = p.Client_().Call(ctx, "ConfirmUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) UploadPictureByArchive(ctx context.Context, req *UploadPictureByArchiveReq) (r *UploadPictureByArchiveResp, err error) {
+	var _args PictureServiceUploadPictureByArchiveArgs
+	_args.Req = req
+	var _result PictureServiceUploadPictureByArchiveResult
+	if err = p.Client_().Call(ctx, "UploadPictureByArchive", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) DeletePicture(ctx context.Context, req *DeletePictureReq) (r *DeletePictureResp, err error) {
+	var _args PictureServiceDeletePictureArgs
+	_args.Req = req
+	var _result PictureServiceDeletePictureResult
+	if err = p.Client_().Call(ctx, "DeletePicture", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) UpdatePicture(ctx context.Context, req *UpdatePictureReq) (r *UpdatePictureResp, err error) {
+	var _args PictureServiceUpdatePictureArgs
+	_args.Req = req
+	var _result PictureServiceUpdatePictureResult
+	if err = p.Client_().Call(ctx, "UpdatePicture", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) QueryPicture(ctx context.Context, req *QueryPictureReq) (r *QueryPictureResp, err error) {
+	var _args PictureServiceQueryPictureArgs
+	_args.Req = req
+	var _result PictureServiceQueryPictureResult
+	if err = p.Client_().Call(ctx, "QueryPicture", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) QueryPictureById(ctx context.Context, req *QueryPictureByIdReq) (r *QueryPictureByIdResp, err error) {
+	var _args PictureServiceQueryPictureByIdArgs
+	_args.Req = req
+	var _result PictureServiceQueryPictureByIdResult
+	if err = p.Client_().Call(ctx, "QueryPictureById", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) ReviewPicture(ctx context.Context, req *ReviewPictureReq) (r *ReviewPictureResp, err error) {
+	var _args PictureServiceReviewPictureArgs
+	_args.Req = req
+	var _result PictureServiceReviewPictureResult
+	if err = p.Client_().Call(ctx, "ReviewPicture", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PictureServiceClient) UploadPictureByBatch(ctx context.Context, req *UploadPictureByBatchReq) (r *UploadPictureByBatchResp, err error) {
+	var _args PictureServiceUploadPictureByBatchArgs
+	_args.Req = req
+	var _result PictureServiceUploadPictureByBatchResult
+	if err = p.Client_().Call(ctx, "UploadPictureByBatch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type PictureServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      PictureService
+}
+
+func (p *PictureServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *PictureServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *PictureServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewPictureServiceProcessor(handler PictureService) *PictureServiceProcessor {
+	self := &PictureServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("PictureListTagCategory", &pictureServiceProcessorPictureListTagCategory{handler: handler})
+	self.AddToProcessorMap("PictureSearch", &pictureServiceProcessorPictureSearch{handler: handler})
+	self.AddToProcessorMap("PictureGetById", &pictureServiceProcessorPictureGetById{handler: handler})
+	self.AddToProcessorMap("PictureImage", &pictureServiceProcessorPictureImage{handler: handler})
+	self.AddToProcessorMap("PictureEdit", &pictureServiceProcessorPictureEdit{handler: handler})
+	self.AddToProcessorMap("PictureDelete", &pictureServiceProcessorPictureDelete{handler: handler})
+	self.AddToProcessorMap("PictureLike", &pictureServiceProcessorPictureLike{handler: handler})
+	self.AddToProcessorMap("PictureFavorite", &pictureServiceProcessorPictureFavorite{handler: handler})
+	self.AddToProcessorMap("ListFavoritePicture", &pictureServiceProcessorListFavoritePicture{handler: handler})
+	self.AddToProcessorMap("PictureFeed", &pictureServiceProcessorPictureFeed{handler: handler})
+	self.AddToProcessorMap("UploadPicture", &pictureServiceProcessorUploadPicture{handler: handler})
+	self.AddToProcessorMap("SearchSimilarPicture", &pictureServiceProcessorSearchSimilarPicture{handler: handler})
+	self.AddToProcessorMap("InitUploadSession", &pictureServiceProcessorInitUploadSession{handler: handler})
+	self.AddToProcessorMap("UploadSessionChunk", &pictureServiceProcessorUploadSessionChunk{handler: handler})
+	self.AddToProcessorMap("GetUploadSession", &pictureServiceProcessorGetUploadSession{handler: handler})
+	self.AddToProcessorMap("CompleteUploadSession", &pictureServiceProcessorCompleteUploadSession{handler: handler})
+	self.AddToProcessorMap("AbortUploadSession", &pictureServiceProcessorAbortUploadSession{handler: handler})
+	self.AddToProcessorMap("PresignUpload", &pictureServiceProcessorPresignUpload{handler: handler})
+	self.AddToProcessorMap("ConfirmUpload", &pictureServiceProcessorConfirmUpload{handler: handler})
+	self.AddToProcessorMap("UploadPictureByArchive", &pictureServiceProcessorUploadPictureByArchive{handler: handler})
+	self.AddToProcessorMap("DeletePicture", &pictureServiceProcessorDeletePicture{handler: handler})
+	self.AddToProcessorMap("UpdatePicture", &pictureServiceProcessorUpdatePicture{handler: handler})
+	self.AddToProcessorMap("QueryPicture", &pictureServiceProcessorQueryPicture{handler: handler})
+	self.AddToProcessorMap("QueryPictureById", &pictureServiceProcessorQueryPictureById{handler: handler})
+	self.AddToProcessorMap("ReviewPicture", &pictureServiceProcessorReviewPicture{handler: handler})
+	self.AddToProcessorMap("UploadPictureByBatch", &pictureServiceProcessorUploadPictureByBatch{handler: handler})
+	return self
+}
+func (p *PictureServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type pictureServiceProcessorPictureListTagCategory struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorPictureListTagCategory) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServicePictureListTagCategoryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PictureListTagCategory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServicePictureListTagCategoryResult{}
+	var retval *PictureTagCategoryResp
+	if retval, err2 = p.handler.PictureListTagCategory(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureListTagCategory: "+err2.Error())
+		oprot.WriteMessageBegin("PictureListTagCategory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PictureListTagCategory", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorPictureSearch struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorPictureSearch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServicePictureSearchArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PictureSearch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServicePictureSearchResult{}
+	var retval *PictureSearchResp
+	if retval, err2 = p.handler.PictureSearch(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureSearch: "+err2.Error())
+		oprot.WriteMessageBegin("PictureSearch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PictureSearch", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorPictureGetById struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorPictureGetById) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServicePictureGetByIdArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PictureGetById", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServicePictureGetByIdResult{}
+	var retval *PictureGetByIdResp
+	if retval, err2 = p.handler.PictureGetById(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureGetById: "+err2.Error())
+		oprot.WriteMessageBegin("PictureGetById", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PictureGetById", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorPictureImage struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorPictureImage) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServicePictureImageArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PictureImage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServicePictureImageResult{}
+	var retval *PictureImageResp
+	if retval, err2 = p.handler.PictureImage(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureImage: "+err2.Error())
+		oprot.WriteMessageBegin("PictureImage", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PictureImage", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorPictureEdit struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorPictureEdit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServicePictureEditArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PictureEdit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServicePictureEditResult{}
+	var retval *PictureEditResp
+	if retval, err2 = p.handler.PictureEdit(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureEdit: "+err2.Error())
+		oprot.WriteMessageBegin("PictureEdit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PictureEdit", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorPictureDelete struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorPictureDelete) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServicePictureDeleteArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PictureDelete", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServicePictureDeleteResult{}
+	var retval *PictureDeleteResp
+	if retval, err2 = p.handler.PictureDelete(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureDelete: "+err2.Error())
+		oprot.WriteMessageBegin("PictureDelete", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PictureDelete", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorPictureLike struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorPictureLike) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServicePictureLikeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PictureLike", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServicePictureLikeResult{}
+	var retval *PictureLikeResp
+	if retval, err2 = p.handler.PictureLike(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureLike: "+err2.Error())
+		oprot.WriteMessageBegin("PictureLike", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PictureLike", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorPictureFavorite struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorPictureFavorite) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServicePictureFavoriteArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PictureFavorite", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServicePictureFavoriteResult{}
+	var retval *PictureFavoriteResp
+	if retval, err2 = p.handler.PictureFavorite(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureFavorite: "+err2.Error())
+		oprot.WriteMessageBegin("PictureFavorite", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PictureFavorite", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorListFavoritePicture struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorListFavoritePicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceListFavoritePictureArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListFavoritePicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceListFavoritePictureResult{}
+	var retval *ListFavoritePictureResp
+	if retval, err2 = p.handler.ListFavoritePicture(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListFavoritePicture: "+err2.Error())
+		oprot.WriteMessageBegin("ListFavoritePicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListFavoritePicture", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorPictureFeed struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorPictureFeed) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServicePictureFeedArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PictureFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServicePictureFeedResult{}
+	var retval *PictureFeedResp
+	if retval, err2 = p.handler.PictureFeed(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PictureFeed: "+err2.Error())
+		oprot.WriteMessageBegin("PictureFeed", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PictureFeed", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorUploadPicture struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorUploadPicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceUploadPictureArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UploadPicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceUploadPictureResult{}
+	var retval *UploadPictureResp
+	if retval, err2 = p.handler.UploadPicture(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadPicture: "+err2.Error())
+		oprot.WriteMessageBegin("UploadPicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UploadPicture", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorSearchSimilarPicture struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorSearchSimilarPicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceSearchSimilarPictureArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SearchSimilarPicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceSearchSimilarPictureResult{}
+	var retval *SearchSimilarPictureResp
+	if retval, err2 = p.handler.SearchSimilarPicture(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchSimilarPicture: "+err2.Error())
+		oprot.WriteMessageBegin("SearchSimilarPicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SearchSimilarPicture", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorInitUploadSession struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorInitUploadSession) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceInitUploadSessionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("InitUploadSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceInitUploadSessionResult{}
+	var retval *InitUploadSessionResp
+	if retval, err2 = p.handler.InitUploadSession(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing InitUploadSession: "+err2.Error())
+		oprot.WriteMessageBegin("InitUploadSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("InitUploadSession", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorUploadSessionChunk struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorUploadSessionChunk) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceUploadSessionChunkArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UploadSessionChunk", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceUploadSessionChunkResult{}
+	var retval *UploadSessionChunkResp
+	if retval, err2 = p.handler.UploadSessionChunk(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadSessionChunk: "+err2.Error())
+		oprot.WriteMessageBegin("UploadSessionChunk", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UploadSessionChunk", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorGetUploadSession struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorGetUploadSession) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceGetUploadSessionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUploadSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceGetUploadSessionResult{}
+	var retval *GetUploadSessionResp
+	if retval, err2 = p.handler.GetUploadSession(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUploadSession: "+err2.Error())
+		oprot.WriteMessageBegin("GetUploadSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUploadSession", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorCompleteUploadSession struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorCompleteUploadSession) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceCompleteUploadSessionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CompleteUploadSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceCompleteUploadSessionResult{}
+	var retval *CompleteUploadSessionResp
+	if retval, err2 = p.handler.CompleteUploadSession(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CompleteUploadSession: "+err2.Error())
+		oprot.WriteMessageBegin("CompleteUploadSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CompleteUploadSession", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorAbortUploadSession struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorAbortUploadSession) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceAbortUploadSessionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AbortUploadSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceAbortUploadSessionResult{}
+	var retval *AbortUploadSessionResp
+	if retval, err2 = p.handler.AbortUploadSession(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AbortUploadSession: "+err2.Error())
+		oprot.WriteMessageBegin("AbortUploadSession", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AbortUploadSession", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorPresignUpload struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorPresignUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServicePresignUploadArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PresignUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServicePresignUploadResult{}
+	var retval *PresignUploadResp
+	if retval, err2 = p.handler.PresignUpload(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PresignUpload: "+err2.Error())
+		oprot.WriteMessageBegin("PresignUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PresignUpload", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorConfirmUpload struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorConfirmUpload) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceConfirmUploadArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ConfirmUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceConfirmUploadResult{}
+	var retval *ConfirmUploadResp
+	if retval, err2 = p.handler.ConfirmUpload(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ConfirmUpload: "+err2.Error())
+		oprot.WriteMessageBegin("ConfirmUpload", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ConfirmUpload", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorUploadPictureByArchive struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorUploadPictureByArchive) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceUploadPictureByArchiveArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UploadPictureByArchive", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceUploadPictureByArchiveResult{}
+	var retval *UploadPictureByArchiveResp
+	if retval, err2 = p.handler.UploadPictureByArchive(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadPictureByArchive: "+err2.Error())
+		oprot.WriteMessageBegin("UploadPictureByArchive", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UploadPictureByArchive", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorDeletePicture struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorDeletePicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceDeletePictureArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeletePicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceDeletePictureResult{}
+	var retval *DeletePictureResp
+	if retval, err2 = p.handler.DeletePicture(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeletePicture: "+err2.Error())
+		oprot.WriteMessageBegin("DeletePicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeletePicture", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorUpdatePicture struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorUpdatePicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceUpdatePictureArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdatePicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceUpdatePictureResult{}
+	var retval *UpdatePictureResp
+	if retval, err2 = p.handler.UpdatePicture(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdatePicture: "+err2.Error())
+		oprot.WriteMessageBegin("UpdatePicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdatePicture", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorQueryPicture struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorQueryPicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceQueryPictureArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryPicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceQueryPictureResult{}
+	var retval *QueryPictureResp
+	if retval, err2 = p.handler.QueryPicture(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryPicture: "+err2.Error())
+		oprot.WriteMessageBegin("QueryPicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryPicture", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorQueryPictureById struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorQueryPictureById) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceQueryPictureByIdArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryPictureById", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceQueryPictureByIdResult{}
+	var retval *QueryPictureByIdResp
+	if retval, err2 = p.handler.QueryPictureById(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryPictureById: "+err2.Error())
+		oprot.WriteMessageBegin("QueryPictureById", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryPictureById", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorReviewPicture struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorReviewPicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceReviewPictureArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ReviewPicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceReviewPictureResult{}
+	var retval *ReviewPictureResp
+	if retval, err2 = p.handler.ReviewPicture(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReviewPicture: "+err2.Error())
+		oprot.WriteMessageBegin("ReviewPicture", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ReviewPicture", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type pictureServiceProcessorUploadPictureByBatch struct {
+	handler PictureService
+}
+
+func (p *pictureServiceProcessorUploadPictureByBatch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PictureServiceUploadPictureByBatchArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UploadPictureByBatch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PictureServiceUploadPictureByBatchResult{}
+	var retval *UploadPictureByBatchResp
+	if retval, err2 = p.handler.UploadPictureByBatch(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadPictureByBatch: "+err2.Error())
+		oprot.WriteMessageBegin("UploadPictureByBatch", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UploadPictureByBatch", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
 		err = err2
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type PictureServicePictureListTagCategoryArgs struct {
+	Req *PictureTagCategoryReq `thrift:"req,1"`
+}
+
+func NewPictureServicePictureListTagCategoryArgs() *PictureServicePictureListTagCategoryArgs {
+	return &PictureServicePictureListTagCategoryArgs{}
+}
+
+func (p *PictureServicePictureListTagCategoryArgs) InitDefault() {
+}
+
+var PictureServicePictureListTagCategoryArgs_Req_DEFAULT *PictureTagCategoryReq
+
+func (p *PictureServicePictureListTagCategoryArgs) GetReq() (v *PictureTagCategoryReq) {
+	if !p.IsSetReq() {
+		return PictureServicePictureListTagCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServicePictureListTagCategoryArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServicePictureListTagCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServicePictureListTagCategoryArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureListTagCategoryArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureListTagCategoryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPictureTagCategoryReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServicePictureListTagCategoryArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureListTagCategory_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureListTagCategoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServicePictureListTagCategoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureListTagCategoryArgs(%+v)", *p)
+
+}
+
+type PictureServicePictureListTagCategoryResult struct {
+	Success *PictureTagCategoryResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServicePictureListTagCategoryResult() *PictureServicePictureListTagCategoryResult {
+	return &PictureServicePictureListTagCategoryResult{}
+}
+
+func (p *PictureServicePictureListTagCategoryResult) InitDefault() {
+}
+
+var PictureServicePictureListTagCategoryResult_Success_DEFAULT *PictureTagCategoryResp
+
+func (p *PictureServicePictureListTagCategoryResult) GetSuccess() (v *PictureTagCategoryResp) {
+	if !p.IsSetSuccess() {
+		return PictureServicePictureListTagCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServicePictureListTagCategoryResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServicePictureListTagCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServicePictureListTagCategoryResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureListTagCategoryResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureListTagCategoryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPictureTagCategoryResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServicePictureListTagCategoryResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureListTagCategory_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureListTagCategoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServicePictureListTagCategoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureListTagCategoryResult(%+v)", *p)
+
+}
+
+type PictureServicePictureSearchArgs struct {
+	Req *PictureSearchReq `thrift:"req,1"`
+}
+
+func NewPictureServicePictureSearchArgs() *PictureServicePictureSearchArgs {
+	return &PictureServicePictureSearchArgs{}
+}
+
+func (p *PictureServicePictureSearchArgs) InitDefault() {
+}
+
+var PictureServicePictureSearchArgs_Req_DEFAULT *PictureSearchReq
+
+func (p *PictureServicePictureSearchArgs) GetReq() (v *PictureSearchReq) {
+	if !p.IsSetReq() {
+		return PictureServicePictureSearchArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServicePictureSearchArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServicePictureSearchArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServicePictureSearchArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureSearchArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureSearchArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPictureSearchReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServicePictureSearchArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureSearch_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureSearchArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServicePictureSearchArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureSearchArgs(%+v)", *p)
+
+}
+
+type PictureServicePictureSearchResult struct {
+	Success *PictureSearchResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServicePictureSearchResult() *PictureServicePictureSearchResult {
+	return &PictureServicePictureSearchResult{}
+}
+
+func (p *PictureServicePictureSearchResult) InitDefault() {
+}
+
+var PictureServicePictureSearchResult_Success_DEFAULT *PictureSearchResp
+
+func (p *PictureServicePictureSearchResult) GetSuccess() (v *PictureSearchResp) {
+	if !p.IsSetSuccess() {
+		return PictureServicePictureSearchResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServicePictureSearchResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServicePictureSearchResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServicePictureSearchResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureSearchResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureSearchResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPictureSearchResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServicePictureSearchResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureSearch_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureSearchResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServicePictureSearchResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureSearchResult(%+v)", *p)
+
+}
+
+type PictureServicePictureGetByIdArgs struct {
+	Req *PictureGetByIdReq `thrift:"req,1"`
+}
+
+func NewPictureServicePictureGetByIdArgs() *PictureServicePictureGetByIdArgs {
+	return &PictureServicePictureGetByIdArgs{}
+}
+
+func (p *PictureServicePictureGetByIdArgs) InitDefault() {
+}
+
+var PictureServicePictureGetByIdArgs_Req_DEFAULT *PictureGetByIdReq
+
+func (p *PictureServicePictureGetByIdArgs) GetReq() (v *PictureGetByIdReq) {
+	if !p.IsSetReq() {
+		return PictureServicePictureGetByIdArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServicePictureGetByIdArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServicePictureGetByIdArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServicePictureGetByIdArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureGetByIdArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureGetByIdArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPictureGetByIdReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServicePictureGetByIdArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureGetById_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureGetByIdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServicePictureGetByIdArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureGetByIdArgs(%+v)", *p)
+
+}
+
+type PictureServicePictureGetByIdResult struct {
+	Success *PictureGetByIdResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServicePictureGetByIdResult() *PictureServicePictureGetByIdResult {
+	return &PictureServicePictureGetByIdResult{}
+}
+
+func (p *PictureServicePictureGetByIdResult) InitDefault() {
+}
+
+var PictureServicePictureGetByIdResult_Success_DEFAULT *PictureGetByIdResp
+
+func (p *PictureServicePictureGetByIdResult) GetSuccess() (v *PictureGetByIdResp) {
+	if !p.IsSetSuccess() {
+		return PictureServicePictureGetByIdResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServicePictureGetByIdResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServicePictureGetByIdResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServicePictureGetByIdResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureGetByIdResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureGetByIdResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPictureGetByIdResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServicePictureGetByIdResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureGetById_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureGetByIdResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServicePictureGetByIdResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureGetByIdResult(%+v)", *p)
+
+}
+
+type PictureServicePictureImageArgs struct {
+	Req *PictureImageReq `thrift:"req,1"`
+}
+
+func NewPictureServicePictureImageArgs() *PictureServicePictureImageArgs {
+	return &PictureServicePictureImageArgs{}
+}
+
+func (p *PictureServicePictureImageArgs) InitDefault() {
+}
+
+var PictureServicePictureImageArgs_Req_DEFAULT *PictureImageReq
+
+func (p *PictureServicePictureImageArgs) GetReq() (v *PictureImageReq) {
+	if !p.IsSetReq() {
+		return PictureServicePictureImageArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServicePictureImageArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServicePictureImageArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServicePictureImageArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureImageArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureImageArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPictureImageReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServicePictureImageArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureImage_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureImageArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServicePictureImageArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureImageArgs(%+v)", *p)
+
+}
+
+type PictureServicePictureImageResult struct {
+	Success *PictureImageResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServicePictureImageResult() *PictureServicePictureImageResult {
+	return &PictureServicePictureImageResult{}
+}
+
+func (p *PictureServicePictureImageResult) InitDefault() {
+}
+
+var PictureServicePictureImageResult_Success_DEFAULT *PictureImageResp
+
+func (p *PictureServicePictureImageResult) GetSuccess() (v *PictureImageResp) {
+	if !p.IsSetSuccess() {
+		return PictureServicePictureImageResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServicePictureImageResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServicePictureImageResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServicePictureImageResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureImageResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureImageResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPictureImageResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServicePictureImageResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureImage_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureImageResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServicePictureImageResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureImageResult(%+v)", *p)
+
+}
+
+type PictureServicePictureEditArgs struct {
+	Req *PictureEditReq `thrift:"req,1"`
+}
+
+func NewPictureServicePictureEditArgs() *PictureServicePictureEditArgs {
+	return &PictureServicePictureEditArgs{}
+}
+
+func (p *PictureServicePictureEditArgs) InitDefault() {
+}
+
+var PictureServicePictureEditArgs_Req_DEFAULT *PictureEditReq
+
+func (p *PictureServicePictureEditArgs) GetReq() (v *PictureEditReq) {
+	if !p.IsSetReq() {
+		return PictureServicePictureEditArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServicePictureEditArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServicePictureEditArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServicePictureEditArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureEditArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureEditArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPictureEditReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServicePictureEditArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureEdit_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureEditArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServicePictureEditArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureEditArgs(%+v)", *p)
+
+}
+
+type PictureServicePictureEditResult struct {
+	Success *PictureEditResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServicePictureEditResult() *PictureServicePictureEditResult {
+	return &PictureServicePictureEditResult{}
+}
+
+func (p *PictureServicePictureEditResult) InitDefault() {
+}
+
+var PictureServicePictureEditResult_Success_DEFAULT *PictureEditResp
+
+func (p *PictureServicePictureEditResult) GetSuccess() (v *PictureEditResp) {
+	if !p.IsSetSuccess() {
+		return PictureServicePictureEditResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServicePictureEditResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServicePictureEditResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServicePictureEditResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureEditResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureEditResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPictureEditResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServicePictureEditResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureEdit_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureEditResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServicePictureEditResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureEditResult(%+v)", *p)
+
+}
+
+type PictureServicePictureDeleteArgs struct {
+	Req *PictureDeleteReq `thrift:"req,1"`
+}
+
+func NewPictureServicePictureDeleteArgs() *PictureServicePictureDeleteArgs {
+	return &PictureServicePictureDeleteArgs{}
+}
+
+func (p *PictureServicePictureDeleteArgs) InitDefault() {
+}
+
+var PictureServicePictureDeleteArgs_Req_DEFAULT *PictureDeleteReq
+
+func (p *PictureServicePictureDeleteArgs) GetReq() (v *PictureDeleteReq) {
+	if !p.IsSetReq() {
+		return PictureServicePictureDeleteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServicePictureDeleteArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServicePictureDeleteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServicePictureDeleteArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureDeleteArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureDeleteArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPictureDeleteReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServicePictureDeleteArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureDelete_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureDeleteArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServicePictureDeleteArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureDeleteArgs(%+v)", *p)
+
+}
+
+type PictureServicePictureDeleteResult struct {
+	Success *PictureDeleteResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServicePictureDeleteResult() *PictureServicePictureDeleteResult {
+	return &PictureServicePictureDeleteResult{}
+}
+
+func (p *PictureServicePictureDeleteResult) InitDefault() {
+}
+
+var PictureServicePictureDeleteResult_Success_DEFAULT *PictureDeleteResp
+
+func (p *PictureServicePictureDeleteResult) GetSuccess() (v *PictureDeleteResp) {
+	if !p.IsSetSuccess() {
+		return PictureServicePictureDeleteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServicePictureDeleteResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServicePictureDeleteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServicePictureDeleteResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureDeleteResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureDeleteResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPictureDeleteResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServicePictureDeleteResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureDelete_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureDeleteResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServicePictureDeleteResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureDeleteResult(%+v)", *p)
+
+}
+
+type PictureServicePictureLikeArgs struct {
+	Req *PictureLikeReq `thrift:"req,1"`
+}
+
+func NewPictureServicePictureLikeArgs() *PictureServicePictureLikeArgs {
+	return &PictureServicePictureLikeArgs{}
+}
+
+func (p *PictureServicePictureLikeArgs) InitDefault() {
+}
+
+var PictureServicePictureLikeArgs_Req_DEFAULT *PictureLikeReq
+
+func (p *PictureServicePictureLikeArgs) GetReq() (v *PictureLikeReq) {
+	if !p.IsSetReq() {
+		return PictureServicePictureLikeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServicePictureLikeArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServicePictureLikeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServicePictureLikeArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureLikeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureLikeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPictureLikeReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServicePictureLikeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureLike_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureLikeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServicePictureLikeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureLikeArgs(%+v)", *p)
+
+}
+
+type PictureServicePictureLikeResult struct {
+	Success *PictureLikeResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServicePictureLikeResult() *PictureServicePictureLikeResult {
+	return &PictureServicePictureLikeResult{}
+}
+
+func (p *PictureServicePictureLikeResult) InitDefault() {
+}
+
+var PictureServicePictureLikeResult_Success_DEFAULT *PictureLikeResp
+
+func (p *PictureServicePictureLikeResult) GetSuccess() (v *PictureLikeResp) {
+	if !p.IsSetSuccess() {
+		return PictureServicePictureLikeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServicePictureLikeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServicePictureLikeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServicePictureLikeResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureLikeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureLikeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPictureLikeResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServicePictureLikeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureLike_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureLikeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServicePictureLikeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureLikeResult(%+v)", *p)
+
+}
+
+type PictureServicePictureFavoriteArgs struct {
+	Req *PictureFavoriteReq `thrift:"req,1"`
+}
+
+func NewPictureServicePictureFavoriteArgs() *PictureServicePictureFavoriteArgs {
+	return &PictureServicePictureFavoriteArgs{}
+}
+
+func (p *PictureServicePictureFavoriteArgs) InitDefault() {
+}
+
+var PictureServicePictureFavoriteArgs_Req_DEFAULT *PictureFavoriteReq
+
+func (p *PictureServicePictureFavoriteArgs) GetReq() (v *PictureFavoriteReq) {
+	if !p.IsSetReq() {
+		return PictureServicePictureFavoriteArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServicePictureFavoriteArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServicePictureFavoriteArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServicePictureFavoriteArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureFavoriteArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureFavoriteArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPictureFavoriteReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServicePictureFavoriteArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureFavorite_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureFavoriteArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServicePictureFavoriteArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureFavoriteArgs(%+v)", *p)
+
+}
+
+type PictureServicePictureFavoriteResult struct {
+	Success *PictureFavoriteResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServicePictureFavoriteResult() *PictureServicePictureFavoriteResult {
+	return &PictureServicePictureFavoriteResult{}
+}
+
+func (p *PictureServicePictureFavoriteResult) InitDefault() {
+}
+
+var PictureServicePictureFavoriteResult_Success_DEFAULT *PictureFavoriteResp
+
+func (p *PictureServicePictureFavoriteResult) GetSuccess() (v *PictureFavoriteResp) {
+	if !p.IsSetSuccess() {
+		return PictureServicePictureFavoriteResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServicePictureFavoriteResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServicePictureFavoriteResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServicePictureFavoriteResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureFavoriteResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureFavoriteResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPictureFavoriteResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServicePictureFavoriteResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureFavorite_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureFavoriteResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServicePictureFavoriteResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureFavoriteResult(%+v)", *p)
+
+}
+
+type PictureServiceListFavoritePictureArgs struct {
+	Req *ListFavoritePictureReq `thrift:"req,1"`
+}
+
+func NewPictureServiceListFavoritePictureArgs() *PictureServiceListFavoritePictureArgs {
+	return &PictureServiceListFavoritePictureArgs{}
+}
+
+func (p *PictureServiceListFavoritePictureArgs) InitDefault() {
+}
+
+var PictureServiceListFavoritePictureArgs_Req_DEFAULT *ListFavoritePictureReq
+
+func (p *PictureServiceListFavoritePictureArgs) GetReq() (v *ListFavoritePictureReq) {
+	if !p.IsSetReq() {
+		return PictureServiceListFavoritePictureArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServiceListFavoritePictureArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServiceListFavoritePictureArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServiceListFavoritePictureArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceListFavoritePictureArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServiceListFavoritePictureArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListFavoritePictureReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServiceListFavoritePictureArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListFavoritePicture_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceListFavoritePictureArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServiceListFavoritePictureArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServiceListFavoritePictureArgs(%+v)", *p)
+
+}
+
+type PictureServiceListFavoritePictureResult struct {
+	Success *ListFavoritePictureResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServiceListFavoritePictureResult() *PictureServiceListFavoritePictureResult {
+	return &PictureServiceListFavoritePictureResult{}
+}
+
+func (p *PictureServiceListFavoritePictureResult) InitDefault() {
+}
+
+var PictureServiceListFavoritePictureResult_Success_DEFAULT *ListFavoritePictureResp
+
+func (p *PictureServiceListFavoritePictureResult) GetSuccess() (v *ListFavoritePictureResp) {
+	if !p.IsSetSuccess() {
+		return PictureServiceListFavoritePictureResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServiceListFavoritePictureResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServiceListFavoritePictureResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServiceListFavoritePictureResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceListFavoritePictureResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServiceListFavoritePictureResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListFavoritePictureResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServiceListFavoritePictureResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListFavoritePicture_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceListFavoritePictureResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServiceListFavoritePictureResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServiceListFavoritePictureResult(%+v)", *p)
+
+}
+
+type PictureServicePictureFeedArgs struct {
+	Req *PictureFeedReq `thrift:"req,1"`
+}
+
+func NewPictureServicePictureFeedArgs() *PictureServicePictureFeedArgs {
+	return &PictureServicePictureFeedArgs{}
+}
+
+func (p *PictureServicePictureFeedArgs) InitDefault() {
+}
+
+var PictureServicePictureFeedArgs_Req_DEFAULT *PictureFeedReq
+
+func (p *PictureServicePictureFeedArgs) GetReq() (v *PictureFeedReq) {
+	if !p.IsSetReq() {
+		return PictureServicePictureFeedArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServicePictureFeedArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServicePictureFeedArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServicePictureFeedArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureFeedArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureFeedArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPictureFeedReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServicePictureFeedArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureFeed_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureFeedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServicePictureFeedArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureFeedArgs(%+v)", *p)
+
+}
+
+type PictureServicePictureFeedResult struct {
+	Success *PictureFeedResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServicePictureFeedResult() *PictureServicePictureFeedResult {
+	return &PictureServicePictureFeedResult{}
+}
+
+func (p *PictureServicePictureFeedResult) InitDefault() {
+}
+
+var PictureServicePictureFeedResult_Success_DEFAULT *PictureFeedResp
+
+func (p *PictureServicePictureFeedResult) GetSuccess() (v *PictureFeedResp) {
+	if !p.IsSetSuccess() {
+		return PictureServicePictureFeedResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServicePictureFeedResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServicePictureFeedResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServicePictureFeedResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureFeedResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureFeedResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPictureFeedResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServicePictureFeedResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PictureFeed_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServicePictureFeedResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServicePictureFeedResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServicePictureFeedResult(%+v)", *p)
+
+}
+
+type PictureServiceUploadPictureArgs struct {
+	Req *UploadPictureReq `thrift:"req,1"`
+}
+
+func NewPictureServiceUploadPictureArgs() *PictureServiceUploadPictureArgs {
+	return &PictureServiceUploadPictureArgs{}
+}
+
+func (p *PictureServiceUploadPictureArgs) InitDefault() {
+}
+
+var PictureServiceUploadPictureArgs_Req_DEFAULT *UploadPictureReq
+
+func (p *PictureServiceUploadPictureArgs) GetReq() (v *UploadPictureReq) {
+	if !p.IsSetReq() {
+		return PictureServiceUploadPictureArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServiceUploadPictureArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServiceUploadPictureArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServiceUploadPictureArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceUploadPictureArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServiceUploadPictureArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUploadPictureReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServiceUploadPictureArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadPicture_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceUploadPictureArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServiceUploadPictureArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServiceUploadPictureArgs(%+v)", *p)
+
+}
+
+type PictureServiceUploadPictureResult struct {
+	Success *UploadPictureResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServiceUploadPictureResult() *PictureServiceUploadPictureResult {
+	return &PictureServiceUploadPictureResult{}
+}
+
+func (p *PictureServiceUploadPictureResult) InitDefault() {
+}
+
+var PictureServiceUploadPictureResult_Success_DEFAULT *UploadPictureResp
+
+func (p *PictureServiceUploadPictureResult) GetSuccess() (v *UploadPictureResp) {
+	if !p.IsSetSuccess() {
+		return PictureServiceUploadPictureResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServiceUploadPictureResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServiceUploadPictureResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServiceUploadPictureResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceUploadPictureResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServiceUploadPictureResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUploadPictureResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServiceUploadPictureResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadPicture_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceUploadPictureResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServiceUploadPictureResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServiceUploadPictureResult(%+v)", *p)
+
+}
+
+type PictureServiceSearchSimilarPictureArgs struct {
+	Req *SearchSimilarPictureReq `thrift:"req,1"`
+}
+
+func NewPictureServiceSearchSimilarPictureArgs() *PictureServiceSearchSimilarPictureArgs {
+	return &PictureServiceSearchSimilarPictureArgs{}
+}
+
+func (p *PictureServiceSearchSimilarPictureArgs) InitDefault() {
+}
+
+var PictureServiceSearchSimilarPictureArgs_Req_DEFAULT *SearchSimilarPictureReq
+
+func (p *PictureServiceSearchSimilarPictureArgs) GetReq() (v *SearchSimilarPictureReq) {
+	if !p.IsSetReq() {
+		return PictureServiceSearchSimilarPictureArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServiceSearchSimilarPictureArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServiceSearchSimilarPictureArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServiceSearchSimilarPictureArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceSearchSimilarPictureArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServiceSearchSimilarPictureArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSearchSimilarPictureReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServiceSearchSimilarPictureArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SearchSimilarPicture_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceSearchSimilarPictureArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServiceSearchSimilarPictureArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServiceSearchSimilarPictureArgs(%+v)", *p)
+
+}
+
+type PictureServiceSearchSimilarPictureResult struct {
+	Success *SearchSimilarPictureResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServiceSearchSimilarPictureResult() *PictureServiceSearchSimilarPictureResult {
+	return &PictureServiceSearchSimilarPictureResult{}
+}
+
+func (p *PictureServiceSearchSimilarPictureResult) InitDefault() {
+}
+
+var PictureServiceSearchSimilarPictureResult_Success_DEFAULT *SearchSimilarPictureResp
+
+func (p *PictureServiceSearchSimilarPictureResult) GetSuccess() (v *SearchSimilarPictureResp) {
+	if !p.IsSetSuccess() {
+		return PictureServiceSearchSimilarPictureResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServiceSearchSimilarPictureResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServiceSearchSimilarPictureResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServiceSearchSimilarPictureResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceSearchSimilarPictureResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServiceSearchSimilarPictureResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSearchSimilarPictureResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServiceSearchSimilarPictureResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SearchSimilarPicture_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceSearchSimilarPictureResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServiceSearchSimilarPictureResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServiceSearchSimilarPictureResult(%+v)", *p)
+
+}
+
+type PictureServiceInitUploadSessionArgs struct {
+	Req *InitUploadSessionReq `thrift:"req,1"`
+}
+
+func NewPictureServiceInitUploadSessionArgs() *PictureServiceInitUploadSessionArgs {
+	return &PictureServiceInitUploadSessionArgs{}
+}
+
+func (p *PictureServiceInitUploadSessionArgs) InitDefault() {
+}
+
+var PictureServiceInitUploadSessionArgs_Req_DEFAULT *InitUploadSessionReq
+
+func (p *PictureServiceInitUploadSessionArgs) GetReq() (v *InitUploadSessionReq) {
+	if !p.IsSetReq() {
+		return PictureServiceInitUploadSessionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServiceInitUploadSessionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServiceInitUploadSessionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServiceInitUploadSessionArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceInitUploadSessionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServiceInitUploadSessionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewInitUploadSessionReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServiceInitUploadSessionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("InitUploadSession_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceInitUploadSessionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServiceInitUploadSessionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServiceInitUploadSessionArgs(%+v)", *p)
+
+}
+
+type PictureServiceInitUploadSessionResult struct {
+	Success *InitUploadSessionResp `thrift:"success,0,optional"`
+}
+
+func NewPictureServiceInitUploadSessionResult() *PictureServiceInitUploadSessionResult {
+	return &PictureServiceInitUploadSessionResult{}
+}
+
+func (p *PictureServiceInitUploadSessionResult) InitDefault() {
+}
+
+var PictureServiceInitUploadSessionResult_Success_DEFAULT *InitUploadSessionResp
+
+func (p *PictureServiceInitUploadSessionResult) GetSuccess() (v *InitUploadSessionResp) {
+	if !p.IsSetSuccess() {
+		return PictureServiceInitUploadSessionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PictureServiceInitUploadSessionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServiceInitUploadSessionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServiceInitUploadSessionResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceInitUploadSessionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServiceInitUploadSessionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewInitUploadSessionResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServiceInitUploadSessionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("InitUploadSession_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceInitUploadSessionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServiceInitUploadSessionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServiceInitUploadSessionResult(%+v)", *p)
+
+}
+
+type PictureServiceUploadSessionChunkArgs struct {
+	Req *UploadSessionChunkReq `thrift:"req,1"`
+}
+
+func NewPictureServiceUploadSessionChunkArgs() *PictureServiceUploadSessionChunkArgs {
+	return &PictureServiceUploadSessionChunkArgs{}
+}
+
+func (p *PictureServiceUploadSessionChunkArgs) InitDefault() {
+}
+
+var PictureServiceUploadSessionChunkArgs_Req_DEFAULT *UploadSessionChunkReq
+
+func (p *PictureServiceUploadSessionChunkArgs) GetReq() (v *UploadSessionChunkReq) {
+	if !p.IsSetReq() {
+		return PictureServiceUploadSessionChunkArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PictureServiceUploadSessionChunkArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServiceUploadSessionChunkArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServiceUploadSessionChunkArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceUploadSessionChunkArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServiceUploadSessionChunkArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUploadSessionChunkReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PictureServiceUploadSessionChunkArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadSessionChunk_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceUploadSessionChunkArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PictureServiceUploadSessionChunkArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("PictureServiceUploadSessionChunkArgs(%+v)", *p)
+
 }
 
-type pictureServiceProcessorDeletePicture struct {
-	handler PictureService
+type PictureServiceUploadSessionChunkResult struct {
+	Success *UploadSessionChunkResp `thrift:"success,0,optional"`
 }
 
-func (p *pictureServiceProcessorDeletePicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServiceDeletePictureArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeletePicture", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func NewPictureServiceUploadSessionChunkResult() *PictureServiceUploadSessionChunkResult {
+	return &PictureServiceUploadSessionChunkResult{}
+}
+
+func (p *PictureServiceUploadSessionChunkResult) InitDefault() {
+}
+
+var PictureServiceUploadSessionChunkResult_Success_DEFAULT *UploadSessionChunkResp
+
+func (p *PictureServiceUploadSessionChunkResult) GetSuccess() (v *UploadSessionChunkResp) {
+	if !p.IsSetSuccess() {
+		return PictureServiceUploadSessionChunkResult_Success_DEFAULT
 	}
+	return p.Success
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServiceDeletePictureResult{}
-	var retval *DeletePictureResp
-	if retval, err2 = p.handler.DeletePicture(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeletePicture: "+err2.Error())
-		oprot.WriteMessageBegin("DeletePicture", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+var fieldIDToName_PictureServiceUploadSessionChunkResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServiceUploadSessionChunkResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServiceUploadSessionChunkResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("DeletePicture", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceUploadSessionChunkResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PictureServiceUploadSessionChunkResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUploadSessionChunkResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	p.Success = _field
+	return nil
+}
+
+func (p *PictureServiceUploadSessionChunkResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadSessionChunk_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err != nil {
-		return
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	return true, err
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type pictureServiceProcessorUpdatePicture struct {
-	handler PictureService
+func (p *PictureServiceUploadSessionChunkResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *pictureServiceProcessorUpdatePicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServiceUpdatePictureArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdatePicture", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *PictureServiceUploadSessionChunkResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("PictureServiceUploadSessionChunkResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServiceUpdatePictureResult{}
-	var retval *UpdatePictureResp
-	if retval, err2 = p.handler.UpdatePicture(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdatePicture: "+err2.Error())
-		oprot.WriteMessageBegin("UpdatePicture", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UpdatePicture", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+}
+
+type PictureServiceGetUploadSessionArgs struct {
+	Req *GetUploadSessionReq `thrift:"req,1"`
+}
+
+func NewPictureServiceGetUploadSessionArgs() *PictureServiceGetUploadSessionArgs {
+	return &PictureServiceGetUploadSessionArgs{}
+}
+
+func (p *PictureServiceGetUploadSessionArgs) InitDefault() {
+}
+
+var PictureServiceGetUploadSessionArgs_Req_DEFAULT *GetUploadSessionReq
+
+func (p *PictureServiceGetUploadSessionArgs) GetReq() (v *GetUploadSessionReq) {
+	if !p.IsSetReq() {
+		return PictureServiceGetUploadSessionArgs_Req_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Req
+}
+
+var fieldIDToName_PictureServiceGetUploadSessionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PictureServiceGetUploadSessionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PictureServiceGetUploadSessionArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type pictureServiceProcessorQueryPicture struct {
-	handler PictureService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceGetUploadSessionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *pictureServiceProcessorQueryPicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServiceQueryPictureArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("QueryPicture", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *PictureServiceGetUploadSessionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUploadSessionReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServiceQueryPictureResult{}
-	var retval *QueryPictureResp
-	if retval, err2 = p.handler.QueryPicture(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryPicture: "+err2.Error())
-		oprot.WriteMessageBegin("QueryPicture", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *PictureServiceGetUploadSessionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUploadSession_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("QueryPicture", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceGetUploadSessionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Req.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type pictureServiceProcessorQueryPictureById struct {
-	handler PictureService
+func (p *PictureServiceGetUploadSessionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PictureServiceGetUploadSessionArgs(%+v)", *p)
+
 }
 
-func (p *pictureServiceProcessorQueryPictureById) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServiceQueryPictureByIdArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("QueryPictureById", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type PictureServiceGetUploadSessionResult struct {
+	Success *GetUploadSessionResp `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServiceQueryPictureByIdResult{}
-	var retval *QueryPictureByIdResp
-	if retval, err2 = p.handler.QueryPictureById(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryPictureById: "+err2.Error())
-		oprot.WriteMessageBegin("QueryPictureById", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("QueryPictureById", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewPictureServiceGetUploadSessionResult() *PictureServiceGetUploadSessionResult {
+	return &PictureServiceGetUploadSessionResult{}
 }
 
-type pictureServiceProcessorReviewPicture struct {
-	handler PictureService
+func (p *PictureServiceGetUploadSessionResult) InitDefault() {
 }
 
-func (p *pictureServiceProcessorReviewPicture) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServiceReviewPictureArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ReviewPicture", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var PictureServiceGetUploadSessionResult_Success_DEFAULT *GetUploadSessionResp
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServiceReviewPictureResult{}
-	var retval *ReviewPictureResp
-	if retval, err2 = p.handler.ReviewPicture(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReviewPicture: "+err2.Error())
-		oprot.WriteMessageBegin("ReviewPicture", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("ReviewPicture", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *PictureServiceGetUploadSessionResult) GetSuccess() (v *GetUploadSessionResp) {
+	if !p.IsSetSuccess() {
+		return PictureServiceGetUploadSessionResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_PictureServiceGetUploadSessionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PictureServiceGetUploadSessionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PictureServiceGetUploadSessionResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type pictureServiceProcessorUploadPictureByBatch struct {
-	handler PictureService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceGetUploadSessionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *pictureServiceProcessorUploadPictureByBatch) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PictureServiceUploadPictureByBatchArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UploadPictureByBatch", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *PictureServiceGetUploadSessionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetUploadSessionResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PictureServiceUploadPictureByBatchResult{}
-	var retval *UploadPictureByBatchResp
-	if retval, err2 = p.handler.UploadPictureByBatch(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadPictureByBatch: "+err2.Error())
-		oprot.WriteMessageBegin("UploadPictureByBatch", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *PictureServiceGetUploadSessionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUploadSession_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UploadPictureByBatch", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PictureServiceGetUploadSessionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PictureServiceGetUploadSessionResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("PictureServiceGetUploadSessionResult(%+v)", *p)
+
 }
 
-type PictureServicePictureListTagCategoryArgs struct {
-	Req *PictureTagCategoryReq `thrift:"req,1"`
+type PictureServiceCompleteUploadSessionArgs struct {
+	Req *CompleteUploadSessionReq `thrift:"req,1"`
 }
 
-func NewPictureServicePictureListTagCategoryArgs() *PictureServicePictureListTagCategoryArgs {
-	return &PictureServicePictureListTagCategoryArgs{}
+func NewPictureServiceCompleteUploadSessionArgs() *PictureServiceCompleteUploadSessionArgs {
+	return &PictureServiceCompleteUploadSessionArgs{}
 }
 
-func (p *PictureServicePictureListTagCategoryArgs) InitDefault() {
+func (p *PictureServiceCompleteUploadSessionArgs) InitDefault() {
 }
 
-var PictureServicePictureListTagCategoryArgs_Req_DEFAULT *PictureTagCategoryReq
+var PictureServiceCompleteUploadSessionArgs_Req_DEFAULT *CompleteUploadSessionReq
 
-func (p *PictureServicePictureListTagCategoryArgs) GetReq() (v *PictureTagCategoryReq) {
+func (p *PictureServiceCompleteUploadSessionArgs) GetReq() (v *CompleteUploadSessionReq) {
 	if !p.IsSetReq() {
-		return PictureServicePictureListTagCategoryArgs_Req_DEFAULT
+		return PictureServiceCompleteUploadSessionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_PictureServicePictureListTagCategoryArgs = map[int16]string{
+var fieldIDToName_PictureServiceCompleteUploadSessionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *PictureServicePictureListTagCategoryArgs) IsSetReq() bool {
+func (p *PictureServiceCompleteUploadSessionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *PictureServicePictureListTagCategoryArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureServiceCompleteUploadSessionArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6822,7 +19382,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureListTagCategoryArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceCompleteUploadSessionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6832,8 +19392,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureListTagCategoryArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPictureTagCategoryReq()
+func (p *PictureServiceCompleteUploadSessionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCompleteUploadSessionReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6841,9 +19401,9 @@ func (p *PictureServicePictureListTagCategoryArgs) ReadField1(iprot thrift.TProt
 	return nil
 }
 
-func (p *PictureServicePictureListTagCategoryArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceCompleteUploadSessionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PictureListTagCategory_args"); err != nil {
+	if err = oprot.WriteStructBegin("CompleteUploadSession_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6869,7 +19429,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureListTagCategoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceCompleteUploadSessionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6886,43 +19446,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PictureServicePictureListTagCategoryArgs) String() string {
+func (p *PictureServiceCompleteUploadSessionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureServicePictureListTagCategoryArgs(%+v)", *p)
+	return fmt.Sprintf("PictureServiceCompleteUploadSessionArgs(%+v)", *p)
 
 }
 
-type PictureServicePictureListTagCategoryResult struct {
-	Success *PictureTagCategoryResp `thrift:"success,0,optional"`
+type PictureServiceCompleteUploadSessionResult struct {
+	Success *CompleteUploadSessionResp `thrift:"success,0,optional"`
 }
 
-func NewPictureServicePictureListTagCategoryResult() *PictureServicePictureListTagCategoryResult {
-	return &PictureServicePictureListTagCategoryResult{}
+func NewPictureServiceCompleteUploadSessionResult() *PictureServiceCompleteUploadSessionResult {
+	return &PictureServiceCompleteUploadSessionResult{}
 }
 
-func (p *PictureServicePictureListTagCategoryResult) InitDefault() {
+func (p *PictureServiceCompleteUploadSessionResult) InitDefault() {
 }
 
-var PictureServicePictureListTagCategoryResult_Success_DEFAULT *PictureTagCategoryResp
+var PictureServiceCompleteUploadSessionResult_Success_DEFAULT *CompleteUploadSessionResp
 
-func (p *PictureServicePictureListTagCategoryResult) GetSuccess() (v *PictureTagCategoryResp) {
+func (p *PictureServiceCompleteUploadSessionResult) GetSuccess() (v *CompleteUploadSessionResp) {
 	if !p.IsSetSuccess() {
-		return PictureServicePictureListTagCategoryResult_Success_DEFAULT
+		return PictureServiceCompleteUploadSessionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PictureServicePictureListTagCategoryResult = map[int16]string{
+var fieldIDToName_PictureServiceCompleteUploadSessionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PictureServicePictureListTagCategoryResult) IsSetSuccess() bool {
+func (p *PictureServiceCompleteUploadSessionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PictureServicePictureListTagCategoryResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureServiceCompleteUploadSessionResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6967,7 +19527,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureListTagCategoryResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceCompleteUploadSessionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6977,8 +19537,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureListTagCategoryResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewPictureTagCategoryResp()
+func (p *PictureServiceCompleteUploadSessionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewCompleteUploadSessionResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6986,9 +19546,9 @@ func (p *PictureServicePictureListTagCategoryResult) ReadField0(iprot thrift.TPr
 	return nil
 }
 
-func (p *PictureServicePictureListTagCategoryResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceCompleteUploadSessionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PictureListTagCategory_result"); err != nil {
+	if err = oprot.WriteStructBegin("CompleteUploadSession_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7014,7 +19574,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureListTagCategoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceCompleteUploadSessionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7033,43 +19593,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PictureServicePictureListTagCategoryResult) String() string {
+func (p *PictureServiceCompleteUploadSessionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureServicePictureListTagCategoryResult(%+v)", *p)
+	return fmt.Sprintf("PictureServiceCompleteUploadSessionResult(%+v)", *p)
 
 }
 
-type PictureServicePictureSearchArgs struct {
-	Req *PictureSearchReq `thrift:"req,1"`
+type PictureServiceAbortUploadSessionArgs struct {
+	Req *AbortUploadSessionReq `thrift:"req,1"`
 }
 
-func NewPictureServicePictureSearchArgs() *PictureServicePictureSearchArgs {
-	return &PictureServicePictureSearchArgs{}
+func NewPictureServiceAbortUploadSessionArgs() *PictureServiceAbortUploadSessionArgs {
+	return &PictureServiceAbortUploadSessionArgs{}
 }
 
-func (p *PictureServicePictureSearchArgs) InitDefault() {
+func (p *PictureServiceAbortUploadSessionArgs) InitDefault() {
 }
 
-var PictureServicePictureSearchArgs_Req_DEFAULT *PictureSearchReq
+var PictureServiceAbortUploadSessionArgs_Req_DEFAULT *AbortUploadSessionReq
 
-func (p *PictureServicePictureSearchArgs) GetReq() (v *PictureSearchReq) {
+func (p *PictureServiceAbortUploadSessionArgs) GetReq() (v *AbortUploadSessionReq) {
 	if !p.IsSetReq() {
-		return PictureServicePictureSearchArgs_Req_DEFAULT
+		return PictureServiceAbortUploadSessionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_PictureServicePictureSearchArgs = map[int16]string{
+var fieldIDToName_PictureServiceAbortUploadSessionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *PictureServicePictureSearchArgs) IsSetReq() bool {
+func (p *PictureServiceAbortUploadSessionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *PictureServicePictureSearchArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureServiceAbortUploadSessionArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7114,7 +19674,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureSearchArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceAbortUploadSessionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7124,8 +19684,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureSearchArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPictureSearchReq()
+func (p *PictureServiceAbortUploadSessionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewAbortUploadSessionReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7133,9 +19693,9 @@ func (p *PictureServicePictureSearchArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *PictureServicePictureSearchArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceAbortUploadSessionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PictureSearch_args"); err != nil {
+	if err = oprot.WriteStructBegin("AbortUploadSession_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7161,7 +19721,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureSearchArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceAbortUploadSessionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7178,43 +19738,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PictureServicePictureSearchArgs) String() string {
+func (p *PictureServiceAbortUploadSessionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureServicePictureSearchArgs(%+v)", *p)
+	return fmt.Sprintf("PictureServiceAbortUploadSessionArgs(%+v)", *p)
 
 }
 
-type PictureServicePictureSearchResult struct {
-	Success *PictureSearchResp `thrift:"success,0,optional"`
+type PictureServiceAbortUploadSessionResult struct {
+	Success *AbortUploadSessionResp `thrift:"success,0,optional"`
 }
 
-func NewPictureServicePictureSearchResult() *PictureServicePictureSearchResult {
-	return &PictureServicePictureSearchResult{}
+func NewPictureServiceAbortUploadSessionResult() *PictureServiceAbortUploadSessionResult {
+	return &PictureServiceAbortUploadSessionResult{}
 }
 
-func (p *PictureServicePictureSearchResult) InitDefault() {
+func (p *PictureServiceAbortUploadSessionResult) InitDefault() {
 }
 
-var PictureServicePictureSearchResult_Success_DEFAULT *PictureSearchResp
+var PictureServiceAbortUploadSessionResult_Success_DEFAULT *AbortUploadSessionResp
 
-func (p *PictureServicePictureSearchResult) GetSuccess() (v *PictureSearchResp) {
+func (p *PictureServiceAbortUploadSessionResult) GetSuccess() (v *AbortUploadSessionResp) {
 	if !p.IsSetSuccess() {
-		return PictureServicePictureSearchResult_Success_DEFAULT
+		return PictureServiceAbortUploadSessionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PictureServicePictureSearchResult = map[int16]string{
+var fieldIDToName_PictureServiceAbortUploadSessionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PictureServicePictureSearchResult) IsSetSuccess() bool {
+func (p *PictureServiceAbortUploadSessionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PictureServicePictureSearchResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureServiceAbortUploadSessionResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7259,7 +19819,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureSearchResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceAbortUploadSessionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7269,8 +19829,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureSearchResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewPictureSearchResp()
+func (p *PictureServiceAbortUploadSessionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewAbortUploadSessionResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7278,9 +19838,9 @@ func (p *PictureServicePictureSearchResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *PictureServicePictureSearchResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceAbortUploadSessionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PictureSearch_result"); err != nil {
+	if err = oprot.WriteStructBegin("AbortUploadSession_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7306,7 +19866,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureSearchResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceAbortUploadSessionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7325,43 +19885,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PictureServicePictureSearchResult) String() string {
+func (p *PictureServiceAbortUploadSessionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureServicePictureSearchResult(%+v)", *p)
+	return fmt.Sprintf("PictureServiceAbortUploadSessionResult(%+v)", *p)
 
 }
 
-type PictureServicePictureGetByIdArgs struct {
-	Req *PictureGetByIdReq `thrift:"req,1"`
+type PictureServicePresignUploadArgs struct {
+	Req *PresignUploadReq `thrift:"req,1"`
 }
 
-func NewPictureServicePictureGetByIdArgs() *PictureServicePictureGetByIdArgs {
-	return &PictureServicePictureGetByIdArgs{}
+func NewPictureServicePresignUploadArgs() *PictureServicePresignUploadArgs {
+	return &PictureServicePresignUploadArgs{}
 }
 
-func (p *PictureServicePictureGetByIdArgs) InitDefault() {
+func (p *PictureServicePresignUploadArgs) InitDefault() {
 }
 
-var PictureServicePictureGetByIdArgs_Req_DEFAULT *PictureGetByIdReq
+var PictureServicePresignUploadArgs_Req_DEFAULT *PresignUploadReq
 
-func (p *PictureServicePictureGetByIdArgs) GetReq() (v *PictureGetByIdReq) {
+func (p *PictureServicePresignUploadArgs) GetReq() (v *PresignUploadReq) {
 	if !p.IsSetReq() {
-		return PictureServicePictureGetByIdArgs_Req_DEFAULT
+		return PictureServicePresignUploadArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_PictureServicePictureGetByIdArgs = map[int16]string{
+var fieldIDToName_PictureServicePresignUploadArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *PictureServicePictureGetByIdArgs) IsSetReq() bool {
+func (p *PictureServicePresignUploadArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *PictureServicePictureGetByIdArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureServicePresignUploadArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7406,7 +19966,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureGetByIdArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePresignUploadArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7416,8 +19976,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureGetByIdArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPictureGetByIdReq()
+func (p *PictureServicePresignUploadArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewPresignUploadReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7425,9 +19985,9 @@ func (p *PictureServicePictureGetByIdArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *PictureServicePictureGetByIdArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureServicePresignUploadArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PictureGetById_args"); err != nil {
+	if err = oprot.WriteStructBegin("PresignUpload_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7453,7 +20013,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureGetByIdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PictureServicePresignUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7470,43 +20030,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PictureServicePictureGetByIdArgs) String() string {
+func (p *PictureServicePresignUploadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureServicePictureGetByIdArgs(%+v)", *p)
+	return fmt.Sprintf("PictureServicePresignUploadArgs(%+v)", *p)
 
 }
 
-type PictureServicePictureGetByIdResult struct {
-	Success *PictureGetByIdResp `thrift:"success,0,optional"`
+type PictureServicePresignUploadResult struct {
+	Success *PresignUploadResp `thrift:"success,0,optional"`
 }
 
-func NewPictureServicePictureGetByIdResult() *PictureServicePictureGetByIdResult {
-	return &PictureServicePictureGetByIdResult{}
+func NewPictureServicePresignUploadResult() *PictureServicePresignUploadResult {
+	return &PictureServicePresignUploadResult{}
 }
 
-func (p *PictureServicePictureGetByIdResult) InitDefault() {
+func (p *PictureServicePresignUploadResult) InitDefault() {
 }
 
-var PictureServicePictureGetByIdResult_Success_DEFAULT *PictureGetByIdResp
+var PictureServicePresignUploadResult_Success_DEFAULT *PresignUploadResp
 
-func (p *PictureServicePictureGetByIdResult) GetSuccess() (v *PictureGetByIdResp) {
+func (p *PictureServicePresignUploadResult) GetSuccess() (v *PresignUploadResp) {
 	if !p.IsSetSuccess() {
-		return PictureServicePictureGetByIdResult_Success_DEFAULT
+		return PictureServicePresignUploadResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PictureServicePictureGetByIdResult = map[int16]string{
+var fieldIDToName_PictureServicePresignUploadResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PictureServicePictureGetByIdResult) IsSetSuccess() bool {
+func (p *PictureServicePresignUploadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PictureServicePictureGetByIdResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureServicePresignUploadResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7551,7 +20111,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureGetByIdResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePresignUploadResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7561,8 +20121,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureGetByIdResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewPictureGetByIdResp()
+func (p *PictureServicePresignUploadResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewPresignUploadResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7570,9 +20130,9 @@ func (p *PictureServicePictureGetByIdResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *PictureServicePictureGetByIdResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureServicePresignUploadResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PictureGetById_result"); err != nil {
+	if err = oprot.WriteStructBegin("PresignUpload_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7598,7 +20158,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureGetByIdResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PictureServicePresignUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7617,43 +20177,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PictureServicePictureGetByIdResult) String() string {
+func (p *PictureServicePresignUploadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureServicePictureGetByIdResult(%+v)", *p)
+	return fmt.Sprintf("PictureServicePresignUploadResult(%+v)", *p)
 
 }
 
-type PictureServicePictureEditArgs struct {
-	Req *PictureEditReq `thrift:"req,1"`
+type PictureServiceConfirmUploadArgs struct {
+	Req *ConfirmUploadReq `thrift:"req,1"`
 }
 
-func NewPictureServicePictureEditArgs() *PictureServicePictureEditArgs {
-	return &PictureServicePictureEditArgs{}
+func NewPictureServiceConfirmUploadArgs() *PictureServiceConfirmUploadArgs {
+	return &PictureServiceConfirmUploadArgs{}
 }
 
-func (p *PictureServicePictureEditArgs) InitDefault() {
+func (p *PictureServiceConfirmUploadArgs) InitDefault() {
 }
 
-var PictureServicePictureEditArgs_Req_DEFAULT *PictureEditReq
+var PictureServiceConfirmUploadArgs_Req_DEFAULT *ConfirmUploadReq
 
-func (p *PictureServicePictureEditArgs) GetReq() (v *PictureEditReq) {
+func (p *PictureServiceConfirmUploadArgs) GetReq() (v *ConfirmUploadReq) {
 	if !p.IsSetReq() {
-		return PictureServicePictureEditArgs_Req_DEFAULT
+		return PictureServiceConfirmUploadArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_PictureServicePictureEditArgs = map[int16]string{
+var fieldIDToName_PictureServiceConfirmUploadArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *PictureServicePictureEditArgs) IsSetReq() bool {
+func (p *PictureServiceConfirmUploadArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *PictureServicePictureEditArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureServiceConfirmUploadArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7698,7 +20258,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureEditArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceConfirmUploadArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7708,8 +20268,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureEditArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewPictureEditReq()
+func (p *PictureServiceConfirmUploadArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewConfirmUploadReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7717,9 +20277,9 @@ func (p *PictureServicePictureEditArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *PictureServicePictureEditArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceConfirmUploadArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PictureEdit_args"); err != nil {
+	if err = oprot.WriteStructBegin("ConfirmUpload_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7745,7 +20305,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureEditArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceConfirmUploadArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7762,43 +20322,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PictureServicePictureEditArgs) String() string {
+func (p *PictureServiceConfirmUploadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureServicePictureEditArgs(%+v)", *p)
+	return fmt.Sprintf("PictureServiceConfirmUploadArgs(%+v)", *p)
 
 }
 
-type PictureServicePictureEditResult struct {
-	Success *PictureEditResp `thrift:"success,0,optional"`
+type PictureServiceConfirmUploadResult struct {
+	Success *ConfirmUploadResp `thrift:"success,0,optional"`
 }
 
-func NewPictureServicePictureEditResult() *PictureServicePictureEditResult {
-	return &PictureServicePictureEditResult{}
+func NewPictureServiceConfirmUploadResult() *PictureServiceConfirmUploadResult {
+	return &PictureServiceConfirmUploadResult{}
 }
 
-func (p *PictureServicePictureEditResult) InitDefault() {
+func (p *PictureServiceConfirmUploadResult) InitDefault() {
 }
 
-var PictureServicePictureEditResult_Success_DEFAULT *PictureEditResp
+var PictureServiceConfirmUploadResult_Success_DEFAULT *ConfirmUploadResp
 
-func (p *PictureServicePictureEditResult) GetSuccess() (v *PictureEditResp) {
+func (p *PictureServiceConfirmUploadResult) GetSuccess() (v *ConfirmUploadResp) {
 	if !p.IsSetSuccess() {
-		return PictureServicePictureEditResult_Success_DEFAULT
+		return PictureServiceConfirmUploadResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PictureServicePictureEditResult = map[int16]string{
+var fieldIDToName_PictureServiceConfirmUploadResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PictureServicePictureEditResult) IsSetSuccess() bool {
+func (p *PictureServiceConfirmUploadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PictureServicePictureEditResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureServiceConfirmUploadResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7843,7 +20403,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServicePictureEditResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceConfirmUploadResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7853,8 +20413,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureEditResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewPictureEditResp()
+func (p *PictureServiceConfirmUploadResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewConfirmUploadResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7862,9 +20422,9 @@ func (p *PictureServicePictureEditResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *PictureServicePictureEditResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceConfirmUploadResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("PictureEdit_result"); err != nil {
+	if err = oprot.WriteStructBegin("ConfirmUpload_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7890,7 +20450,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureServicePictureEditResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceConfirmUploadResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7909,43 +20469,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PictureServicePictureEditResult) String() string {
+func (p *PictureServiceConfirmUploadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureServicePictureEditResult(%+v)", *p)
+	return fmt.Sprintf("PictureServiceConfirmUploadResult(%+v)", *p)
 
 }
 
-type PictureServiceUploadPictureArgs struct {
-	Req *UploadPictureReq `thrift:"req,1"`
+type PictureServiceUploadPictureByArchiveArgs struct {
+	Req *UploadPictureByArchiveReq `thrift:"req,1"`
 }
 
-func NewPictureServiceUploadPictureArgs() *PictureServiceUploadPictureArgs {
-	return &PictureServiceUploadPictureArgs{}
+func NewPictureServiceUploadPictureByArchiveArgs() *PictureServiceUploadPictureByArchiveArgs {
+	return &PictureServiceUploadPictureByArchiveArgs{}
 }
 
-func (p *PictureServiceUploadPictureArgs) InitDefault() {
+func (p *PictureServiceUploadPictureByArchiveArgs) InitDefault() {
 }
 
-var PictureServiceUploadPictureArgs_Req_DEFAULT *UploadPictureReq
+var PictureServiceUploadPictureByArchiveArgs_Req_DEFAULT *UploadPictureByArchiveReq
 
-func (p *PictureServiceUploadPictureArgs) GetReq() (v *UploadPictureReq) {
+func (p *PictureServiceUploadPictureByArchiveArgs) GetReq() (v *UploadPictureByArchiveReq) {
 	if !p.IsSetReq() {
-		return PictureServiceUploadPictureArgs_Req_DEFAULT
+		return PictureServiceUploadPictureByArchiveArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_PictureServiceUploadPictureArgs = map[int16]string{
+var fieldIDToName_PictureServiceUploadPictureByArchiveArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *PictureServiceUploadPictureArgs) IsSetReq() bool {
+func (p *PictureServiceUploadPictureByArchiveArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *PictureServiceUploadPictureArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureServiceUploadPictureByArchiveArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7990,7 +20550,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceUploadPictureArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceUploadPictureByArchiveArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8000,8 +20560,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureServiceUploadPictureArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUploadPictureReq()
+func (p *PictureServiceUploadPictureByArchiveArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUploadPictureByArchiveReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8009,9 +20569,9 @@ func (p *PictureServiceUploadPictureArgs) ReadField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *PictureServiceUploadPictureArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceUploadPictureByArchiveArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadPicture_args"); err != nil {
+	if err = oprot.WriteStructBegin("UploadPictureByArchive_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8037,7 +20597,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureServiceUploadPictureArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceUploadPictureByArchiveArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8054,43 +20614,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PictureServiceUploadPictureArgs) String() string {
+func (p *PictureServiceUploadPictureByArchiveArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureServiceUploadPictureArgs(%+v)", *p)
+	return fmt.Sprintf("PictureServiceUploadPictureByArchiveArgs(%+v)", *p)
 
 }
 
-type PictureServiceUploadPictureResult struct {
-	Success *UploadPictureResp `thrift:"success,0,optional"`
+type PictureServiceUploadPictureByArchiveResult struct {
+	Success *UploadPictureByArchiveResp `thrift:"success,0,optional"`
 }
 
-func NewPictureServiceUploadPictureResult() *PictureServiceUploadPictureResult {
-	return &PictureServiceUploadPictureResult{}
+func NewPictureServiceUploadPictureByArchiveResult() *PictureServiceUploadPictureByArchiveResult {
+	return &PictureServiceUploadPictureByArchiveResult{}
 }
 
-func (p *PictureServiceUploadPictureResult) InitDefault() {
+func (p *PictureServiceUploadPictureByArchiveResult) InitDefault() {
 }
 
-var PictureServiceUploadPictureResult_Success_DEFAULT *UploadPictureResp
+var PictureServiceUploadPictureByArchiveResult_Success_DEFAULT *UploadPictureByArchiveResp
 
-func (p *PictureServiceUploadPictureResult) GetSuccess() (v *UploadPictureResp) {
+func (p *PictureServiceUploadPictureByArchiveResult) GetSuccess() (v *UploadPictureByArchiveResp) {
 	if !p.IsSetSuccess() {
-		return PictureServiceUploadPictureResult_Success_DEFAULT
+		return PictureServiceUploadPictureByArchiveResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PictureServiceUploadPictureResult = map[int16]string{
+var fieldIDToName_PictureServiceUploadPictureByArchiveResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PictureServiceUploadPictureResult) IsSetSuccess() bool {
+func (p *PictureServiceUploadPictureByArchiveResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PictureServiceUploadPictureResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PictureServiceUploadPictureByArchiveResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -8135,7 +20695,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceUploadPictureResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PictureServiceUploadPictureByArchiveResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8145,8 +20705,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PictureServiceUploadPictureResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUploadPictureResp()
+func (p *PictureServiceUploadPictureByArchiveResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUploadPictureByArchiveResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8154,9 +20714,9 @@ func (p *PictureServiceUploadPictureResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *PictureServiceUploadPictureResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceUploadPictureByArchiveResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadPicture_result"); err != nil {
+	if err = oprot.WriteStructBegin("UploadPictureByArchive_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8182,7 +20742,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PictureServiceUploadPictureResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PictureServiceUploadPictureByArchiveResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8201,11 +20761,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PictureServiceUploadPictureResult) String() string {
+func (p *PictureServiceUploadPictureByArchiveResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PictureServiceUploadPictureResult(%+v)", *p)
+	return fmt.Sprintf("PictureServiceUploadPictureByArchiveResult(%+v)", *p)
 
 }
 
@@ -846,8 +846,9 @@ func (p *GetLoginUserReq) String() string {
 }
 
 type GetLoginUserResp struct {
-	User *base.UserVo   `thrift:"user,1" form:"user" json:"user" query:"user"`
-	Resp *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
+	User  *base.UserVo    `thrift:"user,1" form:"user" json:"user" query:"user"`
+	Quota *base.UserQuota `thrift:"quota,2" form:"quota" json:"quota" query:"quota"`
+	Resp  *base.BaseResp  `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
 }
 
 func NewGetLoginUserResp() *GetLoginUserResp {
@@ -866,6 +867,15 @@ func (p *GetLoginUserResp) GetUser() (v *base.UserVo) {
 	return p.User
 }
 
+var GetLoginUserResp_Quota_DEFAULT *base.UserQuota
+
+func (p *GetLoginUserResp) GetQuota() (v *base.UserQuota) {
+	if !p.IsSetQuota() {
+		return GetLoginUserResp_Quota_DEFAULT
+	}
+	return p.Quota
+}
+
 var GetLoginUserResp_Resp_DEFAULT *base.BaseResp
 
 func (p *GetLoginUserResp) GetResp() (v *base.BaseResp) {
@@ -877,6 +887,7 @@ func (p *GetLoginUserResp) GetResp() (v *base.BaseResp) {
 
 var fieldIDToName_GetLoginUserResp = map[int16]string{
 	1:   "user",
+	2:   "quota",
 	255: "resp",
 }
 
@@ -884,6 +895,10 @@ func (p *GetLoginUserResp) IsSetUser() bool {
 	return p.User != nil
 }
 
+func (p *GetLoginUserResp) IsSetQuota() bool {
+	return p.Quota != nil
+}
+
 func (p *GetLoginUserResp) IsSetResp() bool {
 	return p.Resp != nil
 }
@@ -914,6 +929,14 @@ func (p *GetLoginUserResp) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -959,6 +982,14 @@ func (p *GetLoginUserResp) ReadField1(iprot thrift.TProtocol) error {
 	p.User = _field
 	return nil
 }
+func (p *GetLoginUserResp) ReadField2(iprot thrift.TProtocol) error {
+	_field := base.NewUserQuota()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Quota = _field
+	return nil
+}
 func (p *GetLoginUserResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
@@ -978,6 +1009,10 @@ func (p *GetLoginUserResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -1016,6 +1051,22 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *GetLoginUserResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("quota", thrift.STRUCT, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Quota.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
 func (p *GetLoginUserResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("resp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
@@ -2333,71 +2384,26 @@ func (p *UserSearchResp) String() string {
 
 }
 
-type AddUserReq struct {
-	UserAccount string  `thrift:"user_account,1" form:"user_account" json:"user_account" query:"user_account" vd:"$ == null || (len($) >= 4 && regexp('^[a-zA-Z0-9]+$'))"`
-	UserAvatar  *string `thrift:"user_avatar,2,optional" form:"user_avatar" json:"user_avatar,omitempty" query:"user_avatar"`
-	UserProfile *string `thrift:"user_profile,3,optional" form:"user_profile" json:"user_profile,omitempty" query:"user_profile"`
-	UserRole    *string `thrift:"user_role,4,optional" form:"user_role" json:"user_role,omitempty" query:"user_role"`
-}
-
-func NewAddUserReq() *AddUserReq {
-	return &AddUserReq{}
-}
-
-func (p *AddUserReq) InitDefault() {
-}
-
-func (p *AddUserReq) GetUserAccount() (v string) {
-	return p.UserAccount
-}
-
-var AddUserReq_UserAvatar_DEFAULT string
-
-func (p *AddUserReq) GetUserAvatar() (v string) {
-	if !p.IsSetUserAvatar() {
-		return AddUserReq_UserAvatar_DEFAULT
-	}
-	return *p.UserAvatar
-}
-
-var AddUserReq_UserProfile_DEFAULT string
-
-func (p *AddUserReq) GetUserProfile() (v string) {
-	if !p.IsSetUserProfile() {
-		return AddUserReq_UserProfile_DEFAULT
-	}
-	return *p.UserProfile
-}
-
-var AddUserReq_UserRole_DEFAULT string
-
-func (p *AddUserReq) GetUserRole() (v string) {
-	if !p.IsSetUserRole() {
-		return AddUserReq_UserRole_DEFAULT
-	}
-	return *p.UserRole
+type FollowUserReq struct {
+	UserID int64 `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
 }
 
-var fieldIDToName_AddUserReq = map[int16]string{
-	1: "user_account",
-	2: "user_avatar",
-	3: "user_profile",
-	4: "user_role",
+func NewFollowUserReq() *FollowUserReq {
+	return &FollowUserReq{}
 }
 
-func (p *AddUserReq) IsSetUserAvatar() bool {
-	return p.UserAvatar != nil
+func (p *FollowUserReq) InitDefault() {
 }
 
-func (p *AddUserReq) IsSetUserProfile() bool {
-	return p.UserProfile != nil
+func (p *FollowUserReq) GetUserID() (v int64) {
+	return p.UserID
 }
 
-func (p *AddUserReq) IsSetUserRole() bool {
-	return p.UserRole != nil
+var fieldIDToName_FollowUserReq = map[int16]string{
+	1: "user_id",
 }
 
-func (p *AddUserReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowUserReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2416,37 +2422,13 @@ func (p *AddUserReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2466,7 +2448,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddUserReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowUserReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2476,54 +2458,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AddUserReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *FollowUserReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.UserAccount = _field
-	return nil
-}
-func (p *AddUserReq) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserAvatar = _field
-	return nil
-}
-func (p *AddUserReq) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserProfile = _field
-	return nil
-}
-func (p *AddUserReq) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserRole = _field
+	p.UserID = _field
 	return nil
 }
 
-func (p *AddUserReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowUserReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddUserReq"); err != nil {
+	if err = oprot.WriteStructBegin("FollowUserReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2531,18 +2480,6 @@ func (p *AddUserReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2561,11 +2498,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AddUserReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user_account", thrift.STRING, 1); err != nil {
+func (p *FollowUserReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteString(p.UserAccount); err != nil {
+	if err := oprot.WriteI64(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2577,104 +2514,50 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AddUserReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserAvatar() {
-		if err = oprot.WriteFieldBegin("user_avatar", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserAvatar); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *AddUserReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserProfile() {
-		if err = oprot.WriteFieldBegin("user_profile", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserProfile); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *AddUserReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserRole() {
-		if err = oprot.WriteFieldBegin("user_role", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserRole); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
 
-func (p *AddUserReq) String() string {
+func (p *FollowUserReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AddUserReq(%+v)", *p)
+	return fmt.Sprintf("FollowUserReq(%+v)", *p)
 
 }
 
-type AddUserResp struct {
-	ID   int64          `thrift:"id,1" form:"id" json:"id" query:"id"`
-	Resp *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
+type FollowUserResp struct {
+	FollowerCount int64          `thrift:"follower_count,1" form:"follower_count" json:"follower_count" query:"follower_count"`
+	Resp          *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
 }
 
-func NewAddUserResp() *AddUserResp {
-	return &AddUserResp{}
+func NewFollowUserResp() *FollowUserResp {
+	return &FollowUserResp{}
 }
 
-func (p *AddUserResp) InitDefault() {
+func (p *FollowUserResp) InitDefault() {
 }
 
-func (p *AddUserResp) GetID() (v int64) {
-	return p.ID
+func (p *FollowUserResp) GetFollowerCount() (v int64) {
+	return p.FollowerCount
 }
 
-var AddUserResp_Resp_DEFAULT *base.BaseResp
+var FollowUserResp_Resp_DEFAULT *base.BaseResp
 
-func (p *AddUserResp) GetResp() (v *base.BaseResp) {
+func (p *FollowUserResp) GetResp() (v *base.BaseResp) {
 	if !p.IsSetResp() {
-		return AddUserResp_Resp_DEFAULT
+		return FollowUserResp_Resp_DEFAULT
 	}
 	return p.Resp
 }
 
-var fieldIDToName_AddUserResp = map[int16]string{
-	1:   "id",
+var fieldIDToName_FollowUserResp = map[int16]string{
+	1:   "follower_count",
 	255: "resp",
 }
 
-func (p *AddUserResp) IsSetResp() bool {
+func (p *FollowUserResp) IsSetResp() bool {
 	return p.Resp != nil
 }
 
-func (p *AddUserResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *FollowUserResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2727,7 +2610,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddUserResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_FollowUserResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2737,7 +2620,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AddUserResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *FollowUserResp) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2745,10 +2628,10 @@ func (p *AddUserResp) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.FollowerCount = _field
 	return nil
 }
-func (p *AddUserResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *FollowUserResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -2757,9 +2640,9 @@ func (p *AddUserResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *AddUserResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *FollowUserResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AddUserResp"); err != nil {
+	if err = oprot.WriteStructBegin("FollowUserResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2789,11 +2672,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AddUserResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *FollowUserResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("follower_count", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := oprot.WriteI64(p.FollowerCount); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2805,7 +2688,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *AddUserResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *FollowUserResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("resp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2822,34 +2705,34 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *AddUserResp) String() string {
+func (p *FollowUserResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AddUserResp(%+v)", *p)
+	return fmt.Sprintf("FollowUserResp(%+v)", *p)
 
 }
 
-type DeleteUserReq struct {
-	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
+type UnfollowUserReq struct {
+	UserID int64 `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
 }
 
-func NewDeleteUserReq() *DeleteUserReq {
-	return &DeleteUserReq{}
+func NewUnfollowUserReq() *UnfollowUserReq {
+	return &UnfollowUserReq{}
 }
 
-func (p *DeleteUserReq) InitDefault() {
+func (p *UnfollowUserReq) InitDefault() {
 }
 
-func (p *DeleteUserReq) GetID() (v int64) {
-	return p.ID
+func (p *UnfollowUserReq) GetUserID() (v int64) {
+	return p.UserID
 }
 
-var fieldIDToName_DeleteUserReq = map[int16]string{
-	1: "id",
+var fieldIDToName_UnfollowUserReq = map[int16]string{
+	1: "user_id",
 }
 
-func (p *DeleteUserReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *UnfollowUserReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -2894,7 +2777,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteUserReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UnfollowUserReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2904,7 +2787,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteUserReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *UnfollowUserReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -2912,13 +2795,13 @@ func (p *DeleteUserReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.UserID = _field
 	return nil
 }
 
-func (p *DeleteUserReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *UnfollowUserReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteUserReq"); err != nil {
+	if err = oprot.WriteStructBegin("UnfollowUserReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2944,11 +2827,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteUserReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *UnfollowUserReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := oprot.WriteI64(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -2961,43 +2844,49 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *DeleteUserReq) String() string {
+func (p *UnfollowUserReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteUserReq(%+v)", *p)
+	return fmt.Sprintf("UnfollowUserReq(%+v)", *p)
 
 }
 
-type DeleteUserResp struct {
-	Resp *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
+type UnfollowUserResp struct {
+	FollowerCount int64          `thrift:"follower_count,1" form:"follower_count" json:"follower_count" query:"follower_count"`
+	Resp          *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
 }
 
-func NewDeleteUserResp() *DeleteUserResp {
-	return &DeleteUserResp{}
+func NewUnfollowUserResp() *UnfollowUserResp {
+	return &UnfollowUserResp{}
 }
 
-func (p *DeleteUserResp) InitDefault() {
+func (p *UnfollowUserResp) InitDefault() {
 }
 
-var DeleteUserResp_Resp_DEFAULT *base.BaseResp
+func (p *UnfollowUserResp) GetFollowerCount() (v int64) {
+	return p.FollowerCount
+}
 
-func (p *DeleteUserResp) GetResp() (v *base.BaseResp) {
+var UnfollowUserResp_Resp_DEFAULT *base.BaseResp
+
+func (p *UnfollowUserResp) GetResp() (v *base.BaseResp) {
 	if !p.IsSetResp() {
-		return DeleteUserResp_Resp_DEFAULT
+		return UnfollowUserResp_Resp_DEFAULT
 	}
 	return p.Resp
 }
 
-var fieldIDToName_DeleteUserResp = map[int16]string{
+var fieldIDToName_UnfollowUserResp = map[int16]string{
+	1:   "follower_count",
 	255: "resp",
 }
 
-func (p *DeleteUserResp) IsSetResp() bool {
+func (p *UnfollowUserResp) IsSetResp() bool {
 	return p.Resp != nil
 }
 
-func (p *DeleteUserResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *UnfollowUserResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3015,6 +2904,14 @@ func (p *DeleteUserResp) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -3042,7 +2939,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteUserResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UnfollowUserResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3052,7 +2949,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *DeleteUserResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *UnfollowUserResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.FollowerCount = _field
+	return nil
+}
+func (p *UnfollowUserResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -3061,12 +2969,16 @@ func (p *DeleteUserResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DeleteUserResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *UnfollowUserResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeleteUserResp"); err != nil {
+	if err = oprot.WriteStructBegin("UnfollowUserResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -3089,7 +3001,23 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *DeleteUserResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *UnfollowUserResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("follower_count", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.FollowerCount); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UnfollowUserResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("resp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3106,94 +3034,46 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *DeleteUserResp) String() string {
+func (p *UnfollowUserResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DeleteUserResp(%+v)", *p)
-
-}
-
-type UpdateUserReq struct {
-	ID           int64   `thrift:"id,1" form:"id" json:"id" query:"id"`
-	UserPassword *string `thrift:"user_password,2,optional" form:"user_password" json:"user_password,omitempty" query:"user_password" vd:"$ == null || len($) >= 8"`
-	UserAvatar   *string `thrift:"user_avatar,3,optional" form:"user_avatar" json:"user_avatar,omitempty" query:"user_avatar"`
-	UserProfile  *string `thrift:"user_profile,4,optional" form:"user_profile" json:"user_profile,omitempty" query:"user_profile"`
-	UserRole     *string `thrift:"user_role,5,optional" form:"user_role" json:"user_role,omitempty" query:"user_role"`
-}
-
-func NewUpdateUserReq() *UpdateUserReq {
-	return &UpdateUserReq{}
-}
-
-func (p *UpdateUserReq) InitDefault() {
-}
-
-func (p *UpdateUserReq) GetID() (v int64) {
-	return p.ID
-}
-
-var UpdateUserReq_UserPassword_DEFAULT string
-
-func (p *UpdateUserReq) GetUserPassword() (v string) {
-	if !p.IsSetUserPassword() {
-		return UpdateUserReq_UserPassword_DEFAULT
-	}
-	return *p.UserPassword
-}
-
-var UpdateUserReq_UserAvatar_DEFAULT string
+	return fmt.Sprintf("UnfollowUserResp(%+v)", *p)
 
-func (p *UpdateUserReq) GetUserAvatar() (v string) {
-	if !p.IsSetUserAvatar() {
-		return UpdateUserReq_UserAvatar_DEFAULT
-	}
-	return *p.UserAvatar
 }
 
-var UpdateUserReq_UserProfile_DEFAULT string
-
-func (p *UpdateUserReq) GetUserProfile() (v string) {
-	if !p.IsSetUserProfile() {
-		return UpdateUserReq_UserProfile_DEFAULT
-	}
-	return *p.UserProfile
+type ListFollowerReq struct {
+	UserID      int64 `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
+	CurrentPage int64 `thrift:"current_page,2" form:"current_page" json:"current_page" query:"current_page"`
+	PageSize    int64 `thrift:"page_size,3" form:"page_size" json:"page_size" query:"page_size" vd:" $ <=  30"`
 }
 
-var UpdateUserReq_UserRole_DEFAULT string
-
-func (p *UpdateUserReq) GetUserRole() (v string) {
-	if !p.IsSetUserRole() {
-		return UpdateUserReq_UserRole_DEFAULT
-	}
-	return *p.UserRole
+func NewListFollowerReq() *ListFollowerReq {
+	return &ListFollowerReq{}
 }
 
-var fieldIDToName_UpdateUserReq = map[int16]string{
-	1: "id",
-	2: "user_password",
-	3: "user_avatar",
-	4: "user_profile",
-	5: "user_role",
+func (p *ListFollowerReq) InitDefault() {
 }
 
-func (p *UpdateUserReq) IsSetUserPassword() bool {
-	return p.UserPassword != nil
+func (p *ListFollowerReq) GetUserID() (v int64) {
+	return p.UserID
 }
 
-func (p *UpdateUserReq) IsSetUserAvatar() bool {
-	return p.UserAvatar != nil
+func (p *ListFollowerReq) GetCurrentPage() (v int64) {
+	return p.CurrentPage
 }
 
-func (p *UpdateUserReq) IsSetUserProfile() bool {
-	return p.UserProfile != nil
+func (p *ListFollowerReq) GetPageSize() (v int64) {
+	return p.PageSize
 }
 
-func (p *UpdateUserReq) IsSetUserRole() bool {
-	return p.UserRole != nil
+var fieldIDToName_ListFollowerReq = map[int16]string{
+	1: "user_id",
+	2: "current_page",
+	3: "page_size",
 }
 
-func (p *UpdateUserReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListFollowerReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3220,7 +3100,7 @@ func (p *UpdateUserReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3228,29 +3108,13 @@ func (p *UpdateUserReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3270,7 +3134,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListFollowerReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3280,7 +3144,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateUserReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListFollowerReq) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3288,57 +3152,35 @@ func (p *UpdateUserReq) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = v
 	}
-	p.ID = _field
-	return nil
-}
-func (p *UpdateUserReq) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserPassword = _field
-	return nil
-}
-func (p *UpdateUserReq) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserAvatar = _field
+	p.UserID = _field
 	return nil
 }
-func (p *UpdateUserReq) ReadField4(iprot thrift.TProtocol) error {
+func (p *ListFollowerReq) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.UserProfile = _field
+	p.CurrentPage = _field
 	return nil
 }
-func (p *UpdateUserReq) ReadField5(iprot thrift.TProtocol) error {
+func (p *ListFollowerReq) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.UserRole = _field
+	p.PageSize = _field
 	return nil
 }
 
-func (p *UpdateUserReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListFollowerReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserReq"); err != nil {
+	if err = oprot.WriteStructBegin("ListFollowerReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3354,14 +3196,6 @@ func (p *UpdateUserReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3380,11 +3214,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateUserReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *ListFollowerReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := oprot.WriteI64(p.UserID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3396,17 +3230,15 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdateUserReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserPassword() {
-		if err = oprot.WriteFieldBegin("user_password", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserPassword); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ListFollowerReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("current_page", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CurrentPage); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
@@ -3414,113 +3246,72 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *UpdateUserReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserAvatar() {
-		if err = oprot.WriteFieldBegin("user_avatar", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserAvatar); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ListFollowerReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I64, 3); err != nil {
+		goto WriteFieldBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *UpdateUserReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserProfile() {
-		if err = oprot.WriteFieldBegin("user_profile", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserProfile); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err := oprot.WriteI64(p.PageSize); err != nil {
+		return err
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
-}
-func (p *UpdateUserReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserRole() {
-		if err = oprot.WriteFieldBegin("user_role", thrift.STRING, 5); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserRole); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *UpdateUserReq) String() string {
+func (p *ListFollowerReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateUserReq(%+v)", *p)
+	return fmt.Sprintf("ListFollowerReq(%+v)", *p)
 
 }
 
-type UpdateUserResp struct {
-	User *base.UserVo   `thrift:"user,1" form:"user" json:"user" query:"user"`
-	Resp *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
+type ListFollowerResp struct {
+	Users []*base.UserVo `thrift:"users,1" form:"users" json:"users" query:"users"`
+	Total int64          `thrift:"total,2" form:"total" json:"total" query:"total"`
+	Resp  *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
 }
 
-func NewUpdateUserResp() *UpdateUserResp {
-	return &UpdateUserResp{}
+func NewListFollowerResp() *ListFollowerResp {
+	return &ListFollowerResp{}
 }
 
-func (p *UpdateUserResp) InitDefault() {
+func (p *ListFollowerResp) InitDefault() {
 }
 
-var UpdateUserResp_User_DEFAULT *base.UserVo
+func (p *ListFollowerResp) GetUsers() (v []*base.UserVo) {
+	return p.Users
+}
 
-func (p *UpdateUserResp) GetUser() (v *base.UserVo) {
-	if !p.IsSetUser() {
-		return UpdateUserResp_User_DEFAULT
-	}
-	return p.User
+func (p *ListFollowerResp) GetTotal() (v int64) {
+	return p.Total
 }
 
-var UpdateUserResp_Resp_DEFAULT *base.BaseResp
+var ListFollowerResp_Resp_DEFAULT *base.BaseResp
 
-func (p *UpdateUserResp) GetResp() (v *base.BaseResp) {
+func (p *ListFollowerResp) GetResp() (v *base.BaseResp) {
 	if !p.IsSetResp() {
-		return UpdateUserResp_Resp_DEFAULT
+		return ListFollowerResp_Resp_DEFAULT
 	}
 	return p.Resp
 }
 
-var fieldIDToName_UpdateUserResp = map[int16]string{
-	1:   "user",
+var fieldIDToName_ListFollowerResp = map[int16]string{
+	1:   "users",
+	2:   "total",
 	255: "resp",
 }
 
-func (p *UpdateUserResp) IsSetUser() bool {
-	return p.User != nil
-}
-
-func (p *UpdateUserResp) IsSetResp() bool {
+func (p *ListFollowerResp) IsSetResp() bool {
 	return p.Resp != nil
 }
 
-func (p *UpdateUserResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListFollowerResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3539,13 +3330,21 @@ func (p *UpdateUserResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		case 255:
 			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField255(iprot); err != nil {
@@ -3573,7 +3372,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListFollowerResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3583,15 +3382,41 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UpdateUserResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := base.NewUserVo()
-	if err := _field.Read(iprot); err != nil {
+func (p *ListFollowerResp) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.User = _field
+	_field := make([]*base.UserVo, 0, size)
+	values := make([]base.UserVo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Users = _field
 	return nil
 }
-func (p *UpdateUserResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *ListFollowerResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *ListFollowerResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -3600,9 +3425,9 @@ func (p *UpdateUserResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UpdateUserResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListFollowerResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UpdateUserResp"); err != nil {
+	if err = oprot.WriteStructBegin("ListFollowerResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3610,6 +3435,10 @@ func (p *UpdateUserResp) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 		if err = p.writeField255(oprot); err != nil {
 			fieldId = 255
 			goto WriteFieldError
@@ -3632,11 +3461,19 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UpdateUserResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 1); err != nil {
+func (p *ListFollowerResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("users", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.User.Write(oprot); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Users)); err != nil {
+		return err
+	}
+	for _, v := range p.Users {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -3648,7 +3485,23 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *UpdateUserResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ListFollowerResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *ListFollowerResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("resp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3665,100 +3518,46 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *UpdateUserResp) String() string {
+func (p *ListFollowerResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UpdateUserResp(%+v)", *p)
-
-}
-
-type QueryUserReq struct {
-	ID          *int64  `thrift:"id,1,optional" form:"id" json:"id,omitempty" query:"id"`
-	UserAccount *string `thrift:"user_account,2,optional" form:"user_account" json:"user_account,omitempty" query:"user_account" vd:"$ == null || (len($) >= 4 && regexp('^[a-zA-Z0-9]+$'))"`
-	UserRole    *string `thrift:"user_role,3,optional" form:"user_role" json:"user_role,omitempty" query:"user_role"`
-	UserProfile *string `thrift:"user_profile,4,optional" form:"user_profile" json:"user_profile,omitempty" query:"user_profile"`
-	CurrentPage int64   `thrift:"current_page,5" form:"current_page" json:"current_page" query:"current_page"`
-	PageSize    int64   `thrift:"page_size,6" form:"page_size" json:"page_size" query:"page_size"`
-}
-
-func NewQueryUserReq() *QueryUserReq {
-	return &QueryUserReq{}
-}
+	return fmt.Sprintf("ListFollowerResp(%+v)", *p)
 
-func (p *QueryUserReq) InitDefault() {
 }
 
-var QueryUserReq_ID_DEFAULT int64
-
-func (p *QueryUserReq) GetID() (v int64) {
-	if !p.IsSetID() {
-		return QueryUserReq_ID_DEFAULT
-	}
-	return *p.ID
+type ListFollowingReq struct {
+	UserID      int64 `thrift:"user_id,1" form:"user_id" json:"user_id" query:"user_id"`
+	CurrentPage int64 `thrift:"current_page,2" form:"current_page" json:"current_page" query:"current_page"`
+	PageSize    int64 `thrift:"page_size,3" form:"page_size" json:"page_size" query:"page_size" vd:" $ <=  30"`
 }
 
-var QueryUserReq_UserAccount_DEFAULT string
-
-func (p *QueryUserReq) GetUserAccount() (v string) {
-	if !p.IsSetUserAccount() {
-		return QueryUserReq_UserAccount_DEFAULT
-	}
-	return *p.UserAccount
+func NewListFollowingReq() *ListFollowingReq {
+	return &ListFollowingReq{}
 }
 
-var QueryUserReq_UserRole_DEFAULT string
-
-func (p *QueryUserReq) GetUserRole() (v string) {
-	if !p.IsSetUserRole() {
-		return QueryUserReq_UserRole_DEFAULT
-	}
-	return *p.UserRole
+func (p *ListFollowingReq) InitDefault() {
 }
 
-var QueryUserReq_UserProfile_DEFAULT string
-
-func (p *QueryUserReq) GetUserProfile() (v string) {
-	if !p.IsSetUserProfile() {
-		return QueryUserReq_UserProfile_DEFAULT
-	}
-	return *p.UserProfile
+func (p *ListFollowingReq) GetUserID() (v int64) {
+	return p.UserID
 }
 
-func (p *QueryUserReq) GetCurrentPage() (v int64) {
+func (p *ListFollowingReq) GetCurrentPage() (v int64) {
 	return p.CurrentPage
 }
 
-func (p *QueryUserReq) GetPageSize() (v int64) {
+func (p *ListFollowingReq) GetPageSize() (v int64) {
 	return p.PageSize
 }
 
-var fieldIDToName_QueryUserReq = map[int16]string{
-	1: "id",
-	2: "user_account",
-	3: "user_role",
-	4: "user_profile",
-	5: "current_page",
-	6: "page_size",
-}
-
-func (p *QueryUserReq) IsSetID() bool {
-	return p.ID != nil
-}
-
-func (p *QueryUserReq) IsSetUserAccount() bool {
-	return p.UserAccount != nil
-}
-
-func (p *QueryUserReq) IsSetUserRole() bool {
-	return p.UserRole != nil
-}
-
-func (p *QueryUserReq) IsSetUserProfile() bool {
-	return p.UserProfile != nil
+var fieldIDToName_ListFollowingReq = map[int16]string{
+	1: "user_id",
+	2: "current_page",
+	3: "page_size",
 }
 
-func (p *QueryUserReq) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListFollowingReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -3785,7 +3584,7 @@ func (p *QueryUserReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3793,32 +3592,8 @@ func (p *QueryUserReq) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 5:
-			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField5(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 6:
 			if fieldTypeId == thrift.I64 {
-				if err = p.ReadField6(iprot); err != nil {
+				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -3843,7 +3618,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryUserReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListFollowingReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3853,51 +3628,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *QueryUserReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListFollowingReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field *int64
+	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
-		_field = &v
+		_field = v
 	}
-	p.ID = _field
+	p.UserID = _field
 	return nil
 }
-func (p *QueryUserReq) ReadField2(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserAccount = _field
-	return nil
-}
-func (p *QueryUserReq) ReadField3(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserRole = _field
-	return nil
-}
-func (p *QueryUserReq) ReadField4(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserProfile = _field
-	return nil
-}
-func (p *QueryUserReq) ReadField5(iprot thrift.TProtocol) error {
+func (p *ListFollowingReq) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3908,7 +3650,7 @@ func (p *QueryUserReq) ReadField5(iprot thrift.TProtocol) error {
 	p.CurrentPage = _field
 	return nil
 }
-func (p *QueryUserReq) ReadField6(iprot thrift.TProtocol) error {
+func (p *ListFollowingReq) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -3920,9 +3662,9 @@ func (p *QueryUserReq) ReadField6(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryUserReq) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListFollowingReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryUserReq"); err != nil {
+	if err = oprot.WriteStructBegin("ListFollowingReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3938,18 +3680,6 @@ func (p *QueryUserReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
-		if err = p.writeField5(oprot); err != nil {
-			fieldId = 5
-			goto WriteFieldError
-		}
-		if err = p.writeField6(oprot); err != nil {
-			fieldId = 6
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3968,80 +3698,24 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryUserReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI64(*p.ID); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-func (p *QueryUserReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserAccount() {
-		if err = oprot.WriteFieldBegin("user_account", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserAccount); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ListFollowingReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-func (p *QueryUserReq) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserRole() {
-		if err = oprot.WriteFieldBegin("user_role", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserRole); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err := oprot.WriteI64(p.UserID); err != nil {
+		return err
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-func (p *QueryUserReq) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserProfile() {
-		if err = oprot.WriteFieldBegin("user_profile", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.UserProfile); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *QueryUserReq) writeField5(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("current_page", thrift.I64, 5); err != nil {
+func (p *ListFollowingReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("current_page", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := oprot.WriteI64(p.CurrentPage); err != nil {
@@ -4052,12 +3726,12 @@ func (p *QueryUserReq) writeField5(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *QueryUserReq) writeField6(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("page_size", thrift.I64, 6); err != nil {
+func (p *ListFollowingReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I64, 3); err != nil {
 		goto WriteFieldBeginError
 	}
 	if err := oprot.WriteI64(p.PageSize); err != nil {
@@ -4068,60 +3742,60 @@ func (p *QueryUserReq) writeField6(oprot thrift.TProtocol) (err error) {
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *QueryUserReq) String() string {
+func (p *ListFollowingReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryUserReq(%+v)", *p)
+	return fmt.Sprintf("ListFollowingReq(%+v)", *p)
 
 }
 
-type QueryUserResp struct {
+type ListFollowingResp struct {
 	Users []*base.UserVo `thrift:"users,1" form:"users" json:"users" query:"users"`
 	Total int64          `thrift:"total,2" form:"total" json:"total" query:"total"`
 	Resp  *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
 }
 
-func NewQueryUserResp() *QueryUserResp {
-	return &QueryUserResp{}
+func NewListFollowingResp() *ListFollowingResp {
+	return &ListFollowingResp{}
 }
 
-func (p *QueryUserResp) InitDefault() {
+func (p *ListFollowingResp) InitDefault() {
 }
 
-func (p *QueryUserResp) GetUsers() (v []*base.UserVo) {
+func (p *ListFollowingResp) GetUsers() (v []*base.UserVo) {
 	return p.Users
 }
 
-func (p *QueryUserResp) GetTotal() (v int64) {
+func (p *ListFollowingResp) GetTotal() (v int64) {
 	return p.Total
 }
 
-var QueryUserResp_Resp_DEFAULT *base.BaseResp
+var ListFollowingResp_Resp_DEFAULT *base.BaseResp
 
-func (p *QueryUserResp) GetResp() (v *base.BaseResp) {
+func (p *ListFollowingResp) GetResp() (v *base.BaseResp) {
 	if !p.IsSetResp() {
-		return QueryUserResp_Resp_DEFAULT
+		return ListFollowingResp_Resp_DEFAULT
 	}
 	return p.Resp
 }
 
-var fieldIDToName_QueryUserResp = map[int16]string{
+var fieldIDToName_ListFollowingResp = map[int16]string{
 	1:   "users",
 	2:   "total",
 	255: "resp",
 }
 
-func (p *QueryUserResp) IsSetResp() bool {
+func (p *ListFollowingResp) IsSetResp() bool {
 	return p.Resp != nil
 }
 
-func (p *QueryUserResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *ListFollowingResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4182,7 +3856,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryUserResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListFollowingResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4192,7 +3866,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *QueryUserResp) ReadField1(iprot thrift.TProtocol) error {
+func (p *ListFollowingResp) ReadField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
@@ -4215,7 +3889,7 @@ func (p *QueryUserResp) ReadField1(iprot thrift.TProtocol) error {
 	p.Users = _field
 	return nil
 }
-func (p *QueryUserResp) ReadField2(iprot thrift.TProtocol) error {
+func (p *ListFollowingResp) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field int64
 	if v, err := iprot.ReadI64(); err != nil {
@@ -4226,7 +3900,7 @@ func (p *QueryUserResp) ReadField2(iprot thrift.TProtocol) error {
 	p.Total = _field
 	return nil
 }
-func (p *QueryUserResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *ListFollowingResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4235,9 +3909,9 @@ func (p *QueryUserResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *QueryUserResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *ListFollowingResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("QueryUserResp"); err != nil {
+	if err = oprot.WriteStructBegin("ListFollowingResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4271,7 +3945,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *QueryUserResp) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *ListFollowingResp) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("users", thrift.LIST, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4295,7 +3969,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *QueryUserResp) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *ListFollowingResp) writeField2(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("total", thrift.I64, 2); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4311,7 +3985,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
-func (p *QueryUserResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *ListFollowingResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("resp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4328,34 +4002,79 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *QueryUserResp) String() string {
+func (p *ListFollowingResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("QueryUserResp(%+v)", *p)
+	return fmt.Sprintf("ListFollowingResp(%+v)", *p)
 
 }
 
-type GetUserReq struct {
-	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
+type AddUserReq struct {
+	UserAccount string  `thrift:"user_account,1" form:"user_account" json:"user_account" query:"user_account" vd:"$ == null || (len($) >= 4 && regexp('^[a-zA-Z0-9]+$'))"`
+	UserAvatar  *string `thrift:"user_avatar,2,optional" form:"user_avatar" json:"user_avatar,omitempty" query:"user_avatar"`
+	UserProfile *string `thrift:"user_profile,3,optional" form:"user_profile" json:"user_profile,omitempty" query:"user_profile"`
+	UserRole    *string `thrift:"user_role,4,optional" form:"user_role" json:"user_role,omitempty" query:"user_role"`
 }
 
-func NewGetUserReq() *GetUserReq {
-	return &GetUserReq{}
+func NewAddUserReq() *AddUserReq {
+	return &AddUserReq{}
 }
 
-func (p *GetUserReq) InitDefault() {
+func (p *AddUserReq) InitDefault() {
 }
 
-func (p *GetUserReq) GetID() (v int64) {
-	return p.ID
+func (p *AddUserReq) GetUserAccount() (v string) {
+	return p.UserAccount
 }
 
-var fieldIDToName_GetUserReq = map[int16]string{
-	1: "id",
+var AddUserReq_UserAvatar_DEFAULT string
+
+func (p *AddUserReq) GetUserAvatar() (v string) {
+	if !p.IsSetUserAvatar() {
+		return AddUserReq_UserAvatar_DEFAULT
+	}
+	return *p.UserAvatar
 }
 
-func (p *GetUserReq) Read(iprot thrift.TProtocol) (err error) {
+var AddUserReq_UserProfile_DEFAULT string
+
+func (p *AddUserReq) GetUserProfile() (v string) {
+	if !p.IsSetUserProfile() {
+		return AddUserReq_UserProfile_DEFAULT
+	}
+	return *p.UserProfile
+}
+
+var AddUserReq_UserRole_DEFAULT string
+
+func (p *AddUserReq) GetUserRole() (v string) {
+	if !p.IsSetUserRole() {
+		return AddUserReq_UserRole_DEFAULT
+	}
+	return *p.UserRole
+}
+
+var fieldIDToName_AddUserReq = map[int16]string{
+	1: "user_account",
+	2: "user_avatar",
+	3: "user_profile",
+	4: "user_role",
+}
+
+func (p *AddUserReq) IsSetUserAvatar() bool {
+	return p.UserAvatar != nil
+}
+
+func (p *AddUserReq) IsSetUserProfile() bool {
+	return p.UserProfile != nil
+}
+
+func (p *AddUserReq) IsSetUserRole() bool {
+	return p.UserRole != nil
+}
+
+func (p *AddUserReq) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4374,13 +4093,37 @@ func (p *GetUserReq) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.I64 {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4400,7 +4143,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserReq[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddUserReq[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4410,21 +4153,54 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUserReq) ReadField1(iprot thrift.TProtocol) error {
+func (p *AddUserReq) ReadField1(iprot thrift.TProtocol) error {
 
-	var _field int64
-	if v, err := iprot.ReadI64(); err != nil {
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = v
 	}
-	p.ID = _field
+	p.UserAccount = _field
 	return nil
 }
+func (p *AddUserReq) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *GetUserReq) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserAvatar = _field
+	return nil
+}
+func (p *AddUserReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserProfile = _field
+	return nil
+}
+func (p *AddUserReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserRole = _field
+	return nil
+}
+
+func (p *AddUserReq) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserReq"); err != nil {
+	if err = oprot.WriteStructBegin("AddUserReq"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4432,6 +4208,18 @@ func (p *GetUserReq) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4450,11 +4238,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUserReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+func (p *AddUserReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user_account", thrift.STRING, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := oprot.WriteI64(p.ID); err != nil {
+	if err := oprot.WriteString(p.UserAccount); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4466,59 +4254,104 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
+func (p *AddUserReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserAvatar() {
+		if err = oprot.WriteFieldBegin("user_avatar", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserAvatar); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *AddUserReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserProfile() {
+		if err = oprot.WriteFieldBegin("user_profile", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserProfile); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *AddUserReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserRole() {
+		if err = oprot.WriteFieldBegin("user_role", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserRole); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
 
-func (p *GetUserReq) String() string {
+func (p *AddUserReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserReq(%+v)", *p)
+	return fmt.Sprintf("AddUserReq(%+v)", *p)
 
 }
 
-type GetUserResp struct {
-	User *base.User     `thrift:"user,1" form:"user" json:"user" query:"user"`
+type AddUserResp struct {
+	ID   int64          `thrift:"id,1" form:"id" json:"id" query:"id"`
 	Resp *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
 }
 
-func NewGetUserResp() *GetUserResp {
-	return &GetUserResp{}
+func NewAddUserResp() *AddUserResp {
+	return &AddUserResp{}
 }
 
-func (p *GetUserResp) InitDefault() {
+func (p *AddUserResp) InitDefault() {
 }
 
-var GetUserResp_User_DEFAULT *base.User
-
-func (p *GetUserResp) GetUser() (v *base.User) {
-	if !p.IsSetUser() {
-		return GetUserResp_User_DEFAULT
-	}
-	return p.User
+func (p *AddUserResp) GetID() (v int64) {
+	return p.ID
 }
 
-var GetUserResp_Resp_DEFAULT *base.BaseResp
+var AddUserResp_Resp_DEFAULT *base.BaseResp
 
-func (p *GetUserResp) GetResp() (v *base.BaseResp) {
+func (p *AddUserResp) GetResp() (v *base.BaseResp) {
 	if !p.IsSetResp() {
-		return GetUserResp_Resp_DEFAULT
+		return AddUserResp_Resp_DEFAULT
 	}
 	return p.Resp
 }
 
-var fieldIDToName_GetUserResp = map[int16]string{
-	1:   "user",
+var fieldIDToName_AddUserResp = map[int16]string{
+	1:   "id",
 	255: "resp",
 }
 
-func (p *GetUserResp) IsSetUser() bool {
-	return p.User != nil
-}
-
-func (p *GetUserResp) IsSetResp() bool {
+func (p *AddUserResp) IsSetResp() bool {
 	return p.Resp != nil
 }
 
-func (p *GetUserResp) Read(iprot thrift.TProtocol) (err error) {
+func (p *AddUserResp) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -4537,7 +4370,7 @@ func (p *GetUserResp) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4571,7 +4404,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserResp[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AddUserResp[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4581,15 +4414,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUserResp) ReadField1(iprot thrift.TProtocol) error {
-	_field := base.NewUser()
-	if err := _field.Read(iprot); err != nil {
+func (p *AddUserResp) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
+	} else {
+		_field = v
 	}
-	p.User = _field
+	p.ID = _field
 	return nil
 }
-func (p *GetUserResp) ReadField255(iprot thrift.TProtocol) error {
+func (p *AddUserResp) ReadField255(iprot thrift.TProtocol) error {
 	_field := base.NewBaseResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4598,9 +4434,9 @@ func (p *GetUserResp) ReadField255(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *GetUserResp) Write(oprot thrift.TProtocol) (err error) {
+func (p *AddUserResp) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserResp"); err != nil {
+	if err = oprot.WriteStructBegin("AddUserResp"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4630,11 +4466,11 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUserResp) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 1); err != nil {
+func (p *AddUserResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
 		goto WriteFieldBeginError
 	}
-	if err := p.User.Write(oprot); err != nil {
+	if err := oprot.WriteI64(p.ID); err != nil {
 		return err
 	}
 	if err = oprot.WriteFieldEnd(); err != nil {
@@ -4646,7 +4482,7 @@ WriteFieldBeginError:
 WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
-func (p *GetUserResp) writeField255(oprot thrift.TProtocol) (err error) {
+func (p *AddUserResp) writeField255(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("resp", thrift.STRUCT, 255); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4663,772 +4499,4134 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-func (p *GetUserResp) String() string {
+func (p *AddUserResp) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserResp(%+v)", *p)
+	return fmt.Sprintf("AddUserResp(%+v)", *p)
 
 }
 
-type UserServices interface {
-	UserRegister(ctx context.Context, req *UserLoginReq) (r *UserRegisterResp, err error)
-
-	UserLogin(ctx context.Context, req *UserLoginReq) (r *UserLoginResp, err error)
-	//# auth
-	GetLoginUser(ctx context.Context, req *GetLoginUserReq) (r *GetLoginUserResp, err error)
-
-	LogoutUser(ctx context.Context, req *UserLogoutReq) (r *UserLogoutResp, err error)
-
-	UserEdit(ctx context.Context, req *UserEditReq) (r *UserEditResp, err error)
-
-	UserSearches(ctx context.Context, req *UserSearchReq) (r *UserSearchResp, err error)
-	//# admin
-	AddUser(ctx context.Context, req *AddUserReq) (r *AddUserResp, err error)
-
-	DeleteUser(ctx context.Context, req *DeleteUserReq) (r *DeleteUserResp, err error)
-
-	UpdateUser(ctx context.Context, req *UpdateUserReq) (r *UpdateUserResp, err error)
-
-	QueryUsers(ctx context.Context, req *QueryUserReq) (r *QueryUserResp, err error)
-
-	GetUser(ctx context.Context, req *GetUserReq) (r *GetUserResp, err error)
+type DeleteUserReq struct {
+	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
 }
 
-type UserServicesClient struct {
-	c thrift.TClient
+func NewDeleteUserReq() *DeleteUserReq {
+	return &DeleteUserReq{}
 }
 
-func NewUserServicesClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserServicesClient {
-	return &UserServicesClient{
-		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-	}
+func (p *DeleteUserReq) InitDefault() {
 }
 
-func NewUserServicesClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserServicesClient {
-	return &UserServicesClient{
-		c: thrift.NewTStandardClient(iprot, oprot),
-	}
+func (p *DeleteUserReq) GetID() (v int64) {
+	return p.ID
 }
 
-func NewUserServicesClient(c thrift.TClient) *UserServicesClient {
-	return &UserServicesClient{
-		c: c,
-	}
+var fieldIDToName_DeleteUserReq = map[int16]string{
+	1: "id",
 }
 
-func (p *UserServicesClient) Client_() thrift.TClient {
-	return p.c
-}
+func (p *DeleteUserReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *UserServicesClient) UserRegister(ctx context.Context, req *UserLoginReq) (r *UserRegisterResp, err error) {
-	var _args UserServicesUserRegisterArgs
-	_args.Req = req
-	var _result UserServicesUserRegisterResult
-	if err = p.Client_().Call(ctx, "UserRegister", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServicesClient) UserLogin(ctx context.Context, req *UserLoginReq) (r *UserLoginResp, err error) {
-	var _args UserServicesUserLoginArgs
-	_args.Req = req
-	var _result UserServicesUserLoginResult
-	if err = p.Client_().Call(ctx, "UserLogin", &_args, &_result); err != nil {
-		return
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServicesClient) GetLoginUser(ctx context.Context, req *GetLoginUserReq) (r *GetLoginUserResp, err error) {
-	var _args UserServicesGetLoginUserArgs
-	_args.Req = req
-	var _result UserServicesGetLoginUserResult
-	if err = p.Client_().Call(ctx, "GetLoginUser", &_args, &_result); err != nil {
-		return
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServicesClient) LogoutUser(ctx context.Context, req *UserLogoutReq) (r *UserLogoutResp, err error) {
-	var _args UserServicesLogoutUserArgs
-	_args.Req = req
-	var _result UserServicesLogoutUserResult
-	if err = p.Client_().Call(ctx, "LogoutUser", &_args, &_result); err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return _result.GetSuccess(), nil
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteUserReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
-func (p *UserServicesClient) UserEdit(ctx context.Context, req *UserEditReq) (r *UserEditResp, err error) {
-	var _args UserServicesUserEditArgs
-	_args.Req = req
-	var _result UserServicesUserEditResult
-	if err = p.Client_().Call(ctx, "UserEdit", &_args, &_result); err != nil {
-		return
+
+func (p *DeleteUserReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
 	}
-	return _result.GetSuccess(), nil
+	p.ID = _field
+	return nil
 }
-func (p *UserServicesClient) UserSearches(ctx context.Context, req *UserSearchReq) (r *UserSearchResp, err error) {
-	var _args UserServicesUserSearchesArgs
-	_args.Req = req
-	var _result UserServicesUserSearchesResult
-	if err = p.Client_().Call(ctx, "UserSearches", &_args, &_result); err != nil {
-		return
+
+func (p *DeleteUserReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteUserReq"); err != nil {
+		goto WriteStructBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServicesClient) AddUser(ctx context.Context, req *AddUserReq) (r *AddUserResp, err error) {
-	var _args UserServicesAddUserArgs
-	_args.Req = req
-	var _result UserServicesAddUserResult
-	if err = p.Client_().Call(ctx, "AddUser", &_args, &_result); err != nil {
-		return
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServicesClient) DeleteUser(ctx context.Context, req *DeleteUserReq) (r *DeleteUserResp, err error) {
-	var _args UserServicesDeleteUserArgs
-	_args.Req = req
-	var _result UserServicesDeleteUserResult
-	if err = p.Client_().Call(ctx, "DeleteUser", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServicesClient) UpdateUser(ctx context.Context, req *UpdateUserReq) (r *UpdateUserResp, err error) {
-	var _args UserServicesUpdateUserArgs
-	_args.Req = req
-	var _result UserServicesUpdateUserResult
-	if err = p.Client_().Call(ctx, "UpdateUser", &_args, &_result); err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return _result.GetSuccess(), nil
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
-func (p *UserServicesClient) QueryUsers(ctx context.Context, req *QueryUserReq) (r *QueryUserResp, err error) {
-	var _args UserServicesQueryUsersArgs
-	_args.Req = req
-	var _result UserServicesQueryUsersResult
-	if err = p.Client_().Call(ctx, "QueryUsers", &_args, &_result); err != nil {
-		return
+
+func (p *DeleteUserReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return _result.GetSuccess(), nil
-}
-func (p *UserServicesClient) GetUser(ctx context.Context, req *GetUserReq) (r *GetUserResp, err error) {
-	var _args UserServicesGetUserArgs
-	_args.Req = req
-	var _result UserServicesGetUserResult
-	if err = p.Client_().Call(ctx, "GetUser", &_args, &_result); err != nil {
-		return
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type UserServicesProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      UserServices
-}
+func (p *DeleteUserReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteUserReq(%+v)", *p)
 
-func (p *UserServicesProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
 }
 
-func (p *UserServicesProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+type DeleteUserResp struct {
+	Resp *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
 }
 
-func (p *UserServicesProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func NewDeleteUserResp() *DeleteUserResp {
+	return &DeleteUserResp{}
 }
 
-func NewUserServicesProcessor(handler UserServices) *UserServicesProcessor {
-	self := &UserServicesProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("UserRegister", &userServicesProcessorUserRegister{handler: handler})
-	self.AddToProcessorMap("UserLogin", &userServicesProcessorUserLogin{handler: handler})
-	self.AddToProcessorMap("GetLoginUser", &userServicesProcessorGetLoginUser{handler: handler})
-	self.AddToProcessorMap("LogoutUser", &userServicesProcessorLogoutUser{handler: handler})
-	self.AddToProcessorMap("UserEdit", &userServicesProcessorUserEdit{handler: handler})
-	self.AddToProcessorMap("UserSearches", &userServicesProcessorUserSearches{handler: handler})
-	self.AddToProcessorMap("AddUser", &userServicesProcessorAddUser{handler: handler})
-	self.AddToProcessorMap("DeleteUser", &userServicesProcessorDeleteUser{handler: handler})
-	self.AddToProcessorMap("UpdateUser", &userServicesProcessorUpdateUser{handler: handler})
-	self.AddToProcessorMap("QueryUsers", &userServicesProcessorQueryUsers{handler: handler})
-	self.AddToProcessorMap("GetUser", &userServicesProcessorGetUser{handler: handler})
-	return self
+func (p *DeleteUserResp) InitDefault() {
 }
-func (p *UserServicesProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+
+var DeleteUserResp_Resp_DEFAULT *base.BaseResp
+
+func (p *DeleteUserResp) GetResp() (v *base.BaseResp) {
+	if !p.IsSetResp() {
+		return DeleteUserResp_Resp_DEFAULT
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return p.Resp
 }
 
-type userServicesProcessorUserRegister struct {
-	handler UserServices
+var fieldIDToName_DeleteUserResp = map[int16]string{
+	255: "resp",
 }
 
-func (p *userServicesProcessorUserRegister) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesUserRegisterArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *DeleteUserResp) IsSetResp() bool {
+	return p.Resp != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesUserRegisterResult{}
-	var retval *UserRegisterResp
-	if retval, err2 = p.handler.UserRegister(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserRegister: "+err2.Error())
-		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UserRegister", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+func (p *DeleteUserResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type userServicesProcessorUserLogin struct {
-	handler UserServices
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DeleteUserResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *userServicesProcessorUserLogin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesUserLoginArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *DeleteUserResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Resp = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesUserLoginResult{}
-	var retval *UserLoginResp
-	if retval, err2 = p.handler.UserLogin(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserLogin: "+err2.Error())
-		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *DeleteUserResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeleteUserResp"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UserLogin", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DeleteUserResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("resp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Resp.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
 }
 
-type userServicesProcessorGetLoginUser struct {
-	handler UserServices
+func (p *DeleteUserResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DeleteUserResp(%+v)", *p)
+
 }
 
-func (p *userServicesProcessorGetLoginUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesGetLoginUserArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetLoginUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type UpdateUserReq struct {
+	ID           int64   `thrift:"id,1" form:"id" json:"id" query:"id"`
+	UserPassword *string `thrift:"user_password,2,optional" form:"user_password" json:"user_password,omitempty" query:"user_password" vd:"$ == null || len($) >= 8"`
+	UserAvatar   *string `thrift:"user_avatar,3,optional" form:"user_avatar" json:"user_avatar,omitempty" query:"user_avatar"`
+	UserProfile  *string `thrift:"user_profile,4,optional" form:"user_profile" json:"user_profile,omitempty" query:"user_profile"`
+	UserRole     *string `thrift:"user_role,5,optional" form:"user_role" json:"user_role,omitempty" query:"user_role"`
+	// 配额覆盖, -1 恢复为默认配额, 0 表示不限制
+	QuotaCount *int64 `thrift:"quota_count,6,optional" form:"quota_count" json:"quota_count,omitempty" query:"quota_count" vd:"$ == null || $ >= -1"`
+	QuotaSize  *int64 `thrift:"quota_size,7,optional" form:"quota_size" json:"quota_size,omitempty" query:"quota_size" vd:"$ == null || $ >= -1"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesGetLoginUserResult{}
-	var retval *GetLoginUserResp
-	if retval, err2 = p.handler.GetLoginUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetLoginUser: "+err2.Error())
-		oprot.WriteMessageBegin("GetLoginUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func NewUpdateUserReq() *UpdateUserReq {
+	return &UpdateUserReq{}
+}
+
+func (p *UpdateUserReq) InitDefault() {
+}
+
+func (p *UpdateUserReq) GetID() (v int64) {
+	return p.ID
+}
+
+var UpdateUserReq_UserPassword_DEFAULT string
+
+func (p *UpdateUserReq) GetUserPassword() (v string) {
+	if !p.IsSetUserPassword() {
+		return UpdateUserReq_UserPassword_DEFAULT
 	}
-	if err2 = oprot.WriteMessageBegin("GetLoginUser", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	return *p.UserPassword
+}
+
+var UpdateUserReq_UserAvatar_DEFAULT string
+
+func (p *UpdateUserReq) GetUserAvatar() (v string) {
+	if !p.IsSetUserAvatar() {
+		return UpdateUserReq_UserAvatar_DEFAULT
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	return *p.UserAvatar
+}
+
+var UpdateUserReq_UserProfile_DEFAULT string
+
+func (p *UpdateUserReq) GetUserProfile() (v string) {
+	if !p.IsSetUserProfile() {
+		return UpdateUserReq_UserProfile_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return *p.UserProfile
+}
+
+var UpdateUserReq_UserRole_DEFAULT string
+
+func (p *UpdateUserReq) GetUserRole() (v string) {
+	if !p.IsSetUserRole() {
+		return UpdateUserReq_UserRole_DEFAULT
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return *p.UserRole
+}
+
+var UpdateUserReq_QuotaCount_DEFAULT int64
+
+func (p *UpdateUserReq) GetQuotaCount() (v int64) {
+	if !p.IsSetQuotaCount() {
+		return UpdateUserReq_QuotaCount_DEFAULT
 	}
-	if err != nil {
-		return
+	return *p.QuotaCount
+}
+
+var UpdateUserReq_QuotaSize_DEFAULT int64
+
+func (p *UpdateUserReq) GetQuotaSize() (v int64) {
+	if !p.IsSetQuotaSize() {
+		return UpdateUserReq_QuotaSize_DEFAULT
 	}
-	return true, err
+	return *p.QuotaSize
 }
 
-type userServicesProcessorLogoutUser struct {
-	handler UserServices
+var fieldIDToName_UpdateUserReq = map[int16]string{
+	1: "id",
+	2: "user_password",
+	3: "user_avatar",
+	4: "user_profile",
+	5: "user_role",
+	6: "quota_count",
+	7: "quota_size",
 }
 
-func (p *userServicesProcessorLogoutUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesLogoutUserArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("LogoutUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *UpdateUserReq) IsSetUserPassword() bool {
+	return p.UserPassword != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesLogoutUserResult{}
-	var retval *UserLogoutResp
-	if retval, err2 = p.handler.LogoutUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LogoutUser: "+err2.Error())
-		oprot.WriteMessageBegin("LogoutUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
+func (p *UpdateUserReq) IsSetUserAvatar() bool {
+	return p.UserAvatar != nil
+}
+
+func (p *UpdateUserReq) IsSetUserProfile() bool {
+	return p.UserProfile != nil
+}
+
+func (p *UpdateUserReq) IsSetUserRole() bool {
+	return p.UserRole != nil
+}
+
+func (p *UpdateUserReq) IsSetQuotaCount() bool {
+	return p.QuotaCount != nil
+}
+
+func (p *UpdateUserReq) IsSetQuotaSize() bool {
+	return p.QuotaSize != nil
+}
+
+func (p *UpdateUserReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateUserReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
 	} else {
-		result.Success = retval
+		_field = v
 	}
-	if err2 = oprot.WriteMessageBegin("LogoutUser", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.ID = _field
+	return nil
+}
+func (p *UpdateUserReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserPassword = _field
+	return nil
+}
+func (p *UpdateUserReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserAvatar = _field
+	return nil
+}
+func (p *UpdateUserReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserProfile = _field
+	return nil
+}
+func (p *UpdateUserReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserRole = _field
+	return nil
+}
+func (p *UpdateUserReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.QuotaCount = _field
+	return nil
+}
+func (p *UpdateUserReq) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.QuotaSize = _field
+	return nil
+}
+
+func (p *UpdateUserReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateUserReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateUserReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdateUserReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserPassword() {
+		if err = oprot.WriteFieldBegin("user_password", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserPassword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *UpdateUserReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserAvatar() {
+		if err = oprot.WriteFieldBegin("user_avatar", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserAvatar); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *UpdateUserReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserProfile() {
+		if err = oprot.WriteFieldBegin("user_profile", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserProfile); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *UpdateUserReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserRole() {
+		if err = oprot.WriteFieldBegin("user_role", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserRole); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *UpdateUserReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQuotaCount() {
+		if err = oprot.WriteFieldBegin("quota_count", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.QuotaCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+func (p *UpdateUserReq) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetQuotaSize() {
+		if err = oprot.WriteFieldBegin("quota_size", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.QuotaSize); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *UpdateUserReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateUserReq(%+v)", *p)
+
+}
+
+type UpdateUserResp struct {
+	User *base.UserVo   `thrift:"user,1" form:"user" json:"user" query:"user"`
+	Resp *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
+}
+
+func NewUpdateUserResp() *UpdateUserResp {
+	return &UpdateUserResp{}
+}
+
+func (p *UpdateUserResp) InitDefault() {
+}
+
+var UpdateUserResp_User_DEFAULT *base.UserVo
+
+func (p *UpdateUserResp) GetUser() (v *base.UserVo) {
+	if !p.IsSetUser() {
+		return UpdateUserResp_User_DEFAULT
+	}
+	return p.User
+}
+
+var UpdateUserResp_Resp_DEFAULT *base.BaseResp
+
+func (p *UpdateUserResp) GetResp() (v *base.BaseResp) {
+	if !p.IsSetResp() {
+		return UpdateUserResp_Resp_DEFAULT
+	}
+	return p.Resp
+}
+
+var fieldIDToName_UpdateUserResp = map[int16]string{
+	1:   "user",
+	255: "resp",
+}
+
+func (p *UpdateUserResp) IsSetUser() bool {
+	return p.User != nil
+}
+
+func (p *UpdateUserResp) IsSetResp() bool {
+	return p.Resp != nil
+}
+
+func (p *UpdateUserResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UpdateUserResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UpdateUserResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := base.NewUserVo()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.User = _field
+	return nil
+}
+func (p *UpdateUserResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Resp = _field
+	return nil
+}
+
+func (p *UpdateUserResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateUserResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UpdateUserResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.User.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *UpdateUserResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("resp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Resp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *UpdateUserResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateUserResp(%+v)", *p)
+
+}
+
+type QueryUserReq struct {
+	ID          *int64  `thrift:"id,1,optional" form:"id" json:"id,omitempty" query:"id"`
+	UserAccount *string `thrift:"user_account,2,optional" form:"user_account" json:"user_account,omitempty" query:"user_account" vd:"$ == null || (len($) >= 4 && regexp('^[a-zA-Z0-9]+$'))"`
+	UserRole    *string `thrift:"user_role,3,optional" form:"user_role" json:"user_role,omitempty" query:"user_role"`
+	UserProfile *string `thrift:"user_profile,4,optional" form:"user_profile" json:"user_profile,omitempty" query:"user_profile"`
+	CurrentPage int64   `thrift:"current_page,5" form:"current_page" json:"current_page" query:"current_page"`
+	PageSize    int64   `thrift:"page_size,6" form:"page_size" json:"page_size" query:"page_size"`
+}
+
+func NewQueryUserReq() *QueryUserReq {
+	return &QueryUserReq{}
+}
+
+func (p *QueryUserReq) InitDefault() {
+}
+
+var QueryUserReq_ID_DEFAULT int64
+
+func (p *QueryUserReq) GetID() (v int64) {
+	if !p.IsSetID() {
+		return QueryUserReq_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var QueryUserReq_UserAccount_DEFAULT string
+
+func (p *QueryUserReq) GetUserAccount() (v string) {
+	if !p.IsSetUserAccount() {
+		return QueryUserReq_UserAccount_DEFAULT
+	}
+	return *p.UserAccount
+}
+
+var QueryUserReq_UserRole_DEFAULT string
+
+func (p *QueryUserReq) GetUserRole() (v string) {
+	if !p.IsSetUserRole() {
+		return QueryUserReq_UserRole_DEFAULT
+	}
+	return *p.UserRole
+}
+
+var QueryUserReq_UserProfile_DEFAULT string
+
+func (p *QueryUserReq) GetUserProfile() (v string) {
+	if !p.IsSetUserProfile() {
+		return QueryUserReq_UserProfile_DEFAULT
+	}
+	return *p.UserProfile
+}
+
+func (p *QueryUserReq) GetCurrentPage() (v int64) {
+	return p.CurrentPage
+}
+
+func (p *QueryUserReq) GetPageSize() (v int64) {
+	return p.PageSize
+}
+
+var fieldIDToName_QueryUserReq = map[int16]string{
+	1: "id",
+	2: "user_account",
+	3: "user_role",
+	4: "user_profile",
+	5: "current_page",
+	6: "page_size",
+}
+
+func (p *QueryUserReq) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *QueryUserReq) IsSetUserAccount() bool {
+	return p.UserAccount != nil
+}
+
+func (p *QueryUserReq) IsSetUserRole() bool {
+	return p.UserRole != nil
+}
+
+func (p *QueryUserReq) IsSetUserProfile() bool {
+	return p.UserProfile != nil
+}
+
+func (p *QueryUserReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryUserReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryUserReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *QueryUserReq) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserAccount = _field
+	return nil
+}
+func (p *QueryUserReq) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserRole = _field
+	return nil
+}
+func (p *QueryUserReq) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserProfile = _field
+	return nil
+}
+func (p *QueryUserReq) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.CurrentPage = _field
+	return nil
+}
+func (p *QueryUserReq) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.PageSize = _field
+	return nil
+}
+
+func (p *QueryUserReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryUserReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryUserReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *QueryUserReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserAccount() {
+		if err = oprot.WriteFieldBegin("user_account", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserAccount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *QueryUserReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserRole() {
+		if err = oprot.WriteFieldBegin("user_role", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserRole); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+func (p *QueryUserReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserProfile() {
+		if err = oprot.WriteFieldBegin("user_profile", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserProfile); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+func (p *QueryUserReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("current_page", thrift.I64, 5); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.CurrentPage); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+func (p *QueryUserReq) writeField6(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("page_size", thrift.I64, 6); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.PageSize); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *QueryUserReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryUserReq(%+v)", *p)
+
+}
+
+type QueryUserResp struct {
+	Users []*base.UserVo `thrift:"users,1" form:"users" json:"users" query:"users"`
+	Total int64          `thrift:"total,2" form:"total" json:"total" query:"total"`
+	Resp  *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
+}
+
+func NewQueryUserResp() *QueryUserResp {
+	return &QueryUserResp{}
+}
+
+func (p *QueryUserResp) InitDefault() {
+}
+
+func (p *QueryUserResp) GetUsers() (v []*base.UserVo) {
+	return p.Users
+}
+
+func (p *QueryUserResp) GetTotal() (v int64) {
+	return p.Total
+}
+
+var QueryUserResp_Resp_DEFAULT *base.BaseResp
+
+func (p *QueryUserResp) GetResp() (v *base.BaseResp) {
+	if !p.IsSetResp() {
+		return QueryUserResp_Resp_DEFAULT
+	}
+	return p.Resp
+}
+
+var fieldIDToName_QueryUserResp = map[int16]string{
+	1:   "users",
+	2:   "total",
+	255: "resp",
+}
+
+func (p *QueryUserResp) IsSetResp() bool {
+	return p.Resp != nil
+}
+
+func (p *QueryUserResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_QueryUserResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *QueryUserResp) ReadField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*base.UserVo, 0, size)
+	values := make([]base.UserVo, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Users = _field
+	return nil
+}
+func (p *QueryUserResp) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Total = _field
+	return nil
+}
+func (p *QueryUserResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Resp = _field
+	return nil
+}
+
+func (p *QueryUserResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("QueryUserResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *QueryUserResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("users", thrift.LIST, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Users)); err != nil {
+		return err
+	}
+	for _, v := range p.Users {
+		if err := v.Write(oprot); err != nil {
+			return err
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *QueryUserResp) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("total", thrift.I64, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.Total); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+func (p *QueryUserResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("resp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Resp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *QueryUserResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("QueryUserResp(%+v)", *p)
+
+}
+
+type GetUserReq struct {
+	ID int64 `thrift:"id,1" form:"id" json:"id" query:"id"`
+}
+
+func NewGetUserReq() *GetUserReq {
+	return &GetUserReq{}
+}
+
+func (p *GetUserReq) InitDefault() {
+}
+
+func (p *GetUserReq) GetID() (v int64) {
+	return p.ID
+}
+
+var fieldIDToName_GetUserReq = map[int16]string{
+	1: "id",
+}
+
+func (p *GetUserReq) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserReq[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetUserReq) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.ID = _field
+	return nil
+}
+
+func (p *GetUserReq) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserReq"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUserReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("id", thrift.I64, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteI64(p.ID); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetUserReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserReq(%+v)", *p)
+
+}
+
+type GetUserResp struct {
+	User *base.User     `thrift:"user,1" form:"user" json:"user" query:"user"`
+	Resp *base.BaseResp `thrift:"resp,255" form:"resp" json:"resp" query:"resp"`
+}
+
+func NewGetUserResp() *GetUserResp {
+	return &GetUserResp{}
+}
+
+func (p *GetUserResp) InitDefault() {
+}
+
+var GetUserResp_User_DEFAULT *base.User
+
+func (p *GetUserResp) GetUser() (v *base.User) {
+	if !p.IsSetUser() {
+		return GetUserResp_User_DEFAULT
+	}
+	return p.User
+}
+
+var GetUserResp_Resp_DEFAULT *base.BaseResp
+
+func (p *GetUserResp) GetResp() (v *base.BaseResp) {
+	if !p.IsSetResp() {
+		return GetUserResp_Resp_DEFAULT
+	}
+	return p.Resp
+}
+
+var fieldIDToName_GetUserResp = map[int16]string{
+	1:   "user",
+	255: "resp",
+}
+
+func (p *GetUserResp) IsSetUser() bool {
+	return p.User != nil
+}
+
+func (p *GetUserResp) IsSetResp() bool {
+	return p.Resp != nil
+}
+
+func (p *GetUserResp) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 255:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField255(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUserResp[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetUserResp) ReadField1(iprot thrift.TProtocol) error {
+	_field := base.NewUser()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.User = _field
+	return nil
+}
+func (p *GetUserResp) ReadField255(iprot thrift.TProtocol) error {
+	_field := base.NewBaseResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Resp = _field
+	return nil
+}
+
+func (p *GetUserResp) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUserResp"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField255(oprot); err != nil {
+			fieldId = 255
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUserResp) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("user", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.User.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+func (p *GetUserResp) writeField255(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("resp", thrift.STRUCT, 255); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Resp.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 255 end error: ", p), err)
+}
+
+func (p *GetUserResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserResp(%+v)", *p)
+
+}
+
+type UserServices interface {
+	UserRegister(ctx context.Context, req *UserLoginReq) (r *UserRegisterResp, err error)
+
+	UserLogin(ctx context.Context, req *UserLoginReq) (r *UserLoginResp, err error)
+	//# auth
+	GetLoginUser(ctx context.Context, req *GetLoginUserReq) (r *GetLoginUserResp, err error)
+
+	LogoutUser(ctx context.Context, req *UserLogoutReq) (r *UserLogoutResp, err error)
+
+	UserEdit(ctx context.Context, req *UserEditReq) (r *UserEditResp, err error)
+
+	UserSearches(ctx context.Context, req *UserSearchReq) (r *UserSearchResp, err error)
+
+	FollowUser(ctx context.Context, req *FollowUserReq) (r *FollowUserResp, err error)
+
+	UnfollowUser(ctx context.Context, req *UnfollowUserReq) (r *UnfollowUserResp, err error)
+
+	ListFollower(ctx context.Context, req *ListFollowerReq) (r *ListFollowerResp, err error)
+
+	ListFollowing(ctx context.Context, req *ListFollowingReq) (r *ListFollowingResp, err error)
+	//# admin
+	AddUser(ctx context.Context, req *AddUserReq) (r *AddUserResp, err error)
+
+	DeleteUser(ctx context.Context, req *DeleteUserReq) (r *DeleteUserResp, err error)
+
+	UpdateUser(ctx context.Context, req *UpdateUserReq) (r *UpdateUserResp, err error)
+
+	QueryUsers(ctx context.Context, req *QueryUserReq) (r *QueryUserResp, err error)
+
+	GetUser(ctx context.Context, req *GetUserReq) (r *GetUserResp, err error)
+}
+
+type UserServicesClient struct {
+	c thrift.TClient
+}
+
+func NewUserServicesClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *UserServicesClient {
+	return &UserServicesClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewUserServicesClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *UserServicesClient {
+	return &UserServicesClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewUserServicesClient(c thrift.TClient) *UserServicesClient {
+	return &UserServicesClient{
+		c: c,
+	}
+}
+
+func (p *UserServicesClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *UserServicesClient) UserRegister(ctx context.Context, req *UserLoginReq) (r *UserRegisterResp, err error) {
+	var _args UserServicesUserRegisterArgs
+	_args.Req = req
+	var _result UserServicesUserRegisterResult
+	if err = p.Client_().Call(ctx, "UserRegister", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) UserLogin(ctx context.Context, req *UserLoginReq) (r *UserLoginResp, err error) {
+	var _args UserServicesUserLoginArgs
+	_args.Req = req
+	var _result UserServicesUserLoginResult
+	if err = p.Client_().Call(ctx, "UserLogin", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) GetLoginUser(ctx context.Context, req *GetLoginUserReq) (r *GetLoginUserResp, err error) {
+	var _args UserServicesGetLoginUserArgs
+	_args.Req = req
+	var _result UserServicesGetLoginUserResult
+	if err = p.Client_().Call(ctx, "GetLoginUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) LogoutUser(ctx context.Context, req *UserLogoutReq) (r *UserLogoutResp, err error) {
+	var _args UserServicesLogoutUserArgs
+	_args.Req = req
+	var _result UserServicesLogoutUserResult
+	if err = p.Client_().Call(ctx, "LogoutUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) UserEdit(ctx context.Context, req *UserEditReq) (r *UserEditResp, err error) {
+	var _args UserServicesUserEditArgs
+	_args.Req = req
+	var _result UserServicesUserEditResult
+	if err = p.Client_().Call(ctx, "UserEdit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) UserSearches(ctx context.Context, req *UserSearchReq) (r *UserSearchResp, err error) {
+	var _args UserServicesUserSearchesArgs
+	_args.Req = req
+	var _result UserServicesUserSearchesResult
+	if err = p.Client_().Call(ctx, "UserSearches", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) FollowUser(ctx context.Context, req *FollowUserReq) (r *FollowUserResp, err error) {
+	var _args UserServicesFollowUserArgs
+	_args.Req = req
+	var _result UserServicesFollowUserResult
+	if err = p.Client_().Call(ctx, "FollowUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) UnfollowUser(ctx context.Context, req *UnfollowUserReq) (r *UnfollowUserResp, err error) {
+	var _args UserServicesUnfollowUserArgs
+	_args.Req = req
+	var _result UserServicesUnfollowUserResult
+	if err = p.Client_().Call(ctx, "UnfollowUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) ListFollower(ctx context.Context, req *ListFollowerReq) (r *ListFollowerResp, err error) {
+	var _args UserServicesListFollowerArgs
+	_args.Req = req
+	var _result UserServicesListFollowerResult
+	if err = p.Client_().Call(ctx, "ListFollower", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) ListFollowing(ctx context.Context, req *ListFollowingReq) (r *ListFollowingResp, err error) {
+	var _args UserServicesListFollowingArgs
+	_args.Req = req
+	var _result UserServicesListFollowingResult
+	if err = p.Client_().Call(ctx, "ListFollowing", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) AddUser(ctx context.Context, req *AddUserReq) (r *AddUserResp, err error) {
+	var _args UserServicesAddUserArgs
+	_args.Req = req
+	var _result UserServicesAddUserResult
+	if err = p.Client_().Call(ctx, "AddUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) DeleteUser(ctx context.Context, req *DeleteUserReq) (r *DeleteUserResp, err error) {
+	var _args UserServicesDeleteUserArgs
+	_args.Req = req
+	var _result UserServicesDeleteUserResult
+	if err = p.Client_().Call(ctx, "DeleteUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) UpdateUser(ctx context.Context, req *UpdateUserReq) (r *UpdateUserResp, err error) {
+	var _args UserServicesUpdateUserArgs
+	_args.Req = req
+	var _result UserServicesUpdateUserResult
+	if err = p.Client_().Call(ctx, "UpdateUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) QueryUsers(ctx context.Context, req *QueryUserReq) (r *QueryUserResp, err error) {
+	var _args UserServicesQueryUsersArgs
+	_args.Req = req
+	var _result UserServicesQueryUsersResult
+	if err = p.Client_().Call(ctx, "QueryUsers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServicesClient) GetUser(ctx context.Context, req *GetUserReq) (r *GetUserResp, err error) {
+	var _args UserServicesGetUserArgs
+	_args.Req = req
+	var _result UserServicesGetUserResult
+	if err = p.Client_().Call(ctx, "GetUser", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type UserServicesProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      UserServices
+}
+
+func (p *UserServicesProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *UserServicesProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *UserServicesProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewUserServicesProcessor(handler UserServices) *UserServicesProcessor {
+	self := &UserServicesProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("UserRegister", &userServicesProcessorUserRegister{handler: handler})
+	self.AddToProcessorMap("UserLogin", &userServicesProcessorUserLogin{handler: handler})
+	self.AddToProcessorMap("GetLoginUser", &userServicesProcessorGetLoginUser{handler: handler})
+	self.AddToProcessorMap("LogoutUser", &userServicesProcessorLogoutUser{handler: handler})
+	self.AddToProcessorMap("UserEdit", &userServicesProcessorUserEdit{handler: handler})
+	self.AddToProcessorMap("UserSearches", &userServicesProcessorUserSearches{handler: handler})
+	self.AddToProcessorMap("FollowUser", &userServicesProcessorFollowUser{handler: handler})
+	self.AddToProcessorMap("UnfollowUser", &userServicesProcessorUnfollowUser{handler: handler})
+	self.AddToProcessorMap("ListFollower", &userServicesProcessorListFollower{handler: handler})
+	self.AddToProcessorMap("ListFollowing", &userServicesProcessorListFollowing{handler: handler})
+	self.AddToProcessorMap("AddUser", &userServicesProcessorAddUser{handler: handler})
+	self.AddToProcessorMap("DeleteUser", &userServicesProcessorDeleteUser{handler: handler})
+	self.AddToProcessorMap("UpdateUser", &userServicesProcessorUpdateUser{handler: handler})
+	self.AddToProcessorMap("QueryUsers", &userServicesProcessorQueryUsers{handler: handler})
+	self.AddToProcessorMap("GetUser", &userServicesProcessorGetUser{handler: handler})
+	return self
+}
+func (p *UserServicesProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type userServicesProcessorUserRegister struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorUserRegister) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesUserRegisterArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesUserRegisterResult{}
+	var retval *UserRegisterResp
+	if retval, err2 = p.handler.UserRegister(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserRegister: "+err2.Error())
+		oprot.WriteMessageBegin("UserRegister", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserRegister", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorUserLogin struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorUserLogin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesUserLoginArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesUserLoginResult{}
+	var retval *UserLoginResp
+	if retval, err2 = p.handler.UserLogin(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserLogin: "+err2.Error())
+		oprot.WriteMessageBegin("UserLogin", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserLogin", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorGetLoginUser struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorGetLoginUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesGetLoginUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetLoginUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesGetLoginUserResult{}
+	var retval *GetLoginUserResp
+	if retval, err2 = p.handler.GetLoginUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetLoginUser: "+err2.Error())
+		oprot.WriteMessageBegin("GetLoginUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetLoginUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorLogoutUser struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorLogoutUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesLogoutUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("LogoutUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesLogoutUserResult{}
+	var retval *UserLogoutResp
+	if retval, err2 = p.handler.LogoutUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LogoutUser: "+err2.Error())
+		oprot.WriteMessageBegin("LogoutUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("LogoutUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorUserEdit struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorUserEdit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesUserEditArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserEdit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesUserEditResult{}
+	var retval *UserEditResp
+	if retval, err2 = p.handler.UserEdit(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserEdit: "+err2.Error())
+		oprot.WriteMessageBegin("UserEdit", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserEdit", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorUserSearches struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorUserSearches) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesUserSearchesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UserSearches", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesUserSearchesResult{}
+	var retval *UserSearchResp
+	if retval, err2 = p.handler.UserSearches(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserSearches: "+err2.Error())
+		oprot.WriteMessageBegin("UserSearches", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UserSearches", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorFollowUser struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorFollowUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesFollowUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("FollowUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesFollowUserResult{}
+	var retval *FollowUserResp
+	if retval, err2 = p.handler.FollowUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FollowUser: "+err2.Error())
+		oprot.WriteMessageBegin("FollowUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("FollowUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorUnfollowUser struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorUnfollowUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesUnfollowUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UnfollowUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesUnfollowUserResult{}
+	var retval *UnfollowUserResp
+	if retval, err2 = p.handler.UnfollowUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UnfollowUser: "+err2.Error())
+		oprot.WriteMessageBegin("UnfollowUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UnfollowUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorListFollower struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorListFollower) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesListFollowerArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListFollower", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesListFollowerResult{}
+	var retval *ListFollowerResp
+	if retval, err2 = p.handler.ListFollower(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListFollower: "+err2.Error())
+		oprot.WriteMessageBegin("ListFollower", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListFollower", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorListFollowing struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorListFollowing) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesListFollowingArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListFollowing", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesListFollowingResult{}
+	var retval *ListFollowingResp
+	if retval, err2 = p.handler.ListFollowing(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListFollowing: "+err2.Error())
+		oprot.WriteMessageBegin("ListFollowing", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListFollowing", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorAddUser struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorAddUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesAddUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("AddUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesAddUserResult{}
+	var retval *AddUserResp
+	if retval, err2 = p.handler.AddUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddUser: "+err2.Error())
+		oprot.WriteMessageBegin("AddUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("AddUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorDeleteUser struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorDeleteUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesDeleteUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesDeleteUserResult{}
+	var retval *DeleteUserResp
+	if retval, err2 = p.handler.DeleteUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteUser: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorUpdateUser struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorUpdateUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesUpdateUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesUpdateUserResult{}
+	var retval *UpdateUserResp
+	if retval, err2 = p.handler.UpdateUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateUser: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorQueryUsers struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorQueryUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesQueryUsersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("QueryUsers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesQueryUsersResult{}
+	var retval *QueryUserResp
+	if retval, err2 = p.handler.QueryUsers(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryUsers: "+err2.Error())
+		oprot.WriteMessageBegin("QueryUsers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("QueryUsers", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServicesProcessorGetUser struct {
+	handler UserServices
+}
+
+func (p *userServicesProcessorGetUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServicesGetUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServicesGetUserResult{}
+	var retval *GetUserResp
+	if retval, err2 = p.handler.GetUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUser: "+err2.Error())
+		oprot.WriteMessageBegin("GetUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetUser", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type UserServicesUserRegisterArgs struct {
+	Req *UserLoginReq `thrift:"req,1"`
+}
+
+func NewUserServicesUserRegisterArgs() *UserServicesUserRegisterArgs {
+	return &UserServicesUserRegisterArgs{}
+}
+
+func (p *UserServicesUserRegisterArgs) InitDefault() {
+}
+
+var UserServicesUserRegisterArgs_Req_DEFAULT *UserLoginReq
+
+func (p *UserServicesUserRegisterArgs) GetReq() (v *UserLoginReq) {
+	if !p.IsSetReq() {
+		return UserServicesUserRegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServicesUserRegisterArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServicesUserRegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServicesUserRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserRegisterArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServicesUserRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUserLoginReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServicesUserRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserRegister_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServicesUserRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServicesUserRegisterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServicesUserRegisterArgs(%+v)", *p)
+
+}
+
+type UserServicesUserRegisterResult struct {
+	Success *UserRegisterResp `thrift:"success,0,optional"`
+}
+
+func NewUserServicesUserRegisterResult() *UserServicesUserRegisterResult {
+	return &UserServicesUserRegisterResult{}
+}
+
+func (p *UserServicesUserRegisterResult) InitDefault() {
+}
+
+var UserServicesUserRegisterResult_Success_DEFAULT *UserRegisterResp
+
+func (p *UserServicesUserRegisterResult) GetSuccess() (v *UserRegisterResp) {
+	if !p.IsSetSuccess() {
+		return UserServicesUserRegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServicesUserRegisterResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServicesUserRegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServicesUserRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserRegisterResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServicesUserRegisterResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserRegisterResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServicesUserRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserRegister_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServicesUserRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServicesUserRegisterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServicesUserRegisterResult(%+v)", *p)
+
+}
+
+type UserServicesUserLoginArgs struct {
+	Req *UserLoginReq `thrift:"req,1"`
+}
+
+func NewUserServicesUserLoginArgs() *UserServicesUserLoginArgs {
+	return &UserServicesUserLoginArgs{}
+}
+
+func (p *UserServicesUserLoginArgs) InitDefault() {
+}
+
+var UserServicesUserLoginArgs_Req_DEFAULT *UserLoginReq
+
+func (p *UserServicesUserLoginArgs) GetReq() (v *UserLoginReq) {
+	if !p.IsSetReq() {
+		return UserServicesUserLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServicesUserLoginArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServicesUserLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServicesUserLoginArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserLoginArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServicesUserLoginArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUserLoginReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServicesUserLoginArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserLogin_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServicesUserLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServicesUserLoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServicesUserLoginArgs(%+v)", *p)
+
+}
+
+type UserServicesUserLoginResult struct {
+	Success *UserLoginResp `thrift:"success,0,optional"`
+}
+
+func NewUserServicesUserLoginResult() *UserServicesUserLoginResult {
+	return &UserServicesUserLoginResult{}
+}
+
+func (p *UserServicesUserLoginResult) InitDefault() {
+}
+
+var UserServicesUserLoginResult_Success_DEFAULT *UserLoginResp
+
+func (p *UserServicesUserLoginResult) GetSuccess() (v *UserLoginResp) {
+	if !p.IsSetSuccess() {
+		return UserServicesUserLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_UserServicesUserLoginResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServicesUserLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServicesUserLoginResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserLoginResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServicesUserLoginResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserLoginResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *UserServicesUserLoginResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UserLogin_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServicesUserLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServicesUserLoginResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServicesUserLoginResult(%+v)", *p)
+
+}
+
+type UserServicesGetLoginUserArgs struct {
+	Req *GetLoginUserReq `thrift:"req,1"`
+}
+
+func NewUserServicesGetLoginUserArgs() *UserServicesGetLoginUserArgs {
+	return &UserServicesGetLoginUserArgs{}
+}
+
+func (p *UserServicesGetLoginUserArgs) InitDefault() {
+}
+
+var UserServicesGetLoginUserArgs_Req_DEFAULT *GetLoginUserReq
+
+func (p *UserServicesGetLoginUserArgs) GetReq() (v *GetLoginUserReq) {
+	if !p.IsSetReq() {
+		return UserServicesGetLoginUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_UserServicesGetLoginUserArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *UserServicesGetLoginUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServicesGetLoginUserArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesGetLoginUserArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UserServicesGetLoginUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetLoginUserReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	p.Req = _field
+	return nil
+}
+
+func (p *UserServicesGetLoginUserArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetLoginUser_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type userServicesProcessorUserEdit struct {
-	handler UserServices
+func (p *UserServicesGetLoginUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *userServicesProcessorUserEdit) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesUserEditArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserEdit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServicesGetLoginUserArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("UserServicesGetLoginUserArgs(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesUserEditResult{}
-	var retval *UserEditResp
-	if retval, err2 = p.handler.UserEdit(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserEdit: "+err2.Error())
-		oprot.WriteMessageBegin("UserEdit", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UserEdit", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+}
+
+type UserServicesGetLoginUserResult struct {
+	Success *GetLoginUserResp `thrift:"success,0,optional"`
+}
+
+func NewUserServicesGetLoginUserResult() *UserServicesGetLoginUserResult {
+	return &UserServicesGetLoginUserResult{}
+}
+
+func (p *UserServicesGetLoginUserResult) InitDefault() {
+}
+
+var UserServicesGetLoginUserResult_Success_DEFAULT *GetLoginUserResp
+
+func (p *UserServicesGetLoginUserResult) GetSuccess() (v *GetLoginUserResp) {
+	if !p.IsSetSuccess() {
+		return UserServicesGetLoginUserResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_UserServicesGetLoginUserResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServicesGetLoginUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServicesGetLoginUserResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type userServicesProcessorUserSearches struct {
-	handler UserServices
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesGetLoginUserResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *userServicesProcessorUserSearches) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesUserSearchesArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UserSearches", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServicesGetLoginUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetLoginUserResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesUserSearchesResult{}
-	var retval *UserSearchResp
-	if retval, err2 = p.handler.UserSearches(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UserSearches: "+err2.Error())
-		oprot.WriteMessageBegin("UserSearches", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *UserServicesGetLoginUserResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetLoginUser_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UserSearches", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServicesGetLoginUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServicesGetLoginUserResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("UserServicesGetLoginUserResult(%+v)", *p)
+
 }
 
-type userServicesProcessorAddUser struct {
-	handler UserServices
+type UserServicesLogoutUserArgs struct {
+	Req *UserLogoutReq `thrift:"req,1"`
 }
 
-func (p *userServicesProcessorAddUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesAddUserArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("AddUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewUserServicesLogoutUserArgs() *UserServicesLogoutUserArgs {
+	return &UserServicesLogoutUserArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesAddUserResult{}
-	var retval *AddUserResp
-	if retval, err2 = p.handler.AddUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddUser: "+err2.Error())
-		oprot.WriteMessageBegin("AddUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("AddUser", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *UserServicesLogoutUserArgs) InitDefault() {
+}
+
+var UserServicesLogoutUserArgs_Req_DEFAULT *UserLogoutReq
+
+func (p *UserServicesLogoutUserArgs) GetReq() (v *UserLogoutReq) {
+	if !p.IsSetReq() {
+		return UserServicesLogoutUserArgs_Req_DEFAULT
 	}
-	return true, err
+	return p.Req
 }
 
-type userServicesProcessorDeleteUser struct {
-	handler UserServices
+var fieldIDToName_UserServicesLogoutUserArgs = map[int16]string{
+	1: "req",
 }
 
-func (p *userServicesProcessorDeleteUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesDeleteUserArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeleteUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *UserServicesLogoutUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesDeleteUserResult{}
-	var retval *DeleteUserResp
-	if retval, err2 = p.handler.DeleteUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteUser: "+err2.Error())
-		oprot.WriteMessageBegin("DeleteUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("DeleteUser", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *UserServicesLogoutUserArgs) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type userServicesProcessorUpdateUser struct {
-	handler UserServices
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesLogoutUserArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *userServicesProcessorUpdateUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesUpdateUserArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UpdateUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServicesLogoutUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUserLogoutReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Req = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesUpdateUserResult{}
-	var retval *UpdateUserResp
-	if retval, err2 = p.handler.UpdateUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateUser: "+err2.Error())
-		oprot.WriteMessageBegin("UpdateUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *UserServicesLogoutUserArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LogoutUser_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("UpdateUser", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServicesLogoutUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UserServicesLogoutUserArgs) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("UserServicesLogoutUserArgs(%+v)", *p)
+
 }
 
-type userServicesProcessorQueryUsers struct {
-	handler UserServices
+type UserServicesLogoutUserResult struct {
+	Success *UserLogoutResp `thrift:"success,0,optional"`
 }
 
-func (p *userServicesProcessorQueryUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesQueryUsersArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("QueryUsers", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewUserServicesLogoutUserResult() *UserServicesLogoutUserResult {
+	return &UserServicesLogoutUserResult{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesQueryUsersResult{}
-	var retval *QueryUserResp
-	if retval, err2 = p.handler.QueryUsers(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryUsers: "+err2.Error())
-		oprot.WriteMessageBegin("QueryUsers", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("QueryUsers", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *UserServicesLogoutUserResult) InitDefault() {
+}
+
+var UserServicesLogoutUserResult_Success_DEFAULT *UserLogoutResp
+
+func (p *UserServicesLogoutUserResult) GetSuccess() (v *UserLogoutResp) {
+	if !p.IsSetSuccess() {
+		return UserServicesLogoutUserResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_UserServicesLogoutUserResult = map[int16]string{
+	0: "success",
+}
+
+func (p *UserServicesLogoutUserResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServicesLogoutUserResult) Read(iprot thrift.TProtocol) (err error) {
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type userServicesProcessorGetUser struct {
-	handler UserServices
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesLogoutUserResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *userServicesProcessorGetUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServicesGetUserArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *UserServicesLogoutUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserLogoutResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := UserServicesGetUserResult{}
-	var retval *GetUserResp
-	if retval, err2 = p.handler.GetUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetUser: "+err2.Error())
-		oprot.WriteMessageBegin("GetUser", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *UserServicesLogoutUserResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LogoutUser_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("GetUser", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UserServicesLogoutUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *UserServicesLogoutUserResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("UserServicesLogoutUserResult(%+v)", *p)
+
 }
 
-type UserServicesUserRegisterArgs struct {
-	Req *UserLoginReq `thrift:"req,1"`
+type UserServicesUserEditArgs struct {
+	Req *UserEditReq `thrift:"req,1"`
 }
 
-func NewUserServicesUserRegisterArgs() *UserServicesUserRegisterArgs {
-	return &UserServicesUserRegisterArgs{}
+func NewUserServicesUserEditArgs() *UserServicesUserEditArgs {
+	return &UserServicesUserEditArgs{}
 }
 
-func (p *UserServicesUserRegisterArgs) InitDefault() {
+func (p *UserServicesUserEditArgs) InitDefault() {
 }
 
-var UserServicesUserRegisterArgs_Req_DEFAULT *UserLoginReq
+var UserServicesUserEditArgs_Req_DEFAULT *UserEditReq
 
-func (p *UserServicesUserRegisterArgs) GetReq() (v *UserLoginReq) {
+func (p *UserServicesUserEditArgs) GetReq() (v *UserEditReq) {
 	if !p.IsSetReq() {
-		return UserServicesUserRegisterArgs_Req_DEFAULT
+		return UserServicesUserEditArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServicesUserRegisterArgs = map[int16]string{
+var fieldIDToName_UserServicesUserEditArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServicesUserRegisterArgs) IsSetReq() bool {
+func (p *UserServicesUserEditArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServicesUserRegisterArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserEditArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5473,7 +8671,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserRegisterArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserEditArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5483,8 +8681,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesUserRegisterArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUserLoginReq()
+func (p *UserServicesUserEditArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUserEditReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5492,9 +8690,9 @@ func (p *UserServicesUserRegisterArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServicesUserRegisterArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserEditArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserRegister_args"); err != nil {
+	if err = oprot.WriteStructBegin("UserEdit_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5520,7 +8718,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesUserRegisterArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserEditArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5537,43 +8735,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServicesUserRegisterArgs) String() string {
+func (p *UserServicesUserEditArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesUserRegisterArgs(%+v)", *p)
+	return fmt.Sprintf("UserServicesUserEditArgs(%+v)", *p)
 
 }
 
-type UserServicesUserRegisterResult struct {
-	Success *UserRegisterResp `thrift:"success,0,optional"`
+type UserServicesUserEditResult struct {
+	Success *UserEditResp `thrift:"success,0,optional"`
 }
 
-func NewUserServicesUserRegisterResult() *UserServicesUserRegisterResult {
-	return &UserServicesUserRegisterResult{}
+func NewUserServicesUserEditResult() *UserServicesUserEditResult {
+	return &UserServicesUserEditResult{}
 }
 
-func (p *UserServicesUserRegisterResult) InitDefault() {
+func (p *UserServicesUserEditResult) InitDefault() {
 }
 
-var UserServicesUserRegisterResult_Success_DEFAULT *UserRegisterResp
+var UserServicesUserEditResult_Success_DEFAULT *UserEditResp
 
-func (p *UserServicesUserRegisterResult) GetSuccess() (v *UserRegisterResp) {
+func (p *UserServicesUserEditResult) GetSuccess() (v *UserEditResp) {
 	if !p.IsSetSuccess() {
-		return UserServicesUserRegisterResult_Success_DEFAULT
+		return UserServicesUserEditResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServicesUserRegisterResult = map[int16]string{
+var fieldIDToName_UserServicesUserEditResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServicesUserRegisterResult) IsSetSuccess() bool {
+func (p *UserServicesUserEditResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServicesUserRegisterResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserEditResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5618,7 +8816,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserRegisterResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserEditResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5628,8 +8826,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesUserRegisterResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUserRegisterResp()
+func (p *UserServicesUserEditResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserEditResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5637,9 +8835,9 @@ func (p *UserServicesUserRegisterResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServicesUserRegisterResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserEditResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserRegister_result"); err != nil {
+	if err = oprot.WriteStructBegin("UserEdit_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5665,7 +8863,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesUserRegisterResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserEditResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5684,43 +8882,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServicesUserRegisterResult) String() string {
+func (p *UserServicesUserEditResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesUserRegisterResult(%+v)", *p)
+	return fmt.Sprintf("UserServicesUserEditResult(%+v)", *p)
 
 }
 
-type UserServicesUserLoginArgs struct {
-	Req *UserLoginReq `thrift:"req,1"`
+type UserServicesUserSearchesArgs struct {
+	Req *UserSearchReq `thrift:"req,1"`
 }
 
-func NewUserServicesUserLoginArgs() *UserServicesUserLoginArgs {
-	return &UserServicesUserLoginArgs{}
+func NewUserServicesUserSearchesArgs() *UserServicesUserSearchesArgs {
+	return &UserServicesUserSearchesArgs{}
 }
 
-func (p *UserServicesUserLoginArgs) InitDefault() {
+func (p *UserServicesUserSearchesArgs) InitDefault() {
 }
 
-var UserServicesUserLoginArgs_Req_DEFAULT *UserLoginReq
+var UserServicesUserSearchesArgs_Req_DEFAULT *UserSearchReq
 
-func (p *UserServicesUserLoginArgs) GetReq() (v *UserLoginReq) {
+func (p *UserServicesUserSearchesArgs) GetReq() (v *UserSearchReq) {
 	if !p.IsSetReq() {
-		return UserServicesUserLoginArgs_Req_DEFAULT
+		return UserServicesUserSearchesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServicesUserLoginArgs = map[int16]string{
+var fieldIDToName_UserServicesUserSearchesArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServicesUserLoginArgs) IsSetReq() bool {
+func (p *UserServicesUserSearchesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServicesUserLoginArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserSearchesArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5765,7 +8963,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserLoginArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserSearchesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5775,8 +8973,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesUserLoginArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUserLoginReq()
+func (p *UserServicesUserSearchesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUserSearchReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5784,9 +8982,9 @@ func (p *UserServicesUserLoginArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServicesUserLoginArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserSearchesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserLogin_args"); err != nil {
+	if err = oprot.WriteStructBegin("UserSearches_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5812,7 +9010,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesUserLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserSearchesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5829,43 +9027,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServicesUserLoginArgs) String() string {
+func (p *UserServicesUserSearchesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesUserLoginArgs(%+v)", *p)
+	return fmt.Sprintf("UserServicesUserSearchesArgs(%+v)", *p)
 
 }
 
-type UserServicesUserLoginResult struct {
-	Success *UserLoginResp `thrift:"success,0,optional"`
+type UserServicesUserSearchesResult struct {
+	Success *UserSearchResp `thrift:"success,0,optional"`
 }
 
-func NewUserServicesUserLoginResult() *UserServicesUserLoginResult {
-	return &UserServicesUserLoginResult{}
+func NewUserServicesUserSearchesResult() *UserServicesUserSearchesResult {
+	return &UserServicesUserSearchesResult{}
 }
 
-func (p *UserServicesUserLoginResult) InitDefault() {
+func (p *UserServicesUserSearchesResult) InitDefault() {
 }
 
-var UserServicesUserLoginResult_Success_DEFAULT *UserLoginResp
+var UserServicesUserSearchesResult_Success_DEFAULT *UserSearchResp
 
-func (p *UserServicesUserLoginResult) GetSuccess() (v *UserLoginResp) {
+func (p *UserServicesUserSearchesResult) GetSuccess() (v *UserSearchResp) {
 	if !p.IsSetSuccess() {
-		return UserServicesUserLoginResult_Success_DEFAULT
+		return UserServicesUserSearchesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServicesUserLoginResult = map[int16]string{
+var fieldIDToName_UserServicesUserSearchesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServicesUserLoginResult) IsSetSuccess() bool {
+func (p *UserServicesUserSearchesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServicesUserLoginResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserSearchesResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -5910,7 +9108,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserLoginResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserSearchesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5920,8 +9118,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesUserLoginResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUserLoginResp()
+func (p *UserServicesUserSearchesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserSearchResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5929,9 +9127,9 @@ func (p *UserServicesUserLoginResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServicesUserLoginResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserSearchesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserLogin_result"); err != nil {
+	if err = oprot.WriteStructBegin("UserSearches_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5957,7 +9155,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesUserLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUserSearchesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5976,43 +9174,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServicesUserLoginResult) String() string {
+func (p *UserServicesUserSearchesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesUserLoginResult(%+v)", *p)
+	return fmt.Sprintf("UserServicesUserSearchesResult(%+v)", *p)
 
 }
 
-type UserServicesGetLoginUserArgs struct {
-	Req *GetLoginUserReq `thrift:"req,1"`
+type UserServicesFollowUserArgs struct {
+	Req *FollowUserReq `thrift:"req,1"`
 }
 
-func NewUserServicesGetLoginUserArgs() *UserServicesGetLoginUserArgs {
-	return &UserServicesGetLoginUserArgs{}
+func NewUserServicesFollowUserArgs() *UserServicesFollowUserArgs {
+	return &UserServicesFollowUserArgs{}
 }
 
-func (p *UserServicesGetLoginUserArgs) InitDefault() {
+func (p *UserServicesFollowUserArgs) InitDefault() {
 }
 
-var UserServicesGetLoginUserArgs_Req_DEFAULT *GetLoginUserReq
+var UserServicesFollowUserArgs_Req_DEFAULT *FollowUserReq
 
-func (p *UserServicesGetLoginUserArgs) GetReq() (v *GetLoginUserReq) {
+func (p *UserServicesFollowUserArgs) GetReq() (v *FollowUserReq) {
 	if !p.IsSetReq() {
-		return UserServicesGetLoginUserArgs_Req_DEFAULT
+		return UserServicesFollowUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServicesGetLoginUserArgs = map[int16]string{
+var fieldIDToName_UserServicesFollowUserArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServicesGetLoginUserArgs) IsSetReq() bool {
+func (p *UserServicesFollowUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServicesGetLoginUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesFollowUserArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6057,7 +9255,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesGetLoginUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesFollowUserArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6067,8 +9265,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesGetLoginUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetLoginUserReq()
+func (p *UserServicesFollowUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewFollowUserReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6076,9 +9274,9 @@ func (p *UserServicesGetLoginUserArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServicesGetLoginUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesFollowUserArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLoginUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("FollowUser_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6104,7 +9302,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesGetLoginUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesFollowUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6121,43 +9319,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServicesGetLoginUserArgs) String() string {
+func (p *UserServicesFollowUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesGetLoginUserArgs(%+v)", *p)
+	return fmt.Sprintf("UserServicesFollowUserArgs(%+v)", *p)
 
 }
 
-type UserServicesGetLoginUserResult struct {
-	Success *GetLoginUserResp `thrift:"success,0,optional"`
+type UserServicesFollowUserResult struct {
+	Success *FollowUserResp `thrift:"success,0,optional"`
 }
 
-func NewUserServicesGetLoginUserResult() *UserServicesGetLoginUserResult {
-	return &UserServicesGetLoginUserResult{}
+func NewUserServicesFollowUserResult() *UserServicesFollowUserResult {
+	return &UserServicesFollowUserResult{}
 }
 
-func (p *UserServicesGetLoginUserResult) InitDefault() {
+func (p *UserServicesFollowUserResult) InitDefault() {
 }
 
-var UserServicesGetLoginUserResult_Success_DEFAULT *GetLoginUserResp
+var UserServicesFollowUserResult_Success_DEFAULT *FollowUserResp
 
-func (p *UserServicesGetLoginUserResult) GetSuccess() (v *GetLoginUserResp) {
+func (p *UserServicesFollowUserResult) GetSuccess() (v *FollowUserResp) {
 	if !p.IsSetSuccess() {
-		return UserServicesGetLoginUserResult_Success_DEFAULT
+		return UserServicesFollowUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServicesGetLoginUserResult = map[int16]string{
+var fieldIDToName_UserServicesFollowUserResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServicesGetLoginUserResult) IsSetSuccess() bool {
+func (p *UserServicesFollowUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServicesGetLoginUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesFollowUserResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6202,7 +9400,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesGetLoginUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesFollowUserResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6212,8 +9410,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesGetLoginUserResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewGetLoginUserResp()
+func (p *UserServicesFollowUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewFollowUserResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6221,9 +9419,9 @@ func (p *UserServicesGetLoginUserResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServicesGetLoginUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesFollowUserResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLoginUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("FollowUser_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6249,7 +9447,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesGetLoginUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesFollowUserResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6268,43 +9466,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServicesGetLoginUserResult) String() string {
+func (p *UserServicesFollowUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesGetLoginUserResult(%+v)", *p)
+	return fmt.Sprintf("UserServicesFollowUserResult(%+v)", *p)
 
 }
 
-type UserServicesLogoutUserArgs struct {
-	Req *UserLogoutReq `thrift:"req,1"`
+type UserServicesUnfollowUserArgs struct {
+	Req *UnfollowUserReq `thrift:"req,1"`
 }
 
-func NewUserServicesLogoutUserArgs() *UserServicesLogoutUserArgs {
-	return &UserServicesLogoutUserArgs{}
+func NewUserServicesUnfollowUserArgs() *UserServicesUnfollowUserArgs {
+	return &UserServicesUnfollowUserArgs{}
 }
 
-func (p *UserServicesLogoutUserArgs) InitDefault() {
+func (p *UserServicesUnfollowUserArgs) InitDefault() {
 }
 
-var UserServicesLogoutUserArgs_Req_DEFAULT *UserLogoutReq
+var UserServicesUnfollowUserArgs_Req_DEFAULT *UnfollowUserReq
 
-func (p *UserServicesLogoutUserArgs) GetReq() (v *UserLogoutReq) {
+func (p *UserServicesUnfollowUserArgs) GetReq() (v *UnfollowUserReq) {
 	if !p.IsSetReq() {
-		return UserServicesLogoutUserArgs_Req_DEFAULT
+		return UserServicesUnfollowUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServicesLogoutUserArgs = map[int16]string{
+var fieldIDToName_UserServicesUnfollowUserArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServicesLogoutUserArgs) IsSetReq() bool {
+func (p *UserServicesUnfollowUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServicesLogoutUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesUnfollowUserArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6349,7 +9547,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesLogoutUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUnfollowUserArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6359,8 +9557,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesLogoutUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUserLogoutReq()
+func (p *UserServicesUnfollowUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUnfollowUserReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6368,9 +9566,9 @@ func (p *UserServicesLogoutUserArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServicesLogoutUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUnfollowUserArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LogoutUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("UnfollowUser_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6396,7 +9594,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesLogoutUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUnfollowUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6413,43 +9611,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServicesLogoutUserArgs) String() string {
+func (p *UserServicesUnfollowUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesLogoutUserArgs(%+v)", *p)
+	return fmt.Sprintf("UserServicesUnfollowUserArgs(%+v)", *p)
 
 }
 
-type UserServicesLogoutUserResult struct {
-	Success *UserLogoutResp `thrift:"success,0,optional"`
+type UserServicesUnfollowUserResult struct {
+	Success *UnfollowUserResp `thrift:"success,0,optional"`
 }
 
-func NewUserServicesLogoutUserResult() *UserServicesLogoutUserResult {
-	return &UserServicesLogoutUserResult{}
+func NewUserServicesUnfollowUserResult() *UserServicesUnfollowUserResult {
+	return &UserServicesUnfollowUserResult{}
 }
 
-func (p *UserServicesLogoutUserResult) InitDefault() {
+func (p *UserServicesUnfollowUserResult) InitDefault() {
 }
 
-var UserServicesLogoutUserResult_Success_DEFAULT *UserLogoutResp
+var UserServicesUnfollowUserResult_Success_DEFAULT *UnfollowUserResp
 
-func (p *UserServicesLogoutUserResult) GetSuccess() (v *UserLogoutResp) {
+func (p *UserServicesUnfollowUserResult) GetSuccess() (v *UnfollowUserResp) {
 	if !p.IsSetSuccess() {
-		return UserServicesLogoutUserResult_Success_DEFAULT
+		return UserServicesUnfollowUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServicesLogoutUserResult = map[int16]string{
+var fieldIDToName_UserServicesUnfollowUserResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServicesLogoutUserResult) IsSetSuccess() bool {
+func (p *UserServicesUnfollowUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServicesLogoutUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesUnfollowUserResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6494,7 +9692,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesLogoutUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUnfollowUserResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6504,8 +9702,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesLogoutUserResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUserLogoutResp()
+func (p *UserServicesUnfollowUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUnfollowUserResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6513,9 +9711,9 @@ func (p *UserServicesLogoutUserResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServicesLogoutUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUnfollowUserResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LogoutUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("UnfollowUser_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6541,7 +9739,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesLogoutUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesUnfollowUserResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6560,43 +9758,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServicesLogoutUserResult) String() string {
+func (p *UserServicesUnfollowUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesLogoutUserResult(%+v)", *p)
+	return fmt.Sprintf("UserServicesUnfollowUserResult(%+v)", *p)
 
 }
 
-type UserServicesUserEditArgs struct {
-	Req *UserEditReq `thrift:"req,1"`
+type UserServicesListFollowerArgs struct {
+	Req *ListFollowerReq `thrift:"req,1"`
 }
 
-func NewUserServicesUserEditArgs() *UserServicesUserEditArgs {
-	return &UserServicesUserEditArgs{}
+func NewUserServicesListFollowerArgs() *UserServicesListFollowerArgs {
+	return &UserServicesListFollowerArgs{}
 }
 
-func (p *UserServicesUserEditArgs) InitDefault() {
+func (p *UserServicesListFollowerArgs) InitDefault() {
 }
 
-var UserServicesUserEditArgs_Req_DEFAULT *UserEditReq
+var UserServicesListFollowerArgs_Req_DEFAULT *ListFollowerReq
 
-func (p *UserServicesUserEditArgs) GetReq() (v *UserEditReq) {
+func (p *UserServicesListFollowerArgs) GetReq() (v *ListFollowerReq) {
 	if !p.IsSetReq() {
-		return UserServicesUserEditArgs_Req_DEFAULT
+		return UserServicesListFollowerArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServicesUserEditArgs = map[int16]string{
+var fieldIDToName_UserServicesListFollowerArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServicesUserEditArgs) IsSetReq() bool {
+func (p *UserServicesListFollowerArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServicesUserEditArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowerArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6641,7 +9839,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserEditArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesListFollowerArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6651,8 +9849,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesUserEditArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUserEditReq()
+func (p *UserServicesListFollowerArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListFollowerReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6660,9 +9858,9 @@ func (p *UserServicesUserEditArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServicesUserEditArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowerArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserEdit_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListFollower_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6688,7 +9886,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesUserEditArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowerArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6705,43 +9903,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServicesUserEditArgs) String() string {
+func (p *UserServicesListFollowerArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesUserEditArgs(%+v)", *p)
+	return fmt.Sprintf("UserServicesListFollowerArgs(%+v)", *p)
 
 }
 
-type UserServicesUserEditResult struct {
-	Success *UserEditResp `thrift:"success,0,optional"`
+type UserServicesListFollowerResult struct {
+	Success *ListFollowerResp `thrift:"success,0,optional"`
 }
 
-func NewUserServicesUserEditResult() *UserServicesUserEditResult {
-	return &UserServicesUserEditResult{}
+func NewUserServicesListFollowerResult() *UserServicesListFollowerResult {
+	return &UserServicesListFollowerResult{}
 }
 
-func (p *UserServicesUserEditResult) InitDefault() {
+func (p *UserServicesListFollowerResult) InitDefault() {
 }
 
-var UserServicesUserEditResult_Success_DEFAULT *UserEditResp
+var UserServicesListFollowerResult_Success_DEFAULT *ListFollowerResp
 
-func (p *UserServicesUserEditResult) GetSuccess() (v *UserEditResp) {
+func (p *UserServicesListFollowerResult) GetSuccess() (v *ListFollowerResp) {
 	if !p.IsSetSuccess() {
-		return UserServicesUserEditResult_Success_DEFAULT
+		return UserServicesListFollowerResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServicesUserEditResult = map[int16]string{
+var fieldIDToName_UserServicesListFollowerResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServicesUserEditResult) IsSetSuccess() bool {
+func (p *UserServicesListFollowerResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServicesUserEditResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowerResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6786,7 +9984,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserEditResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesListFollowerResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6796,8 +9994,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesUserEditResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUserEditResp()
+func (p *UserServicesListFollowerResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListFollowerResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6805,9 +10003,9 @@ func (p *UserServicesUserEditResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServicesUserEditResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowerResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserEdit_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListFollower_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6833,7 +10031,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesUserEditResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowerResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6852,43 +10050,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServicesUserEditResult) String() string {
+func (p *UserServicesListFollowerResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesUserEditResult(%+v)", *p)
+	return fmt.Sprintf("UserServicesListFollowerResult(%+v)", *p)
 
 }
 
-type UserServicesUserSearchesArgs struct {
-	Req *UserSearchReq `thrift:"req,1"`
+type UserServicesListFollowingArgs struct {
+	Req *ListFollowingReq `thrift:"req,1"`
 }
 
-func NewUserServicesUserSearchesArgs() *UserServicesUserSearchesArgs {
-	return &UserServicesUserSearchesArgs{}
+func NewUserServicesListFollowingArgs() *UserServicesListFollowingArgs {
+	return &UserServicesListFollowingArgs{}
 }
 
-func (p *UserServicesUserSearchesArgs) InitDefault() {
+func (p *UserServicesListFollowingArgs) InitDefault() {
 }
 
-var UserServicesUserSearchesArgs_Req_DEFAULT *UserSearchReq
+var UserServicesListFollowingArgs_Req_DEFAULT *ListFollowingReq
 
-func (p *UserServicesUserSearchesArgs) GetReq() (v *UserSearchReq) {
+func (p *UserServicesListFollowingArgs) GetReq() (v *ListFollowingReq) {
 	if !p.IsSetReq() {
-		return UserServicesUserSearchesArgs_Req_DEFAULT
+		return UserServicesListFollowingArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_UserServicesUserSearchesArgs = map[int16]string{
+var fieldIDToName_UserServicesListFollowingArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *UserServicesUserSearchesArgs) IsSetReq() bool {
+func (p *UserServicesListFollowingArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServicesUserSearchesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowingArgs) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -6933,7 +10131,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserSearchesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesListFollowingArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6943,8 +10141,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesUserSearchesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUserSearchReq()
+func (p *UserServicesListFollowingArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListFollowingReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6952,9 +10150,9 @@ func (p *UserServicesUserSearchesArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServicesUserSearchesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowingArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserSearches_args"); err != nil {
+	if err = oprot.WriteStructBegin("ListFollowing_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6980,7 +10178,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesUserSearchesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowingArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6997,43 +10195,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServicesUserSearchesArgs) String() string {
+func (p *UserServicesListFollowingArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesUserSearchesArgs(%+v)", *p)
+	return fmt.Sprintf("UserServicesListFollowingArgs(%+v)", *p)
 
 }
 
-type UserServicesUserSearchesResult struct {
-	Success *UserSearchResp `thrift:"success,0,optional"`
+type UserServicesListFollowingResult struct {
+	Success *ListFollowingResp `thrift:"success,0,optional"`
 }
 
-func NewUserServicesUserSearchesResult() *UserServicesUserSearchesResult {
-	return &UserServicesUserSearchesResult{}
+func NewUserServicesListFollowingResult() *UserServicesListFollowingResult {
+	return &UserServicesListFollowingResult{}
 }
 
-func (p *UserServicesUserSearchesResult) InitDefault() {
+func (p *UserServicesListFollowingResult) InitDefault() {
 }
 
-var UserServicesUserSearchesResult_Success_DEFAULT *UserSearchResp
+var UserServicesListFollowingResult_Success_DEFAULT *ListFollowingResp
 
-func (p *UserServicesUserSearchesResult) GetSuccess() (v *UserSearchResp) {
+func (p *UserServicesListFollowingResult) GetSuccess() (v *ListFollowingResp) {
 	if !p.IsSetSuccess() {
-		return UserServicesUserSearchesResult_Success_DEFAULT
+		return UserServicesListFollowingResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServicesUserSearchesResult = map[int16]string{
+var fieldIDToName_UserServicesListFollowingResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServicesUserSearchesResult) IsSetSuccess() bool {
+func (p *UserServicesListFollowingResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServicesUserSearchesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowingResult) Read(iprot thrift.TProtocol) (err error) {
 	var fieldTypeId thrift.TType
 	var fieldId int16
 
@@ -7078,7 +10276,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesUserSearchesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServicesListFollowingResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7088,8 +10286,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServicesUserSearchesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUserSearchResp()
+func (p *UserServicesListFollowingResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListFollowingResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7097,9 +10295,9 @@ func (p *UserServicesUserSearchesResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *UserServicesUserSearchesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowingResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserSearches_result"); err != nil {
+	if err = oprot.WriteStructBegin("ListFollowing_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7125,7 +10323,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServicesUserSearchesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServicesListFollowingResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7144,11 +10342,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServicesUserSearchesResult) String() string {
+func (p *UserServicesListFollowingResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServicesUserSearchesResult(%+v)", *p)
+	return fmt.Sprintf("UserServicesListFollowingResult(%+v)", *p)
 
 }
 
@@ -51,3 +51,19 @@ func AdminMiddleware() app.HandlerFunc {
 		c.Next(ctx)
 	}
 }
+
+// OptionalAuthMiddleware - 已登录时写入用户信息, 未登录时不拦截
+func OptionalAuthMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		session := sessions.Default(c)
+		currentByte, ok := session.Get(constants.UserLoginState).([]byte)
+		if ok {
+			var user *db_user.User
+			if err := sonic.Unmarshal(currentByte, &user); err == nil && user != nil {
+				c.Set("user_id", user.Id)
+				c.Set("user_role", user.UserRole)
+			}
+		}
+		c.Next(ctx)
+	}
+}
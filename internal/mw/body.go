@@ -0,0 +1,45 @@
+package mw
+
+import (
+	"bytes"
+	"context"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"io"
+	"net/http"
+)
+
+// BodyLimitMiddleware - 按路由限制请求体大小, 需开启 server.WithStreamBody
+// 超出默认上限的请求体以流的形式交由处理函数读取, 仅 limits 中的路由允许
+// params:
+//   - defaultLimit: 默认上限
+//   - limits: 路由 (FullPath) 对应的上限
+func BodyLimitMiddleware(defaultLimit int64, limits map[string]int64) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		limit, ok := limits[c.FullPath()]
+		if !ok {
+			limit = defaultLimit
+		}
+		contentLength := int64(c.Request.Header.ContentLength())
+		if contentLength > limit {
+			abortBodyTooLarge(c)
+			return
+		}
+		// 分块传输无法预知长度, 按默认上限读入内存
+		if contentLength < 0 && c.Request.IsBodyStream() {
+			body, err := io.ReadAll(io.LimitReader(c.Request.BodyStream(), defaultLimit+1))
+			if err != nil || int64(len(body)) > defaultLimit {
+				abortBodyTooLarge(c)
+				return
+			}
+			c.Request.SetBodyStream(bytes.NewReader(body), len(body))
+		}
+		c.Next(ctx)
+	}
+}
+
+func abortBodyTooLarge(c *app.RequestContext) {
+	resp := errno.BuildBaseResp(errno.ParamErr.WithMessage("请求体过大"))
+	c.JSON(http.StatusRequestEntityTooLarge, resp)
+	c.Abort()
+}
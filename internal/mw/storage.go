@@ -0,0 +1,30 @@
+package mw
+
+import (
+	"context"
+	tencentCos "github.com/Alf-Grindel/clide/internal/pkg/cos_client"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"net/http"
+	"strconv"
+)
+
+// StorageSignMiddleware - 本地存储驱动下校验私有对象的签名地址
+func StorageSignMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		client := tencentCos.NewLocalClient()
+		key := client.ObjKey(string(c.Path()))
+		if !tencentCos.IsPrivateKey(key) {
+			c.Next(ctx)
+			return
+		}
+		expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+		if err != nil || !client.VerifySign(http.MethodGet, key, expires, 0, c.Query("sign")) {
+			resp := errno.BuildBaseResp(errno.NoAuthErr.WithMessage("访问地址无效或已过期"))
+			c.JSON(http.StatusForbidden, resp)
+			c.Abort()
+			return
+		}
+		c.Next(ctx)
+	}
+}
@@ -0,0 +1,121 @@
+package tencentCos
+
+import (
+	"errors"
+	"github.com/Alf-Grindel/clide/internal/pkg/image_util"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"image"
+	"io"
+	"os"
+)
+
+// analyzePicture - 本地解析图片信息并填充 file, 解析失败的字段保持零值
+// params:
+//   - tempFile: 图片临时文件
+//   - file: 待填充的图片信息
+//
+// returns:
+//   - img: 解码后的位图, 无法解码时为 nil
+//   - error: 图片尺寸或动图帧数超出限制时返回参数错误
+func analyzePicture(tempFile *os.File, file *File) (image.Image, error) {
+	imageInfo, err := image_util.DecodeInfo(tempFile)
+	if err != nil {
+		hlog.Infof("cos_client - analyzePicture: decode picture info failed, %s\n", err)
+		return nil, nil
+	}
+	// 解码前按声明的尺寸拦截, 避免解压炸弹耗尽内存
+	if imageInfo.TooLarge() {
+		return nil, errno.ParamErr.WithMessage("图片尺寸过大")
+	}
+	file.fillImageInfo(imageInfo.Width, imageInfo.Height, imageInfo.Format)
+	animation, err := image_util.DecodeAnimation(tempFile, imageInfo.Format)
+	if errors.Is(err, image_util.ErrTooManyFrames) {
+		return nil, errno.ParamErr.WithMessage("动图帧数过多")
+	}
+	if err == nil {
+		file.PicFrames = animation.Frames
+		file.PicDuration = animation.Duration
+	}
+	// svg 等矢量图无法解码为位图
+	img, _, err := image_util.Decode(tempFile)
+	if err != nil {
+		return nil, nil
+	}
+	phash := image_util.DHash(img)
+	file.PicPhash = &phash
+	palette := image_util.ExtractPalette(img, constants.PaletteSize)
+	for _, c := range palette {
+		file.PicPalette = append(file.PicPalette, image_util.HexColor(c))
+	}
+	if len(file.PicPalette) > 0 {
+		file.PicColor = file.PicPalette[0]
+	}
+	return img, nil
+}
+
+// processExif - 读取 jpeg 的 exif 信息, 按方向转正并按需去除定位信息后回写临时文件
+// params:
+//   - tempFile: 图片临时文件
+//   - file: 待填充的图片信息
+//   - format: 识别出的图片格式
+//   - keepGps: 是否保留定位信息
+//
+// returns:
+//   - error: 需去除定位信息的 jpeg 无法处理时返回参数错误, 不保留原文件
+func processExif(tempFile *os.File, file *File, format string, keepGps bool) error {
+	if _, err := tempFile.Seek(0, io.SeekStart); err != nil {
+		hlog.Errorf("cos_client - processExif: offset the file on the start failed, %s\n", err)
+		return errno.OperationErr
+	}
+	data, err := io.ReadAll(tempFile)
+	if err != nil {
+		hlog.Errorf("cos_client - processExif: read temp file failed, %s\n", err)
+		return errno.OperationErr
+	}
+	exif, output, err := image_util.ProcessJpegExif(data, keepGps)
+	if err != nil {
+		// 非 jpeg 或保留定位信息时保持原文件
+		if format != "jpeg" || keepGps {
+			return nil
+		}
+		// 段结构无法解析时重新编码, 不保留任何元数据
+		output, err = image_util.StripJpegMetadata(data)
+		if errors.Is(err, image_util.ErrImageTooLarge) {
+			return errno.ParamErr.WithMessage("图片尺寸过大")
+		}
+		if err != nil {
+			hlog.Infof("cos_client - processExif: strip jpeg metadata failed, %s\n", err)
+			return errno.ParamErr.WithMessage("无法解析 jpeg 图片")
+		}
+	}
+	if exif != nil {
+		file.ExifMake = exif.Make
+		file.ExifModel = exif.Model
+		file.ExifTakenAt = exif.TakenAt
+		file.ExifOrientation = exif.Orientation
+	}
+	if output == nil {
+		return nil
+	}
+	if err = tempFile.Truncate(0); err != nil {
+		hlog.Errorf("cos_client - processExif: truncate temp file failed, %s\n", err)
+		return errno.OperationErr
+	}
+	if _, err = tempFile.WriteAt(output, 0); err != nil {
+		hlog.Errorf("cos_client - processExif: write temp file failed, %s\n", err)
+		return errno.OperationErr
+	}
+	return nil
+}
+
+func (f *File) fillImageInfo(width, height int, format string) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+	f.PicWidth = int32(width)
+	f.PicHeight = int32(height)
+	f.PicScale = float64(width) / float64(height)
+	f.PicFormat = format
+}
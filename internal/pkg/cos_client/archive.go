@@ -0,0 +1,284 @@
+package tencentCos
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"io"
+	"mime/multipart"
+	"os"
+	"path"
+	"strings"
+)
+
+var (
+	// errStopWalk - 提前结束遍历
+	errStopWalk       = errors.New("cos_client: stop walking archive")
+	errTooManyHeaders = errno.ParamErr.WithMessage(fmt.Sprintf("压缩包中的条目不能超过 %d 个", constants.MaxArchiveHeaders))
+)
+
+type ArchiveEntry struct {
+	// 压缩包内的路径, 统一使用 / 分隔
+	Name string
+	// 解压后的大小, 仅作参考, 读取时仍会限制大小
+	Size   int64
+	reader io.Reader
+}
+
+type archiveUploader struct {
+	Entry *ArchiveEntry
+}
+
+func (a *archiveUploader) Validate() error {
+	if a.Entry.Size > constants.MaxFileSize {
+		return errno.ParamErr.WithMessage("上传文件大小不能超过 2 MB")
+	}
+	return validateFileName(a.Entry.Name)
+}
+
+func (a *archiveUploader) GetOriginFileName() (string, error) {
+	return a.Entry.Name, nil
+}
+
+func (a *archiveUploader) ProcessFile(tempFile *os.File) error {
+	// 压缩包中声明的大小不可信, 多读取一个字节判断是否超出上限
+	n, err := io.Copy(tempFile, io.LimitReader(a.Entry.reader, constants.MaxFileSize+1))
+	if err != nil {
+		hlog.Errorf("cos_client - archiveUploader: copy archive entry failed, %s\n", err)
+		return errno.ParamErr.WithMessage("读取压缩包文件失败")
+	}
+	if n > constants.MaxFileSize {
+		return errno.ParamErr.WithMessage("上传文件大小不能超过 2 MB")
+	}
+	if _, err = tempFile.Seek(0, io.SeekStart); err != nil {
+		hlog.Errorf("cos_client - archiveUploader: offset the file on the start failed, %s\n", err)
+		return errno.OperationErr
+	}
+	return nil
+}
+
+// UploadPictureByArchiveEntry - 上传压缩包中的文件, 需在 WalkArchive 的回调中调用
+// params:
+//   - ctx
+//   - entry
+//   - opt
+//
+// returns:
+//   - pictureInformation
+//   - error: nil on success, non-nil on failure
+func UploadPictureByArchiveEntry(ctx context.Context, entry *ArchiveEntry, opt *UploadOption) (*File, error) {
+	uploader := &archiveUploader{entry}
+	return UploadPictureTemplate(ctx, uploader, opt)
+}
+
+// WalkArchive - 依次遍历 zip 或 tar.gz 压缩包中的文件, 跳过目录与隐藏文件
+// 条目数量与解压总大小受 MaxArchiveHeaders 与 MaxArchiveUncompressedSize 限制, 跳过的条目同样计入
+// params:
+//   - archive: 上传的压缩包
+//   - maxEntries: 文件数量上限
+//   - fn: 回调, 返回错误时终止遍历
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func WalkArchive(archive *multipart.FileHeader, maxEntries int, fn func(entry *ArchiveEntry) error) error {
+	file, err := archive.Open()
+	if err != nil {
+		hlog.Errorf("cos_client - WalkArchive: open archive failed, %s\n", err)
+		return errno.OperationErr
+	}
+	defer file.Close()
+	head := make([]byte, 4)
+	if _, err = io.ReadFull(file, head); err != nil {
+		return errno.ParamErr.WithMessage("压缩包格式错误")
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		hlog.Errorf("cos_client - WalkArchive: offset the file on the start failed, %s\n", err)
+		return errno.OperationErr
+	}
+	count := 0
+	visit := func(name string, size int64, reader io.Reader) error {
+		name = strings.ReplaceAll(name, "\\", "/")
+		if strings.HasSuffix(name, "/") {
+			return nil
+		}
+		// 去除 ./ 与 ../ 等相对路径
+		name = strings.TrimPrefix(path.Clean("/"+name), "/")
+		if skipArchiveEntry(name) {
+			return nil
+		}
+		if count++; count > maxEntries {
+			return errno.ParamErr.WithMessage(fmt.Sprintf("压缩包中的文件不能超过 %d 个", maxEntries))
+		}
+		return fn(&ArchiveEntry{Name: name, Size: size, reader: reader})
+	}
+	switch {
+	case bytes.Equal(head, []byte("PK\x03\x04")), bytes.Equal(head, []byte("PK\x05\x06")):
+		err = walkZip(file, archive.Size, &archiveLimiter{}, visit)
+	case head[0] == 0x1F && head[1] == 0x8B:
+		err = walkTarGz(file, &archiveLimiter{}, visit)
+	default:
+		return errno.ParamErr.WithMessage("仅支持 zip 与 tar.gz 压缩包")
+	}
+	if errors.Is(err, errStopWalk) {
+		return nil
+	}
+	return err
+}
+
+// ReadArchiveFile - 读取压缩包中指定路径的文件, 找到后即停止遍历
+// params:
+//   - archive
+//   - name: 压缩包内的路径
+//   - maxSize: 文件大小上限
+//
+// returns:
+//   - data: 文件不存在时为 nil
+//   - error: nil on success, non-nil on failure
+func ReadArchiveFile(archive *multipart.FileHeader, name string, maxSize int64) ([]byte, error) {
+	var data []byte
+	err := WalkArchive(archive, constants.MaxArchiveEntries, func(entry *ArchiveEntry) error {
+		if entry.Name != name {
+			return nil
+		}
+		buf, err := io.ReadAll(io.LimitReader(entry.reader, maxSize+1))
+		if err != nil {
+			return errno.ParamErr.WithMessage("读取压缩包文件失败")
+		}
+		if int64(len(buf)) > maxSize {
+			return errno.ParamErr.WithMessage(fmt.Sprintf("%s 过大", name))
+		}
+		data = buf
+		return errStopWalk
+	})
+	return data, err
+}
+
+// skipArchiveEntry - 隐藏文件与系统生成的文件不参与上传
+func skipArchiveEntry(name string) bool {
+	if name == "" {
+		return true
+	}
+	for _, segment := range strings.Split(name, "/") {
+		if strings.HasPrefix(segment, ".") || segment == "__MACOSX" {
+			return true
+		}
+	}
+	return false
+}
+
+func walkZip(file multipart.File, size int64, limiter *archiveLimiter, visit func(name string, size int64, reader io.Reader) error) error {
+	// 路径在 visit 中统一处理, 不因 ../ 等路径拒绝整个压缩包
+	reader, err := zip.NewReader(file, size)
+	if err != nil && !errors.Is(err, zip.ErrInsecurePath) {
+		return errno.ParamErr.WithMessage("压缩包格式错误")
+	}
+	// 中央目录已列出全部条目, 遍历前即可判断
+	if len(reader.File) > constants.MaxArchiveHeaders {
+		return errTooManyHeaders
+	}
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		if err = visitZipFile(f, limiter, visit); err != nil {
+			return err
+		}
+		if err = limiter.err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func visitZipFile(f *zip.File, limiter *archiveLimiter, visit func(name string, size int64, reader io.Reader) error) error {
+	rc, err := f.Open()
+	if err != nil {
+		// 不支持的压缩方式等, 读取时返回错误, 由回调记录为失败
+		return visit(f.Name, int64(f.UncompressedSize64), errReader{err})
+	}
+	defer rc.Close()
+	return visit(f.Name, int64(f.UncompressedSize64), limiter.reader(rc))
+}
+
+func walkTarGz(file multipart.File, limiter *archiveLimiter, visit func(name string, size int64, reader io.Reader) error) error {
+	gz, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		return errno.ParamErr.WithMessage("压缩包格式错误")
+	}
+	defer gz.Close()
+	// 跳过的条目同样需要解压, 统计整个数据流
+	reader := tar.NewReader(limiter.reader(gz))
+	for headers := 1; ; headers++ {
+		header, err := reader.Next()
+		if limitErr := limiter.err(); limitErr != nil {
+			return limitErr
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil && !errors.Is(err, tar.ErrInsecurePath) {
+			return errno.ParamErr.WithMessage("压缩包格式错误")
+		}
+		if headers > constants.MaxArchiveHeaders {
+			return errTooManyHeaders
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err = visit(header.Name, header.Size, reader); err != nil {
+			return err
+		}
+		if err = limiter.err(); err != nil {
+			return err
+		}
+	}
+}
+
+// archiveLimiter - 统计整个压缩包解压的字节数
+type archiveLimiter struct {
+	read int64
+}
+
+// err - 解压的字节数超出上限时返回错误
+func (l *archiveLimiter) err() error {
+	if l.read > constants.MaxArchiveUncompressedSize {
+		return errno.ParamErr.WithMessage(fmt.Sprintf("压缩包解压后的大小不能超过 %d MB", constants.MaxArchiveUncompressedSize/1024/1024))
+	}
+	return nil
+}
+
+// reader - 经由返回的 reader 读取的字节数计入统计, 超出上限后不再读取
+func (l *archiveLimiter) reader(r io.Reader) io.Reader {
+	return &limitedArchiveReader{limiter: l, reader: r}
+}
+
+type limitedArchiveReader struct {
+	limiter *archiveLimiter
+	reader  io.Reader
+}
+
+func (r *limitedArchiveReader) Read(p []byte) (int, error) {
+	if err := r.limiter.err(); err != nil {
+		return 0, err
+	}
+	n, err := r.reader.Read(p)
+	r.limiter.read += int64(n)
+	return n, err
+}
+
+// errReader - 读取时返回指定错误
+type errReader struct {
+	err error
+}
+
+func (e errReader) Read([]byte) (int, error) {
+	return 0, e.err
+}
@@ -0,0 +1,346 @@
+package tencentCos
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"io"
+	"mime/multipart"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testArchiveEntry struct {
+	name string
+	dir  bool
+	// size 大于 0 时写入 size 个 0, 否则写入 data
+	size int64
+	data string
+}
+
+func (e testArchiveEntry) reader() io.Reader {
+	if e.size > 0 {
+		return io.LimitReader(zeroReader{}, e.size)
+	}
+	return strings.NewReader(e.data)
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func buildZip(t *testing.T, entries []testArchiveEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, entry := range entries {
+		if entry.dir {
+			if _, err := w.Create(entry.name + "/"); err != nil {
+				t.Fatalf("zip Create() error = %v", err)
+			}
+			continue
+		}
+		f, err := w.Create(entry.name)
+		if err != nil {
+			t.Fatalf("zip Create() error = %v", err)
+		}
+		if _, err = io.Copy(f, entry.reader()); err != nil {
+			t.Fatalf("zip write error = %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("zip Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func buildTarGz(t *testing.T, entries []testArchiveEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz, _ := gzip.NewWriterLevel(&buf, gzip.BestSpeed)
+	w := tar.NewWriter(gz)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Mode: 0644, Typeflag: tar.TypeReg, Size: entry.size}
+		if entry.dir {
+			header = &tar.Header{Name: entry.name + "/", Mode: 0755, Typeflag: tar.TypeDir}
+		} else if entry.size == 0 {
+			header.Size = int64(len(entry.data))
+		}
+		if err := w.WriteHeader(header); err != nil {
+			t.Fatalf("tar WriteHeader() error = %v", err)
+		}
+		if _, err := io.Copy(w, entry.reader()); err != nil {
+			t.Fatalf("tar write error = %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("tar Close() error = %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("gzip Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+// archiveFileHeader - 经由 multipart 表单得到上传文件
+func archiveFileHeader(t *testing.T, data []byte) *multipart.FileHeader {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", "archive")
+	if err != nil {
+		t.Fatalf("CreateFormFile() error = %v", err)
+	}
+	if _, err = part.Write(data); err != nil {
+		t.Fatalf("write form file error = %v", err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("multipart Close() error = %v", err)
+	}
+	form, err := multipart.NewReader(&body, w.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("ReadForm() error = %v", err)
+	}
+	t.Cleanup(func() { _ = form.RemoveAll() })
+	return form.File["file"][0]
+}
+
+// archiveBuilders - 以相同条目分别构造 zip 与 tar.gz
+var archiveBuilders = []struct {
+	name  string
+	build func(t *testing.T, entries []testArchiveEntry) []byte
+}{
+	{name: "zip", build: buildZip},
+	{name: "tar.gz", build: buildTarGz},
+}
+
+// walkNames - 遍历压缩包并读取全部条目, 返回条目名称
+func walkNames(archive *multipart.FileHeader, maxEntries int) ([]string, error) {
+	var names []string
+	err := WalkArchive(archive, maxEntries, func(entry *ArchiveEntry) error {
+		names = append(names, entry.Name)
+		_, err := io.Copy(io.Discard, entry.reader)
+		return err
+	})
+	return names, err
+}
+
+func errMsg(err error) string {
+	var e errno.ErrNo
+	if errors.As(err, &e) {
+		return e.ErrMsg
+	}
+	return ""
+}
+
+func TestWalkArchiveNames(t *testing.T) {
+	entries := []testArchiveEntry{
+		{name: "dir", dir: true},
+		{name: "dir/a.png", data: "a"},
+		{name: "../../etc/b.png", data: "b"},
+		{name: "./c.png", data: "c"},
+		{name: "/abs/d.png", data: "d"},
+		{name: `win\e.png`, data: "e"},
+		{name: "__MACOSX/dir/._a.png", data: "x"},
+		{name: "dir/.DS_Store", data: "x"},
+		{name: ".hidden/f.png", data: "x"},
+		{name: "dir/../.g.png", data: "x"},
+	}
+	want := []string{"dir/a.png", "etc/b.png", "c.png", "abs/d.png", "win/e.png"}
+	for _, builder := range archiveBuilders {
+		t.Run(builder.name, func(t *testing.T) {
+			names, err := walkNames(archiveFileHeader(t, builder.build(t, entries)), constants.MaxArchiveEntries)
+			if err != nil {
+				t.Fatalf("WalkArchive() error = %v", err)
+			}
+			if !reflect.DeepEqual(names, want) {
+				t.Errorf("WalkArchive() names = %q, want %q", names, want)
+			}
+		})
+	}
+}
+
+func TestWalkArchiveLimits(t *testing.T) {
+	files := func(n int, prefix string, dir bool) []testArchiveEntry {
+		entries := make([]testArchiveEntry, n)
+		for i := range entries {
+			entries[i] = testArchiveEntry{name: fmt.Sprintf("%sfile%d", prefix, i), dir: dir}
+		}
+		return entries
+	}
+	tests := []struct {
+		name       string
+		entries    []testArchiveEntry
+		maxEntries int
+		wantMsg    string
+	}{
+		{
+			name:       "entries within limit",
+			entries:    files(3, "", false),
+			maxEntries: 3,
+		},
+		{
+			name:       "too many entries",
+			entries:    files(4, "", false),
+			maxEntries: 3,
+			wantMsg:    "压缩包中的文件不能超过 3 个",
+		},
+		{
+			// 跳过的目录与隐藏文件同样计入条目数量
+			name:       "too many headers",
+			entries:    append(files(constants.MaxArchiveHeaders, "", true), files(1, "", false)...),
+			maxEntries: constants.MaxArchiveEntries,
+			wantMsg:    errTooManyHeaders.ErrMsg,
+		},
+		{
+			name:       "too many hidden headers",
+			entries:    files(constants.MaxArchiveHeaders+1, ".", false),
+			maxEntries: constants.MaxArchiveEntries,
+			wantMsg:    errTooManyHeaders.ErrMsg,
+		},
+	}
+	for _, builder := range archiveBuilders {
+		for _, tt := range tests {
+			t.Run(builder.name+"/"+tt.name, func(t *testing.T) {
+				_, err := walkNames(archiveFileHeader(t, builder.build(t, tt.entries)), tt.maxEntries)
+				if tt.wantMsg == "" {
+					if err != nil {
+						t.Errorf("WalkArchive() error = %v", err)
+					}
+					return
+				}
+				if errMsg(err) != tt.wantMsg {
+					t.Errorf("WalkArchive() error = %v, want %q", err, tt.wantMsg)
+				}
+			})
+		}
+	}
+}
+
+func TestWalkArchiveUncompressedSize(t *testing.T) {
+	half := int64(constants.MaxArchiveUncompressedSize/2 + 1)
+	tests := []struct {
+		name    string
+		build   func(t *testing.T, entries []testArchiveEntry) []byte
+		entries []testArchiveEntry
+		// 回调中访问到的条目
+		want []string
+	}{
+		{
+			name:    "zip",
+			build:   buildZip,
+			entries: []testArchiveEntry{{name: "a.png", size: half}, {name: "b.png", size: half}, {name: "c.png", data: "c"}},
+			want:    []string{"a.png", "b.png"},
+		},
+		{
+			// 跳过的隐藏文件同样需要解压, 计入解压总大小
+			name:    "tar.gz skipped entries",
+			build:   buildTarGz,
+			entries: []testArchiveEntry{{name: ".a.png", size: half}, {name: "__MACOSX/b.png", size: half}, {name: "c.png", data: "c"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			names, err := walkNames(archiveFileHeader(t, tt.build(t, tt.entries)), constants.MaxArchiveEntries)
+			if !strings.Contains(errMsg(err), "解压后的大小") {
+				t.Errorf("WalkArchive() error = %v, want uncompressed size error", err)
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("WalkArchive() names = %q, want %q", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestArchiveUploaderProcessFile(t *testing.T) {
+	tests := []struct {
+		name string
+		// 声明的大小
+		size int64
+		// 实际内容的大小
+		actual  int64
+		wantErr bool
+	}{
+		{name: "max size", size: constants.MaxFileSize, actual: constants.MaxFileSize},
+		{name: "over max size", size: constants.MaxFileSize + 1, actual: constants.MaxFileSize + 1, wantErr: true},
+		{name: "declared size lies", size: 1, actual: constants.MaxFileSize + 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempFile, err := os.CreateTemp(t.TempDir(), "entry")
+			if err != nil {
+				t.Fatalf("CreateTemp() error = %v", err)
+			}
+			defer tempFile.Close()
+			uploader := &archiveUploader{Entry: &ArchiveEntry{Name: "a.png", Size: tt.size, reader: io.LimitReader(zeroReader{}, tt.actual)}}
+			err = uploader.ProcessFile(tempFile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ProcessFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			info, err := tempFile.Stat()
+			if err != nil || info.Size() != tt.actual {
+				t.Errorf("ProcessFile() wrote %v bytes, want %d", info.Size(), tt.actual)
+			}
+			if offset, _ := tempFile.Seek(0, io.SeekCurrent); offset != 0 {
+				t.Errorf("ProcessFile() offset = %d, want 0", offset)
+			}
+		})
+	}
+}
+
+func TestReadArchiveFile(t *testing.T) {
+	// 目标文件之后的条目超出数量上限, 找到后即停止遍历则不会报错
+	entries := []testArchiveEntry{{name: "dir/.ignored", data: "x"}, {name: constants.ArchiveManifest, data: `{"a":1}`}}
+	for i := 0; i <= constants.MaxArchiveEntries; i++ {
+		entries = append(entries, testArchiveEntry{name: fmt.Sprintf("pics/%d.png", i), data: "p"})
+	}
+	for _, builder := range archiveBuilders {
+		t.Run(builder.name, func(t *testing.T) {
+			archive := archiveFileHeader(t, builder.build(t, entries))
+			data, err := ReadArchiveFile(archive, constants.ArchiveManifest, 1024)
+			if err != nil || string(data) != `{"a":1}` {
+				t.Errorf("ReadArchiveFile() = %q, %v", data, err)
+			}
+			data, err = ReadArchiveFile(archive, constants.ArchiveManifest, 3)
+			if err == nil || data != nil {
+				t.Errorf("ReadArchiveFile() over max size = %q, %v, want error", data, err)
+			}
+			if _, err = ReadArchiveFile(archive, "missing.json", 1024); err == nil {
+				t.Errorf("ReadArchiveFile() missing file error = nil, want too many entries")
+			}
+		})
+	}
+}
+
+func TestWalkArchiveInvalid(t *testing.T) {
+	zipData := buildZip(t, []testArchiveEntry{{name: "a.png", data: "a"}})
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "too short", data: []byte("PK")},
+		{name: "unsupported format", data: []byte("Rar!\x1a\x07")},
+		{name: "truncated zip", data: zipData[:len(zipData)-10]},
+		{name: "truncated gzip", data: []byte{0x1F, 0x8B, 0x08, 0x00}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := walkNames(archiveFileHeader(t, tt.data), constants.MaxArchiveEntries); err == nil {
+				t.Errorf("WalkArchive() error = nil")
+			}
+		})
+	}
+}
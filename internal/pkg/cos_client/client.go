@@ -7,9 +7,11 @@ import (
 	"github.com/Alf-Grindel/clide/pkg/constants"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/tencentyun/cos-go-sdk-v5"
-	"mime/multipart"
+	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 )
 
 type TencentClient struct {
@@ -32,25 +34,76 @@ func NewTencentClient() *TencentClient {
 	}
 }
 
-func (s *TencentClient) PutObj(ctx context.Context, key string, file multipart.File) (string, error) {
-	_, err := s.client.Object.Put(ctx, key, file, nil)
+func (s *TencentClient) PutObj(ctx context.Context, key string, body io.Reader) error {
+	_, err := s.client.Object.Put(ctx, key, body, nil)
 	if err != nil {
 		hlog.Errorf("cos_client - PutObj: put file to cos failed, %s\n", err)
-		return "", err
+		return err
 	}
-	return key, nil
+	return nil
 }
 
-func (s *TencentClient) GetObj(ctx context.Context, key string) (*cos.Response, error) {
+func (s *TencentClient) GetObj(ctx context.Context, key string) (io.ReadCloser, error) {
 	resp, err := s.client.Object.Get(ctx, key, nil)
 	if err != nil {
 		hlog.Errorf("cos_client - GetObj: get file from cos failed, %s\n", err)
 		return nil, err
 	}
-	return resp, nil
+	return resp.Body, nil
+}
+
+func (s *TencentClient) DeleteObj(ctx context.Context, key string) error {
+	_, err := s.client.Object.Delete(ctx, key)
+	if err != nil {
+		hlog.Errorf("cos_client - DeleteObj: delete file from cos failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+func (s *TencentClient) StatObj(ctx context.Context, key string) (*ObjInfo, error) {
+	resp, err := s.client.Object.Head(ctx, key, nil)
+	if err != nil {
+		hlog.Errorf("cos_client - StatObj: head file from cos failed, %s\n", err)
+		return nil, err
+	}
+	lastModified, _ := http.ParseTime(resp.Header.Get("Last-Modified"))
+	return &ObjInfo{
+		Key:          key,
+		Size:         resp.ContentLength,
+		ContentType:  resp.Header.Get("Content-Type"),
+		LastModified: lastModified,
+	}, nil
+}
+
+func (s *TencentClient) PublicUrl(key string) string {
+	return config.Cos.Client.Host + "/" + key
+}
+
+func (s *TencentClient) PresignPutUrl(ctx context.Context, key string, size int64, expire time.Duration) (string, error) {
+	// Content-Length 参与签名, 上传的内容长度需与申请时一致
+	opt := &cos.PresignedURLOptions{
+		Header: &http.Header{},
+	}
+	opt.Header.Set("Content-Length", strconv.FormatInt(size, 10))
+	u, err := s.client.Object.GetPresignedURL(ctx, http.MethodPut, key, config.Cos.Client.SecretId, config.Cos.Client.SecretKey, expire, opt)
+	if err != nil {
+		hlog.Errorf("cos_client - PresignPutUrl: presign url failed, %s\n", err)
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func (s *TencentClient) PresignGetUrl(ctx context.Context, key string, expire time.Duration) (string, error) {
+	u, err := s.client.Object.GetPresignedURL(ctx, http.MethodGet, key, config.Cos.Client.SecretId, config.Cos.Client.SecretKey, expire, nil)
+	if err != nil {
+		hlog.Errorf("cos_client - PresignGetUrl: presign url failed, %s\n", err)
+		return "", err
+	}
+	return u.String(), nil
 }
 
-func (s *TencentClient) PutPictureObj(ctx context.Context, key string, file multipart.File) (*cos.ImageProcessResult, error) {
+func (s *TencentClient) PutPictureObj(ctx context.Context, key string, body io.Reader) (*cos.ImageProcessResult, error) {
 	pic := &cos.PicOperations{
 		IsPicInfo: 1, // 表示返回原图信息
 	}
@@ -62,7 +115,7 @@ func (s *TencentClient) PutPictureObj(ctx context.Context, key string, file mult
 	}
 	opt.XOptionHeader.Add("Pic-Operations", cos.EncodePicOperations(pic))
 
-	res, _, err := s.client.CI.Put(ctx, key, file, opt)
+	res, _, err := s.client.CI.Put(ctx, key, body, opt)
 	if err != nil {
 		hlog.Errorf("cos_client - PutPictureObj: put picture to cos failed, %s\n", err)
 		return nil, err
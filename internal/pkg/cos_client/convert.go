@@ -0,0 +1,62 @@
+package tencentCos
+
+import (
+	"bytes"
+	"errors"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/pkg/image_util"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"os"
+)
+
+// convertPicture - 写入存储前按格式处理图片: svg 清理或渲染为位图, heic 按需转为 jpeg
+// params:
+//   - tempFile: 已写入内容的临时文件
+//   - format: 识别出的图片格式
+//
+// returns:
+//   - format: 处理后的图片格式
+//   - error: nil on success, non-nil on failure
+func convertPicture(tempFile *os.File, format string) (string, error) {
+	switch {
+	case format == "svg":
+		return processSvg(tempFile)
+	case format == "heic" && config.Upload.HeicToJpeg:
+		return processHeic(tempFile)
+	default:
+		return format, nil
+	}
+}
+
+// processHeic - 将 heic 转为 jpeg, 多数浏览器无法直接展示 heic
+func processHeic(tempFile *os.File) (string, error) {
+	img, _, err := image_util.Decode(tempFile)
+	if errors.Is(err, image_util.ErrImageTooLarge) {
+		return "", errno.ParamErr.WithMessage("图片尺寸过大")
+	}
+	if err != nil {
+		hlog.Infof("cos_client - processHeic: decode heic failed, %s\n", err)
+		return "", errno.ParamErr.WithMessage("无法解析 heic 图片")
+	}
+	var output bytes.Buffer
+	if err = image_util.Encode(&output, img, image_util.FormatJpeg, constants.HeicJpegQuality); err != nil {
+		hlog.Errorf("cos_client - processHeic: encode jpeg failed, %s\n", err)
+		return "", errno.OperationErr
+	}
+	if err = rewriteFile(tempFile, output.Bytes()); err != nil {
+		hlog.Errorf("cos_client - processHeic: rewrite temp file failed, %s\n", err)
+		return "", errno.OperationErr
+	}
+	return "jpeg", nil
+}
+
+// rewriteFile - 以新内容覆盖临时文件
+func rewriteFile(tempFile *os.File, data []byte) error {
+	if err := tempFile.Truncate(0); err != nil {
+		return err
+	}
+	_, err := tempFile.WriteAt(data, 0)
+	return err
+}
@@ -2,6 +2,10 @@ package tencentCos
 
 import (
 	"context"
+	"errors"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/pkg/http_util"
+	"github.com/Alf-Grindel/clide/internal/pkg/image_util"
 	"github.com/Alf-Grindel/clide/pkg/constants"
 	"github.com/Alf-Grindel/clide/pkg/errno"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
@@ -12,7 +16,6 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"time"
 )
 
 var (
@@ -22,6 +25,10 @@ var (
 		"svg":  {},
 		"png":  {},
 		"webp": {},
+		"gif":  {},
+		"avif": {},
+		"heic": {},
+		"heif": {},
 	}
 )
 
@@ -36,7 +43,12 @@ func (l *localUploader) Validate() error {
 	if l.Picture.Size > constants.MaxFileSize {
 		return errno.ParamErr.WithMessage("上传文件大小不能超过 2 MB")
 	}
-	fileNameExt := filepath.Ext(l.Picture.Filename)
+	return validateFileName(l.Picture.Filename)
+}
+
+// validateFileName - 校验文件后缀是否为支持的图片类型
+func validateFileName(fileName string) error {
+	fileNameExt := filepath.Ext(fileName)
 	if fileNameExt == "" {
 		return errno.ParamErr.WithMessage("文件格式错误")
 	}
@@ -71,61 +83,106 @@ func (l *localUploader) ProcessFile(tempFile *os.File) error {
 // params:
 //   - ctx
 //   - picture
-//   - dirPrefix
+//   - opt
 //
 // returns:
 //   - pictureInformation
 //   - error: nil on success, non-nil on failure
-func UploadPicture(ctx context.Context, picture *multipart.FileHeader, dirPrefix string) (*File, error) {
+func UploadPicture(ctx context.Context, picture *multipart.FileHeader, opt *UploadOption) (*File, error) {
 	uploader := &localUploader{picture}
-	return UploadPictureTemplate(ctx, uploader, dirPrefix)
+	return UploadPictureTemplate(ctx, uploader, opt)
+}
+
+// PerceptualHashPicture - 计算图片的感知哈希, 不写入存储
+// params:
+//   - picture
+//
+// returns:
+//   - phash
+//   - error: nil on success, non-nil on failure
+func PerceptualHashPicture(picture *multipart.FileHeader) (uint64, error) {
+	uploader := &localUploader{picture}
+	if err := uploader.Validate(); err != nil {
+		return 0, err
+	}
+	fileBody, err := picture.Open()
+	if err != nil {
+		hlog.Errorf("cos_client - PerceptualHashPicture: open only read file failed, %s\n", err)
+		return 0, errno.OperationErr
+	}
+	defer fileBody.Close()
+	img, _, err := image_util.Decode(fileBody)
+	if err != nil {
+		return 0, errno.ParamErr.WithMessage("无法解析图片")
+	}
+	return image_util.DHash(img), nil
 }
 
 type urlUpload struct {
+	Ctx     context.Context
 	FileUrl string
+	// HEAD 请求返回的 Content-Type
+	ContentType string
+}
+
+// fetchOption - url 上传的请求限制
+func fetchOption() *http_util.FetchOption {
+	timeout := config.Upload.FetchTimeout
+	if timeout <= 0 {
+		timeout = constants.DefaultFetchTimeout
+	}
+	return &http_util.FetchOption{
+		Timeout:      timeout,
+		MaxRedirects: config.Upload.FetchMaxRedirects,
+		MaxSize:      constants.MaxFileSize,
+		AllowDomains: config.Upload.FetchAllowDomains,
+		DenyDomains:  config.Upload.FetchDenyDomains,
+	}
+}
+
+// fetchErr - 转换请求错误
+func fetchErr(err error) error {
+	switch {
+	case errors.Is(err, http_util.ErrUnsupportedScheme):
+		return errno.ParamErr.WithMessage("仅支持 HTTP 或 HTTPS 协议的文件地址")
+	case errors.Is(err, http_util.ErrBlockedDomain), errors.Is(err, http_util.ErrBlockedAddress):
+		return errno.ParamErr.WithMessage("不允许访问该文件地址")
+	case errors.Is(err, http_util.ErrTooManyRedirects):
+		return errno.ParamErr.WithMessage("文件地址重定向次数过多")
+	case errors.Is(err, http_util.ErrTooLarge):
+		return errno.ParamErr.WithMessage("上传文件大小不能超过 2 MB")
+	default:
+		return errno.OperationErr
+	}
 }
 
 func (u *urlUpload) Validate() error {
 	if u.FileUrl == "" {
 		return errno.ParamErr.WithMessage("文件地址为空")
 	}
-	fileUrl, err := url.ParseRequestURI(u.FileUrl)
-	if err != nil {
+	if _, err := url.ParseRequestURI(u.FileUrl); err != nil {
 		hlog.Errorf("cos_client - urlUpload: invalid URL format, %s\n", err)
 		return errno.ParamErr.WithMessage("文件地址格式不正确")
 	}
-	if fileUrl.Scheme != "http" && fileUrl.Scheme != "https" {
-		hlog.Errorf("cos_client - urlUpload: unsupported scheme, %s\n", fileUrl.Scheme)
-		return errno.ParamErr.WithMessage("仅支持 HTTP 或 HTTPS 协议的文件地址")
-	}
-	client := http.Client{Timeout: 5 * time.Second}
-	req, err := http.NewRequest(http.MethodHead, u.FileUrl, nil)
-	if err != nil {
-		hlog.Errorf("cos_client - urlUpload: build request failed, %s\n", err)
-		return errno.OperationErr
-	}
-	resp, err := client.Do(req)
+	resp, err := http_util.Fetch(u.Ctx, http.MethodHead, u.FileUrl, fetchOption())
 	if err != nil {
 		hlog.Errorf("cos_client - urlUpload: access url failed, %s\n", err)
-		return errno.OperationErr
+		return fetchErr(err)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		return nil
 	}
-	contentType := resp.Header.Get("Content-Type")
-	contentLength := resp.ContentLength
-	if contentLength == -1 || contentType == "" {
-		return nil
-	}
-	if !strings.HasPrefix(contentType, "image/") {
-		return errno.ParamErr.WithMessage("文件类型错误")
-	}
-	contentType = strings.ToLower(strings.TrimPrefix(contentType, "image/"))
-	if _, ok := pictureType[contentType]; !ok {
-		return errno.ParamErr.WithMessage("文件类型错误")
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		if !strings.HasPrefix(strings.ToLower(contentType), "image/") {
+			return errno.ParamErr.WithMessage("文件类型错误")
+		}
+		if _, ok := pictureType[normalizeType(contentType)]; !ok {
+			return errno.ParamErr.WithMessage("文件类型错误")
+		}
+		u.ContentType = contentType
 	}
-	if contentLength > constants.MaxFileSize {
+	if resp.ContentLength > constants.MaxFileSize {
 		return errno.ParamErr.WithMessage("上传文件大小不能超过 2 MB")
 	}
 	return nil
@@ -136,16 +193,16 @@ func (u *urlUpload) GetOriginFileName() (string, error) {
 	return base, nil
 }
 
+// DeclaredType - 以 Content-Type 作为声明类型, 地址后缀常与实际类型不符
+func (u *urlUpload) DeclaredType() string {
+	return u.ContentType
+}
+
 func (u *urlUpload) ProcessFile(tempFile *os.File) error {
-	resp, err := http.Get(u.FileUrl)
-	if err != nil {
-		hlog.Errorf("cos_client - urlUpload: get url information failed, %s\n", err)
-		return errno.OperationErr
-	}
-	defer resp.Body.Close()
-	if _, err := io.Copy(tempFile, resp.Body); err != nil {
-		hlog.Errorf("cos_client - urlUpload: copy file content failed, %s\n", err)
-		return errno.OperationErr
+	// 边下载边校验大小, 不依赖响应头中的 Content-Length
+	if _, err := http_util.Download(u.Ctx, tempFile, u.FileUrl, fetchOption()); err != nil {
+		hlog.Errorf("cos_client - urlUpload: download file content failed, %s\n", err)
+		return fetchErr(err)
 	}
 	if _, err := tempFile.Seek(0, io.SeekStart); err != nil {
 		hlog.Errorf("cos_client - urlUpload: offset the file on the start failed, %s\n", err)
@@ -158,12 +215,12 @@ func (u *urlUpload) ProcessFile(tempFile *os.File) error {
 // params:
 //   - ctx
 //   - FileUrl
-//   - dirPrefix
+//   - opt
 //
 // returns:
 //   - pictureInformation
 //   - error: nil on success, non-nil on failure
-func UploadPictureByUrl(ctx context.Context, fileUrl, dirPrefix string) (*File, error) {
-	uploader := &urlUpload{fileUrl}
-	return UploadPictureTemplate(ctx, uploader, dirPrefix)
+func UploadPictureByUrl(ctx context.Context, fileUrl string, opt *UploadOption) (*File, error) {
+	uploader := &urlUpload{Ctx: ctx, FileUrl: fileUrl}
+	return UploadPictureTemplate(ctx, uploader, opt)
 }
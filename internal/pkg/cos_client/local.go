@@ -0,0 +1,180 @@
+package tencentCos
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalClient - 本地磁盘存储, 通过 Hertz 静态路由对外提供访问
+type LocalClient struct {
+	root    string
+	host    string
+	route   string
+	signKey string
+}
+
+func NewLocalClient() *LocalClient {
+	return &LocalClient{
+		root:    config.Storage.Local.Root,
+		host:    strings.TrimSuffix(config.Storage.Local.Host, "/"),
+		route:   strings.Trim(config.Storage.Local.Route, "/"),
+		signKey: config.Storage.Local.SignKey,
+	}
+}
+
+// objPath - 将对象 key 转换为本地路径, 拒绝越出根目录的 key
+func (s *LocalClient) objPath(key string) (string, error) {
+	cleanKey := path.Clean("/" + key)
+	if cleanKey == "/" {
+		return "", errno.ParamErr.WithMessage("文件路径错误")
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleanKey)), nil
+}
+
+func (s *LocalClient) PutObj(ctx context.Context, key string, body io.Reader) error {
+	objPath, err := s.objPath(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(objPath), 0o755); err != nil {
+		hlog.Errorf("cos_client - LocalPutObj: make dir failed, %s\n", err)
+		return err
+	}
+	file, err := os.Create(objPath)
+	if err != nil {
+		hlog.Errorf("cos_client - LocalPutObj: create file failed, %s\n", err)
+		return err
+	}
+	if _, err = io.Copy(file, body); err != nil {
+		hlog.Errorf("cos_client - LocalPutObj: write file failed, %s\n", err)
+		_ = file.Close()
+		_ = os.Remove(objPath)
+		return err
+	}
+	// 关闭时才会报告部分写入错误, 失败时删除不完整的文件
+	if err = file.Close(); err != nil {
+		hlog.Errorf("cos_client - LocalPutObj: close file failed, %s\n", err)
+		_ = os.Remove(objPath)
+		return err
+	}
+	return nil
+}
+
+func (s *LocalClient) GetObj(ctx context.Context, key string) (io.ReadCloser, error) {
+	objPath, err := s.objPath(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(objPath)
+	if err != nil {
+		hlog.Errorf("cos_client - LocalGetObj: open file failed, %s\n", err)
+		return nil, err
+	}
+	return file, nil
+}
+
+func (s *LocalClient) DeleteObj(ctx context.Context, key string) error {
+	objPath, err := s.objPath(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(objPath); err != nil && !os.IsNotExist(err) {
+		hlog.Errorf("cos_client - LocalDeleteObj: remove file failed, %s\n", err)
+		return err
+	}
+	return nil
+}
+
+func (s *LocalClient) StatObj(ctx context.Context, key string) (*ObjInfo, error) {
+	objPath, err := s.objPath(key)
+	if err != nil {
+		return nil, err
+	}
+	fileInfo, err := os.Stat(objPath)
+	if err != nil {
+		hlog.Errorf("cos_client - LocalStatObj: stat file failed, %s\n", err)
+		return nil, err
+	}
+	return &ObjInfo{
+		Key:          key,
+		Size:         fileInfo.Size(),
+		ContentType:  mime.TypeByExtension(filepath.Ext(objPath)),
+		LastModified: fileInfo.ModTime(),
+	}, nil
+}
+
+func (s *LocalClient) PublicUrl(key string) string {
+	return s.host + "/" + s.route + "/" + key
+}
+
+func (s *LocalClient) PresignPutUrl(ctx context.Context, key string, size int64, expire time.Duration) (string, error) {
+	return s.presign(http.MethodPut, key, time.Now().Add(expire).Unix(), size)
+}
+
+func (s *LocalClient) PresignGetUrl(ctx context.Context, key string, expire time.Duration) (string, error) {
+	// 过期时间按半个有效期取整, 同一时间窗口内地址不变, 便于浏览器缓存
+	window := int64(expire/time.Second) / 2
+	if window <= 0 {
+		window = 1
+	}
+	expires := time.Now().Unix()/window*window + int64(expire/time.Second)
+	return s.presign(http.MethodGet, key, expires, 0)
+}
+
+// presign - size 为 0 时不限制内容长度
+func (s *LocalClient) presign(method, key string, expires, size int64) (string, error) {
+	if s.signKey == "" {
+		hlog.Errorf("cos_client - LocalPresign: sign key is not configured\n")
+		return "", errno.OperationErr
+	}
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	if size > 0 {
+		query.Set("size", strconv.FormatInt(size, 10))
+	}
+	query.Set("sign", s.sign(method, key, expires, size))
+	return s.PublicUrl(key) + "?" + query.Encode(), nil
+}
+
+// VerifySign - 校验预签名地址, 过期或签名不一致时返回 false
+// params:
+//   - method: 请求方法
+//   - key: 对象 key
+//   - expires: 过期时间戳 (秒)
+//   - size: 地址限定的内容长度, 未限定时为 0
+//   - sign: 地址中的签名
+//
+// returns:
+//   - ok
+func (s *LocalClient) VerifySign(method, key string, expires, size int64, sign string) bool {
+	if s.signKey == "" || time.Now().Unix() > expires {
+		return false
+	}
+	return hmac.Equal([]byte(sign), []byte(s.sign(method, key, expires, size)))
+}
+
+// ObjKey - 将访问路径转换为对象 key
+func (s *LocalClient) ObjKey(requestPath string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(requestPath, "/"+s.route), "/")
+}
+
+func (s *LocalClient) sign(method, key string, expires, size int64) string {
+	mac := hmac.New(sha256.New, []byte(s.signKey))
+	mac.Write([]byte(method + "\n" + key + "\n" + strconv.FormatInt(expires, 10) + "\n" + strconv.FormatInt(size, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
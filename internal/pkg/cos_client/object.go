@@ -0,0 +1,112 @@
+package tencentCos
+
+import (
+	"context"
+	"fmt"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"io"
+	"os"
+	"path"
+	"time"
+)
+
+type objectUploader struct {
+	Ctx         context.Context
+	Storage     Storage
+	Key         string
+	MaxFileSize int64
+}
+
+func (o *objectUploader) Validate() error {
+	objInfo, err := o.Storage.StatObj(o.Ctx, o.Key)
+	if err != nil {
+		return errno.NotFoundErr.WithMessage("文件未上传")
+	}
+	if objInfo.Size > o.MaxFileSize {
+		return errno.ParamErr.WithMessage(fmt.Sprintf("上传文件大小不能超过 %d MB", o.MaxFileSize/1024/1024))
+	}
+	return validateFileName(o.Key)
+}
+
+func (o *objectUploader) GetOriginFileName() (string, error) {
+	return path.Base(o.Key), nil
+}
+
+func (o *objectUploader) ProcessFile(tempFile *os.File) error {
+	body, err := o.Storage.GetObj(o.Ctx, o.Key)
+	if err != nil {
+		return errno.NotFoundErr.WithMessage("文件未上传")
+	}
+	defer body.Close()
+	// 多读一个字节用于判断是否超出限制
+	n, err := io.Copy(tempFile, io.LimitReader(body, o.MaxFileSize+1))
+	if err != nil {
+		hlog.Errorf("cos_client - objectUploader: download object failed, %s\n", err)
+		return errno.OperationErr
+	}
+	if n > o.MaxFileSize {
+		return errno.ParamErr.WithMessage(fmt.Sprintf("上传文件大小不能超过 %d MB", o.MaxFileSize/1024/1024))
+	}
+	if _, err = tempFile.Seek(0, io.SeekStart); err != nil {
+		hlog.Errorf("cos_client - objectUploader: offset the file on the start failed, %s\n", err)
+		return errno.OperationErr
+	}
+	return nil
+}
+
+// PresignPicture - 为直传生成暂存对象 key 与限时上传地址
+// params:
+//   - ctx
+//   - dirPrefix: 暂存目录前缀, 不应对外公开
+//   - fileName: 原始文件名
+//   - size: 上传内容的长度
+//   - expire: 地址有效期
+//
+// returns:
+//   - key
+//   - uploadUrl
+//   - error: nil on success, non-nil on failure
+func PresignPicture(ctx context.Context, dirPrefix, fileName string, size int64, expire time.Duration) (string, string, error) {
+	if err := validateFileName(fileName); err != nil {
+		return "", "", err
+	}
+	key, err := GenerateKey(dirPrefix, fileName)
+	if err != nil {
+		return "", "", err
+	}
+	uploadUrl, err := NewStorage().PresignPutUrl(ctx, key, size, expire)
+	if err != nil {
+		return "", "", errno.OperationErr.WithMessage("生成上传地址失败")
+	}
+	return key, uploadUrl, nil
+}
+
+// UploadPictureByObject - 解析已直传至暂存目录的对象, 处理后写入新生成的 key, 成功后删除暂存对象
+// params:
+//   - ctx
+//   - key: 暂存对象 key
+//   - maxFileSize: 允许的最大文件大小
+//   - opt
+//
+// returns:
+//   - pictureInformation
+//   - error: nil on success, non-nil on failure
+func UploadPictureByObject(ctx context.Context, key string, maxFileSize int64, opt *UploadOption) (*File, error) {
+	storage := NewStorage()
+	uploader := &objectUploader{
+		Ctx:         ctx,
+		Storage:     storage,
+		Key:         key,
+		MaxFileSize: maxFileSize,
+	}
+	file, err := UploadPictureTemplate(ctx, uploader, opt)
+	if err != nil {
+		return nil, err
+	}
+	// 删除失败时由直传时登记的清理任务兜底
+	if err = storage.DeleteObj(ctx, key); err != nil {
+		hlog.Errorf("cos_client - UploadPictureByObject: delete staging object failed, key - %s, %s\n", key, err)
+	}
+	return file, nil
+}
@@ -0,0 +1,79 @@
+package tencentCos
+
+import (
+	"context"
+	"fmt"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"io"
+	"os"
+)
+
+type partUploader struct {
+	FileName    string
+	FileSize    int64
+	MaxFileSize int64
+	PartPaths   []string
+}
+
+func (p *partUploader) Validate() error {
+	if len(p.PartPaths) == 0 {
+		return errno.ParamErr.WithMessage("未上传文件")
+	}
+	if p.FileSize > p.MaxFileSize {
+		return errno.ParamErr.WithMessage(fmt.Sprintf("上传文件大小不能超过 %d MB", p.MaxFileSize/1024/1024))
+	}
+	return validateFileName(p.FileName)
+}
+
+func (p *partUploader) GetOriginFileName() (string, error) {
+	return p.FileName, nil
+}
+
+func (p *partUploader) ProcessFile(tempFile *os.File) error {
+	var total int64
+	for _, partPath := range p.PartPaths {
+		part, err := os.Open(partPath)
+		if err != nil {
+			hlog.Errorf("cos_client - partUploader: open part failed, %s\n", err)
+			return errno.OperationErr
+		}
+		n, err := io.Copy(tempFile, part)
+		part.Close()
+		if err != nil {
+			hlog.Errorf("cos_client - partUploader: copy part failed, %s\n", err)
+			return errno.OperationErr
+		}
+		total += n
+	}
+	if total != p.FileSize {
+		return errno.ParamErr.WithMessage("分片合并后的文件大小不一致")
+	}
+	if _, err := tempFile.Seek(0, io.SeekStart); err != nil {
+		hlog.Errorf("cos_client - partUploader: offset the file on the start failed, %s\n", err)
+		return errno.OperationErr
+	}
+	return nil
+}
+
+// UploadPictureByParts - 合并分片后上传文件
+// params:
+//   - ctx
+//   - fileName: 原始文件名
+//   - fileSize: 合并后的文件大小
+//   - maxFileSize: 允许的最大文件大小
+//   - partPaths: 按顺序排列的分片路径
+//   - opt
+//
+// returns:
+//   - pictureInformation
+//   - error: nil on success, non-nil on failure
+func UploadPictureByParts(ctx context.Context, fileName string, fileSize, maxFileSize int64, partPaths []string, opt *UploadOption) (*File, error) {
+	uploader := &partUploader{
+		FileName:    fileName,
+		FileSize:    fileSize,
+		MaxFileSize: maxFileSize,
+		PartPaths:   partPaths,
+	}
+	return UploadPictureTemplate(ctx, uploader, opt)
+}
@@ -0,0 +1,78 @@
+package tencentCos
+
+import (
+	"fmt"
+	"github.com/Alf-Grindel/clide/internal/pkg/image_util"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// pictureExt - 支持的图片格式及其在存储中的后缀
+var pictureExt = map[string]string{
+	"jpeg": "jpg",
+	"png":  "png",
+	"svg":  "svg",
+	"webp": "webp",
+	"gif":  "gif",
+	"avif": "avif",
+	"heic": "heic",
+}
+
+// typeDeclarer - 可声明文件类型的上传方式, 未声明时以文件名后缀为准
+type typeDeclarer interface {
+	DeclaredType() string
+}
+
+// normalizeType - 统一文件后缀与 Content-Type 的写法, 如 jpg -> jpeg, image/svg+xml -> svg, heif -> heic
+func normalizeType(t string) string {
+	t = strings.ToLower(strings.TrimSpace(t))
+	if i := strings.IndexByte(t, ';'); i >= 0 {
+		t = strings.TrimSpace(t[:i])
+	}
+	t = strings.TrimPrefix(t, ".")
+	t = strings.TrimPrefix(t, "image/")
+	t = strings.TrimSuffix(t, "+xml")
+	switch t {
+	case "jpg", "pjpeg":
+		return "jpeg"
+	case "heif", "heic-sequence", "heif-sequence":
+		return "heic"
+	case "avif-sequence":
+		return "avif"
+	}
+	return t
+}
+
+// sniffFile - 按文件头识别图片类型, 并校验与声明的类型一致
+// params:
+//   - tempFile: 已写入内容的临时文件
+//   - uploader
+//   - originFileName: 原始文件名, 后缀不是图片类型时不校验
+//
+// returns:
+//   - format: 实际图片格式
+//   - error: nil on success, non-nil on failure
+func sniffFile(tempFile *os.File, uploader Uploader, originFileName string) (string, error) {
+	format, err := image_util.Sniff(tempFile)
+	if err != nil {
+		hlog.Infof("cos_client - sniffFile: unknown file type, %s\n", err)
+		return "", errno.ParamErr.WithMessage("无法识别的文件类型")
+	}
+	if _, ok := pictureExt[format]; !ok {
+		return "", errno.ParamErr.WithMessage(fmt.Sprintf("不支持的图片类型 %s", format))
+	}
+	declared := ""
+	if d, ok := uploader.(typeDeclarer); ok {
+		declared = normalizeType(d.DeclaredType())
+	}
+	if declared == "" {
+		declared = normalizeType(filepath.Ext(originFileName))
+	}
+	if _, ok := pictureType[declared]; ok && declared != format {
+		return "", errno.ParamErr.WithMessage(fmt.Sprintf("文件内容与声明的类型不符, 实际为 %s", format))
+	}
+	return format, nil
+}
@@ -0,0 +1,101 @@
+package tencentCos
+
+import (
+	"os"
+	"testing"
+)
+
+var (
+	pngHead  = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+	jpegHead = "\xFF\xD8\xFF\xE0\x00\x10JFIF\x00"
+	avifHead = "\x00\x00\x00\x1cftypavif\x00\x00\x00\x00avifmif1miaf"
+	heicHead = "\x00\x00\x00\x18ftypheic\x00\x00\x00\x00mif1heic"
+)
+
+func TestSniffFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		fileName string
+		// 不为空时以 Content-Type 声明类型
+		contentType string
+		want        string
+		wantErr     bool
+	}{
+		{name: "jpeg", data: jpegHead, fileName: "a.jpg", want: "jpeg"},
+		{name: "jpeg with jpeg ext", data: jpegHead, fileName: "a.JPEG", want: "jpeg"},
+		{name: "png", data: pngHead, fileName: "a.png", want: "png"},
+		{name: "gif", data: "GIF89a\x01\x00\x01\x00", fileName: "a.gif", want: "gif"},
+		{name: "webp", data: "RIFF\x24\x00\x00\x00WEBPVP8 ", fileName: "a.webp", want: "webp"},
+		{name: "avif", data: avifHead, fileName: "a.avif", want: "avif"},
+		{name: "heic", data: heicHead, fileName: "a.heif", want: "heic"},
+		{name: "svg", data: `<?xml version="1.0"?><!-- c --><svg xmlns="http://www.w3.org/2000/svg"/>`, fileName: "a.svg", want: "svg"},
+		{name: "unknown ext is not checked", data: pngHead, fileName: "a.bin", want: "png"},
+		{name: "png renamed jpg", data: pngHead, fileName: "a.jpg", wantErr: true},
+		{name: "jpeg renamed png", data: jpegHead, fileName: "a.png", wantErr: true},
+		{name: "avif renamed heic", data: avifHead, fileName: "a.heic", wantErr: true},
+		{name: "svg renamed png", data: `<svg onload="alert(1)"/>`, fileName: "a.png", wantErr: true},
+		{name: "html renamed png", data: "<html><script>alert(1)</script></html>", fileName: "a.png", wantErr: true},
+		{name: "html with svg inside", data: "<!DOCTYPE html><html><svg/></html>", fileName: "a.svg", wantErr: true},
+		{name: "svg prefix element", data: "<svgx/>", fileName: "a.svg", wantErr: true},
+		{name: "bmp", data: "BM\x00\x00\x00\x00", fileName: "a.bmp", wantErr: true},
+		{name: "empty", data: "", fileName: "a.png", wantErr: true},
+		{name: "truncated png", data: "\x89PN", fileName: "a.png", wantErr: true},
+		{name: "truncated jpeg", data: "\xFF\xD8", fileName: "a.jpg", wantErr: true},
+		{name: "truncated gif", data: "GIF8", fileName: "a.gif", wantErr: true},
+		{name: "truncated webp", data: "RIFF\x24\x00\x00\x00WEB", fileName: "a.webp", wantErr: true},
+		{name: "truncated ftyp", data: avifHead[:14], fileName: "a.avif", wantErr: true},
+		{name: "ftyp size out of range", data: "\x00\x00\xff\xf0ftypavif\x00\x00\x00\x00", fileName: "a.avif", wantErr: true},
+		{name: "unclosed xml declaration", data: `<?xml version="1.0"`, fileName: "a.svg", wantErr: true},
+		{name: "truncated svg", data: "<svg", fileName: "a.svg", wantErr: true},
+		// 声明的 Content-Type 优先于地址后缀
+		{name: "content type matches", data: pngHead, fileName: "a.jpg", contentType: "image/png", want: "png"},
+		{name: "content type mismatches", data: pngHead, fileName: "a.png", contentType: "image/jpeg; charset=binary", wantErr: true},
+		{name: "svg content type", data: "<svg/>", fileName: "a", contentType: "image/svg+xml", want: "svg"},
+		{name: "svg declared as png", data: "<svg/>", fileName: "a.svg", contentType: "image/png", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempFile, err := os.CreateTemp(t.TempDir(), "sniff")
+			if err != nil {
+				t.Fatalf("CreateTemp() error = %v", err)
+			}
+			defer tempFile.Close()
+			if _, err = tempFile.WriteString(tt.data); err != nil {
+				t.Fatalf("WriteString() error = %v", err)
+			}
+			var uploader Uploader = &archiveUploader{Entry: &ArchiveEntry{Name: tt.fileName}}
+			if tt.contentType != "" {
+				uploader = &urlUpload{FileUrl: "https://example.com/" + tt.fileName, ContentType: tt.contentType}
+			}
+			got, err := sniffFile(tempFile, uploader, tt.fileName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sniffFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("sniffFile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeType(t *testing.T) {
+	tests := map[string]string{
+		"jpg":                      "jpeg",
+		".JPG":                     "jpeg",
+		"image/pjpeg":              "jpeg",
+		"image/jpeg; charset=utf8": "jpeg",
+		"image/svg+xml":            "svg",
+		" image/PNG ":              "png",
+		".heif":                    "heic",
+		"image/heif-sequence":      "heic",
+		"image/avif-sequence":      "avif",
+		"text/html":                "text/html",
+		"":                         "",
+	}
+	for input, want := range tests {
+		if got := normalizeType(input); got != want {
+			t.Errorf("normalizeType(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
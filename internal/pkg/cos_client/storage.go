@@ -0,0 +1,73 @@
+package tencentCos
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/tencentyun/cos-go-sdk-v5"
+	"io"
+	"strings"
+	"time"
+)
+
+type ObjInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+}
+
+// Storage - 对象存储驱动
+type Storage interface {
+	PutObj(ctx context.Context, key string, body io.Reader) error
+	GetObj(ctx context.Context, key string) (io.ReadCloser, error)
+	DeleteObj(ctx context.Context, key string) error
+	StatObj(ctx context.Context, key string) (*ObjInfo, error)
+	PublicUrl(key string) string
+	// PresignPutUrl - 生成可直接上传对象的限时地址, 上传内容的长度需与 size 一致
+	PresignPutUrl(ctx context.Context, key string, size int64, expire time.Duration) (string, error)
+	// PresignGetUrl - 生成可访问私有对象的限时地址
+	PresignGetUrl(ctx context.Context, key string, expire time.Duration) (string, error)
+}
+
+// pictureStorage - 支持上传时解析图片信息的存储驱动 (数据万象)
+type pictureStorage interface {
+	PutPictureObj(ctx context.Context, key string, body io.Reader) (*cos.ImageProcessResult, error)
+}
+
+// NewStorage - 根据配置选择存储驱动
+func NewStorage() Storage {
+	switch config.Storage.Driver {
+	case constants.StorageDriverLocal:
+		return NewLocalClient()
+	default:
+		return NewTencentClient()
+	}
+}
+
+// IsPrivateKey - 对象是否需要签名访问, 开启水印时原图不对外公开
+func IsPrivateKey(key string) bool {
+	return config.Storage.Private || config.Watermark.Enabled || !strings.HasPrefix(key, constants.PublicPrefix)
+}
+
+// AccessUrl - 获取对象的访问地址, 私有对象返回限时签名地址
+// params:
+//   - key: 对象 key, 为空时视为历史数据直接返回 url
+//   - url: 对象的公开地址
+//
+// returns:
+//   - accessUrl: 签名失败时为空
+func AccessUrl(key, url string) string {
+	if key == "" || !IsPrivateKey(key) {
+		return url
+	}
+	expire := config.Storage.SignExpire
+	if expire <= 0 {
+		expire = constants.DefaultSignExpire
+	}
+	signedUrl, err := NewStorage().PresignGetUrl(context.Background(), key, expire)
+	if err != nil {
+		return ""
+	}
+	return signedUrl
+}
@@ -0,0 +1,48 @@
+package tencentCos
+
+import (
+	"bytes"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/pkg/image_util"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"io"
+	"os"
+)
+
+// processSvg - 按配置清理 svg 中的脚本与外部引用, 或渲染为 png
+// params:
+//   - tempFile: 已写入内容的 svg 临时文件
+//
+// returns:
+//   - format: 处理后的图片格式
+//   - error: nil on success, non-nil on failure
+func processSvg(tempFile *os.File) (string, error) {
+	format := "svg"
+	if _, err := tempFile.Seek(0, io.SeekStart); err != nil {
+		hlog.Errorf("cos_client - processSvg: offset the file on the start failed, %s\n", err)
+		return "", errno.OperationErr
+	}
+	var output bytes.Buffer
+	if err := image_util.SanitizeSvg(tempFile, &output); err != nil {
+		return "", errno.ParamErr.WithMessage("无法解析 svg 图片")
+	}
+	if config.Upload.SvgMode == constants.SvgModeRasterize {
+		img, err := image_util.RasterizeSvg(bytes.NewReader(output.Bytes()), constants.SvgRasterMaxSize)
+		if err != nil {
+			return "", errno.ParamErr.WithMessage("无法渲染 svg 图片")
+		}
+		output.Reset()
+		if err = image_util.Encode(&output, img, image_util.FormatPng, 0); err != nil {
+			hlog.Errorf("cos_client - processSvg: encode png failed, %s\n", err)
+			return "", errno.OperationErr
+		}
+		format = "png"
+	}
+	if err := rewriteFile(tempFile, output.Bytes()); err != nil {
+		hlog.Errorf("cos_client - processSvg: rewrite temp file failed, %s\n", err)
+		return "", errno.OperationErr
+	}
+	return format, nil
+}
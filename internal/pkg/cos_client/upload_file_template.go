@@ -2,11 +2,13 @@ package tencentCos
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
-	"github.com/Alf-Grindel/clide/config"
 	"github.com/Alf-Grindel/clide/pkg/errno"
 	"github.com/Alf-Grindel/clide/pkg/utils"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -15,6 +17,7 @@ import (
 )
 
 type File struct {
+	Key       string
 	Url       string
 	PicName   string
 	PicSize   int64
@@ -22,6 +25,25 @@ type File struct {
 	PicHeight int32
 	PicScale  float64
 	PicFormat string
+	PicHash   string
+	// 感知哈希, 0 为合法值, 无法解码为位图时为 nil
+	PicPhash *uint64
+	// 主色调与调色板, #rrggbb 格式
+	PicColor   string
+	PicPalette []string
+	// 拍摄设备与时间, 定位信息不会被保存
+	ExifMake        string
+	ExifModel       string
+	ExifTakenAt     time.Time
+	ExifOrientation int
+	// 缩略图与压缩后的 webp, 无法解码的图片 (如 svg) 为空
+	ThumbnailKey  string
+	ThumbnailUrl  string
+	CompressedKey string
+	CompressedUrl string
+	// 动图帧数与播放时长, 静态图片帧数为 1
+	PicFrames   int
+	PicDuration time.Duration
 }
 
 type Uploader interface {
@@ -30,16 +52,25 @@ type Uploader interface {
 	ProcessFile(tempFile *os.File) error
 }
 
+type UploadOption struct {
+	// 上传图片目录前缀
+	DirPrefix string
+	// 是否保留图片中的定位信息
+	KeepGps bool
+	// 图片解析完成、写入存储前的回调, 返回错误时终止上传
+	BeforeStore func(file *File) error
+}
+
 // UploadPictureTemplate - 上传图片模版
 // params:
 //   - ctx
 //   - uploader
-//   - dirPrefix: 上传图片目录前缀
+//   - opt: 上传选项
 //
 // returns:
-//   - pictureInformation: 上传后从数据万象中读取的图片信息
+//   - pictureInformation: 本地解析的图片信息, 解析失败时使用数据万象返回的信息
 //   - error: nil on success, non-nil on failure
-func UploadPictureTemplate(ctx context.Context, uploader Uploader, dirPrefix string) (*File, error) {
+func UploadPictureTemplate(ctx context.Context, uploader Uploader, opt *UploadOption) (*File, error) {
 	// 校验图片
 	if err := uploader.Validate(); err != nil {
 		return nil, err
@@ -49,56 +80,130 @@ func UploadPictureTemplate(ctx context.Context, uploader Uploader, dirPrefix str
 	if err != nil {
 		return nil, err
 	}
-	fileNameType := strings.TrimPrefix(filepath.Ext(originFileName), ".")
-	if _, ok := pictureType[fileNameType]; !ok {
-		fileNameType = "png"
-	}
-	uuidVal, err := utils.GenerateId()
-	if err != nil {
-		hlog.Errorf("cos_client - validFileFormat: build uuid failed, %s\n", err)
-		return nil, errno.OperationErr
-	}
-	uuid := strconv.FormatInt(uuidVal, 10)
-	day := time.Now().Format(time.DateOnly)
-	fileName := fmt.Sprintf("%s_%s.%s", day, uuid, fileNameType)
-	fileDir := fmt.Sprintf("%s/%s", dirPrefix, fileName)
 	// 生成临时文件
 	tempFile, err := os.CreateTemp("", "downloaded-*")
 	if err != nil {
 		hlog.Errorf("cos_client - uploadPictureTemplate: create temp file failed, %s\n", err)
 		return nil, errno.OperationErr
 	}
+	// 清理临时文件
+	defer func() {
+		tempFile.Close()
+		os.Remove(tempFile.Name())
+	}()
 
 	// 处理文件
 	err = uploader.ProcessFile(tempFile)
 	if err != nil {
 		return nil, err
 	}
+	// 按文件内容识别类型, 后缀由实际类型决定
+	format, err := sniffFile(tempFile, uploader, originFileName)
+	if err != nil {
+		return nil, err
+	}
+	// svg 写入存储前清理或渲染为位图, heic 按需转为 jpeg
+	format, err = convertPicture(tempFile, format)
+	if err != nil {
+		return nil, err
+	}
+	// 生成图片在存储中的 key, 后缀由处理后的类型决定
+	fileDir, err := generateKey(opt.DirPrefix, pictureExt[format])
+	if err != nil {
+		return nil, err
+	}
+	file := &File{}
+	if err = processExif(tempFile, file, format, opt.KeepGps); err != nil {
+		return nil, err
+	}
 	fileInfo, err := tempFile.Stat()
 	if err != nil {
 		hlog.Errorf("cos_client - uploadPictureTemplate: get temp file stat failed, %s\n", err)
 		return nil, errno.OperationErr
 	}
+	hash, err := hashFile(tempFile)
+	if err != nil {
+		hlog.Errorf("cos_client - uploadPictureTemplate: hash temp file failed, %s\n", err)
+		return nil, errno.OperationErr
+	}
 	// 连接对象存储
-	client := NewTencentClient()
-	// 上传图片至对象存储
-	imageProcessResult, err := client.PutPictureObj(ctx, fileDir, tempFile)
+	storage := NewStorage()
+	file.Key = fileDir
+	file.Url = storage.PublicUrl(fileDir)
+	file.PicName = strings.TrimSuffix(originFileName, filepath.Ext(originFileName))
+	file.PicSize = fileInfo.Size()
+	file.PicHash = hash
+	// 本地解析图片信息
+	img, err := analyzePicture(tempFile, file)
 	if err != nil {
-		hlog.Errorf("coa_client - UploadPicture: upload picture to cos failed, %s\n", err)
+		return nil, err
+	}
+	if opt.BeforeStore != nil {
+		if err = opt.BeforeStore(file); err != nil {
+			return nil, err
+		}
+	}
+	if _, err = tempFile.Seek(0, io.SeekStart); err != nil {
+		hlog.Errorf("cos_client - uploadPictureTemplate: offset the file on the start failed, %s\n", err)
+		return nil, errno.OperationErr
+	}
+	// 上传图片至对象存储, 数据万象可同时返回图片信息
+	if picStorage, ok := storage.(pictureStorage); ok {
+		imageProcessResult, err := picStorage.PutPictureObj(ctx, fileDir, tempFile)
+		if err != nil {
+			hlog.Errorf("coa_client - UploadPicture: upload picture to cos failed, %s\n", err)
+			return nil, errno.OperationErr.WithMessage("上传图片失败")
+		}
+		// 本地未能解析时使用数据万象返回的图片信息
+		if file.PicWidth == 0 && imageProcessResult.OriginalInfo != nil && imageProcessResult.OriginalInfo.ImageInfo != nil {
+			pictureInfo := imageProcessResult.OriginalInfo.ImageInfo
+			file.fillImageInfo(pictureInfo.Width, pictureInfo.Height, pictureInfo.Format)
+		}
+	} else if err = storage.PutObj(ctx, fileDir, tempFile); err != nil {
+		hlog.Errorf("coa_client - UploadPicture: upload picture to storage failed, %s\n", err)
 		return nil, errno.OperationErr.WithMessage("上传图片失败")
 	}
-	// 清理临时文件
-	tempFile.Close()
-	os.Remove(tempFile.Name())
-	// 封装解析到的图片信息
-	pictureInfo := imageProcessResult.OriginalInfo.ImageInfo
-	return &File{
-		Url:       config.Cos.Client.Host + "/" + fileDir,
-		PicName:   strings.TrimSuffix(originFileName, filepath.Ext(originFileName)),
-		PicSize:   fileInfo.Size(),
-		PicWidth:  int32(pictureInfo.Width),
-		PicHeight: int32(pictureInfo.Height),
-		PicScale:  float64(pictureInfo.Width) / float64(pictureInfo.Height),
-		PicFormat: pictureInfo.Format,
-	}, nil
+	storeVariants(ctx, storage, img, file)
+	return file, nil
+}
+
+// GenerateKey - 生成图片在存储中的 key, 格式为 前缀/日期_id.后缀
+// params:
+//   - dirPrefix: 目录前缀
+//   - originFileName: 原始文件名, 后缀不支持时使用 png
+//
+// returns:
+//   - key
+//   - error: nil on success, non-nil on failure
+func GenerateKey(dirPrefix, originFileName string) (string, error) {
+	fileNameType := strings.ToLower(strings.TrimPrefix(filepath.Ext(originFileName), "."))
+	if _, ok := pictureType[fileNameType]; !ok {
+		fileNameType = "png"
+	}
+	return generateKey(dirPrefix, fileNameType)
+}
+
+// generateKey - 按指定后缀生成图片在存储中的 key
+func generateKey(dirPrefix, fileNameType string) (string, error) {
+	uuidVal, err := utils.GenerateId()
+	if err != nil {
+		hlog.Errorf("cos_client - GenerateKey: build uuid failed, %s\n", err)
+		return "", errno.OperationErr
+	}
+	uuid := strconv.FormatInt(uuidVal, 10)
+	day := time.Now().Format(time.DateOnly)
+	fileName := fmt.Sprintf("%s_%s.%s", day, uuid, fileNameType)
+	return fmt.Sprintf("%s/%s", dirPrefix, fileName), nil
+}
+
+// hashFile - 计算文件内容的 SHA-256
+func hashFile(file *os.File) (string, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
@@ -0,0 +1,75 @@
+package tencentCos
+
+import (
+	"bytes"
+	"context"
+	"github.com/Alf-Grindel/clide/internal/pkg/image_util"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"image"
+	"path"
+	"strings"
+)
+
+// storeVariants - 生成缩略图与压缩图并存放在原图旁, 失败时仅记录日志
+// params:
+//   - ctx
+//   - storage
+//   - img: 原图位图, 为 nil 时跳过
+//   - file: 待填充的图片信息
+//
+// returns:
+func storeVariants(ctx context.Context, storage Storage, img image.Image, file *File) {
+	if img == nil {
+		return
+	}
+	base := strings.TrimSuffix(file.Key, path.Ext(file.Key))
+	// 不透明的图片缩略图使用 jpeg, 体积更小
+	thumbnailFormat := image_util.FormatWebp
+	if opaque, ok := img.(interface{ Opaque() bool }); ok && opaque.Opaque() {
+		thumbnailFormat = image_util.FormatJpeg
+	}
+	thumbnailKey := base + "_thumbnail." + thumbnailFormat
+	if storeVariant(ctx, storage, img, thumbnailKey, constants.ThumbnailSize, thumbnailFormat) {
+		file.ThumbnailKey = thumbnailKey
+		file.ThumbnailUrl = storage.PublicUrl(thumbnailKey)
+	}
+	compressedKey := base + "_compressed." + image_util.FormatWebp
+	if storeVariant(ctx, storage, img, compressedKey, constants.CompressedSize, image_util.FormatWebp) {
+		file.CompressedKey = compressedKey
+		file.CompressedUrl = storage.PublicUrl(compressedKey)
+	}
+}
+
+// storeVariant - 将图片缩放至最长边不超过 size 后编码上传
+func storeVariant(ctx context.Context, storage Storage, img image.Image, key string, size int, format string) bool {
+	opt := &image_util.TransformOption{Fit: image_util.FitInside}
+	if img.Bounds().Dx() >= img.Bounds().Dy() {
+		opt.Width = size
+	} else {
+		opt.Height = size
+	}
+	var buf bytes.Buffer
+	if err := image_util.Encode(&buf, image_util.Transform(img, opt), format, constants.ImageQuality); err != nil {
+		hlog.Errorf("cos_client - storeVariant: encode variant failed, key - %s, %s\n", key, err)
+		return false
+	}
+	if err := storage.PutObj(ctx, key, &buf); err != nil {
+		hlog.Errorf("cos_client - storeVariant: upload variant failed, key - %s, %s\n", key, err)
+		return false
+	}
+	return true
+}
+
+// ObjectUrl - 获取对象的访问地址, 私有对象返回限时签名地址
+// params:
+//   - key: 对象 key
+//
+// returns:
+//   - url: key 为空时为空
+func ObjectUrl(key string) string {
+	if key == "" {
+		return ""
+	}
+	return AccessUrl(key, NewStorage().PublicUrl(key))
+}
@@ -0,0 +1,208 @@
+package http_util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrUnsupportedScheme = errors.New("http_util: unsupported url scheme")
+	ErrBlockedDomain     = errors.New("http_util: domain is not allowed")
+	ErrBlockedAddress    = errors.New("http_util: address is not allowed")
+	ErrTooManyRedirects  = errors.New("http_util: too many redirects")
+	ErrTooLarge          = errors.New("http_util: response body too large")
+)
+
+// blockedPrefixes - 除私有、回环、链路本地等地址外, 额外禁止访问的网段
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("2001::/32"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+type FetchOption struct {
+	// 总超时时间, 包含重定向与读取响应体
+	Timeout time.Duration
+	// 最大重定向次数, 为 0 时不跟随重定向
+	MaxRedirects int
+	// 响应体大小上限, 为 0 时不限制
+	MaxSize int64
+	// 允许访问的域名, 为空时不限制; 域名同时匹配其子域名
+	AllowDomains []string
+	// 禁止访问的域名, 优先于 AllowDomains
+	DenyDomains []string
+}
+
+// NewClient - 创建禁止访问内网地址的 http 客户端
+// params:
+//   - opt: 超时时间, 重定向次数与域名限制
+//
+// returns:
+//   - client
+func NewClient(opt *FetchOption) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: opt.Timeout,
+		// 在域名解析完成后、建立连接前校验实际地址, 避免 DNS 重绑定绕过
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || IsBlockedAddr(addrPort.Addr()) {
+				return ErrBlockedAddress
+			}
+			return nil
+		},
+	}
+	transport := &http.Transport{
+		// 不使用代理, 否则无法校验目标地址
+		Proxy:                 nil,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		TLSHandshakeTimeout:   opt.Timeout,
+		ResponseHeaderTimeout: opt.Timeout,
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   opt.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > opt.MaxRedirects {
+				return ErrTooManyRedirects
+			}
+			return CheckUrl(req.URL, opt)
+		},
+	}
+}
+
+// CheckUrl - 校验地址协议与域名限制
+// params:
+//   - u
+//   - opt
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func CheckUrl(u *url.URL, opt *FetchOption) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ErrUnsupportedScheme
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		return ErrBlockedDomain
+	}
+	// 直接使用 ip 的地址提前拒绝, 连接时仍会再次校验
+	if addr, err := netip.ParseAddr(host); err == nil && IsBlockedAddr(addr) {
+		return ErrBlockedAddress
+	}
+	if matchDomain(host, opt.DenyDomains) {
+		return ErrBlockedDomain
+	}
+	if len(opt.AllowDomains) > 0 && !matchDomain(host, opt.AllowDomains) {
+		return ErrBlockedDomain
+	}
+	return nil
+}
+
+// IsBlockedAddr - 判断地址是否为私有、回环、链路本地等非公网地址
+func IsBlockedAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLoopback() ||
+		addr.IsLinkLocalUnicast() || addr.IsUnspecified() {
+		return true
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchDomain(host string, domains []string) bool {
+	for _, domain := range domains {
+		domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), ".")
+		if domain == "" {
+			continue
+		}
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// Fetch - 发起请求, 请求及重定向均经过地址与域名校验
+// params:
+//   - ctx
+//   - method
+//   - rawUrl
+//   - opt
+//
+// returns:
+//   - resp: 调用方负责关闭响应体
+//   - error: nil on success, non-nil on failure
+func Fetch(ctx context.Context, method, rawUrl string, opt *FetchOption) (*http.Response, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err = CheckUrl(u, opt); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(opt).Do(req)
+}
+
+// Download - 下载地址内容并写入 w, 超出大小上限时中止
+// params:
+//   - ctx
+//   - w
+//   - rawUrl
+//   - opt
+//
+// returns:
+//   - n: 写入字节数
+//   - error: nil on success, non-nil on failure
+func Download(ctx context.Context, w io.Writer, rawUrl string, opt *FetchOption) (int64, error) {
+	resp, err := Fetch(ctx, http.MethodGet, rawUrl, opt)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("http_util: unexpected status %d", resp.StatusCode)
+	}
+	return copyBody(w, resp, opt.MaxSize)
+}
+
+// copyBody - 将响应体写入 w, 声明或实际长度超出 maxSize 时返回 ErrTooLarge
+func copyBody(w io.Writer, resp *http.Response, maxSize int64) (int64, error) {
+	if maxSize <= 0 {
+		return io.Copy(w, resp.Body)
+	}
+	if resp.ContentLength > maxSize {
+		return 0, ErrTooLarge
+	}
+	// 多读取一个字节判断是否超出上限
+	n, err := io.Copy(w, io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return n, err
+	}
+	if n > maxSize {
+		return n, ErrTooLarge
+	}
+	return n, nil
+}
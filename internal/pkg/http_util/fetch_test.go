@@ -0,0 +1,172 @@
+package http_util
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsBlockedAddr(t *testing.T) {
+	tests := []struct {
+		addr    string
+		blocked bool
+	}{
+		{addr: "127.0.0.1", blocked: true},
+		{addr: "127.1.2.3", blocked: true},
+		{addr: "::1", blocked: true},
+		{addr: "::ffff:127.0.0.1", blocked: true},
+		{addr: "169.254.169.254", blocked: true},
+		{addr: "fe80::1", blocked: true},
+		{addr: "10.0.0.1", blocked: true},
+		{addr: "10.255.255.255", blocked: true},
+		{addr: "172.16.0.1", blocked: true},
+		{addr: "192.168.1.1", blocked: true},
+		{addr: "fd00::1", blocked: true},
+		{addr: "100.64.0.1", blocked: true},
+		{addr: "0.0.0.0", blocked: true},
+		{addr: "::", blocked: true},
+		{addr: "224.0.0.1", blocked: true},
+		{addr: "255.255.255.255", blocked: true},
+		{addr: "64:ff9b::a00:1", blocked: true},
+		{addr: "8.8.8.8", blocked: false},
+		{addr: "2606:4700:4700::1111", blocked: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			if got := IsBlockedAddr(netip.MustParseAddr(tt.addr)); got != tt.blocked {
+				t.Errorf("IsBlockedAddr(%s) = %v, want %v", tt.addr, got, tt.blocked)
+			}
+		})
+	}
+}
+
+func TestCheckUrl(t *testing.T) {
+	opt := &FetchOption{
+		AllowDomains: []string{"example.com", ".example.org"},
+		DenyDomains:  []string{"private.example.com"},
+	}
+	tests := []struct {
+		rawUrl string
+		want   error
+	}{
+		{rawUrl: "https://example.com/a.png", want: nil},
+		{rawUrl: "https://img.example.com/a.png", want: nil},
+		{rawUrl: "http://example.org./a.png", want: nil},
+		{rawUrl: "ftp://example.com/a.png", want: ErrUnsupportedScheme},
+		{rawUrl: "file:///etc/passwd", want: ErrUnsupportedScheme},
+		{rawUrl: "http://127.0.0.1/a.png", want: ErrBlockedAddress},
+		{rawUrl: "http://[::1]:8080/a.png", want: ErrBlockedAddress},
+		{rawUrl: "http://169.254.169.254/latest/meta-data/", want: ErrBlockedAddress},
+		{rawUrl: "http://10.1.2.3/a.png", want: ErrBlockedAddress},
+		{rawUrl: "https://private.example.com/a.png", want: ErrBlockedDomain},
+		{rawUrl: "https://a.private.example.com/a.png", want: ErrBlockedDomain},
+		{rawUrl: "https://notexample.com/a.png", want: ErrBlockedDomain},
+		{rawUrl: "https:///a.png", want: ErrBlockedDomain},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rawUrl, func(t *testing.T) {
+			u, err := url.Parse(tt.rawUrl)
+			if err != nil {
+				t.Fatalf("url.Parse() error = %v", err)
+			}
+			if err = CheckUrl(u, opt); !errors.Is(err, tt.want) {
+				t.Errorf("CheckUrl() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// TestFetchBlockedResolvedAddress - 域名通过校验但解析为内网地址时, 在建立连接前拒绝
+func TestFetchBlockedResolvedAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+	rawUrl := "http://localhost:" + u.Port()
+	_, err = Fetch(context.Background(), http.MethodGet, rawUrl, &FetchOption{Timeout: time.Second})
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Errorf("Fetch() error = %v, want %v", err, ErrBlockedAddress)
+	}
+}
+
+func TestCheckRedirect(t *testing.T) {
+	via := func(n int) []*http.Request {
+		reqs := make([]*http.Request, n)
+		for i := range reqs {
+			reqs[i] = httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+		}
+		return reqs
+	}
+	tests := []struct {
+		name         string
+		maxRedirects int
+		target       string
+		via          int
+		want         error
+	}{
+		{name: "allowed redirect", maxRedirects: 2, target: "https://example.com/b.png", via: 1, want: nil},
+		{name: "last allowed redirect", maxRedirects: 2, target: "https://example.com/b.png", via: 2, want: nil},
+		{name: "too many redirects", maxRedirects: 2, target: "https://example.com/b.png", via: 3, want: ErrTooManyRedirects},
+		{name: "redirect disabled", maxRedirects: 0, target: "https://example.com/b.png", via: 1, want: ErrTooManyRedirects},
+		{name: "redirect to loopback", maxRedirects: 2, target: "http://127.0.0.1/", via: 1, want: ErrBlockedAddress},
+		{name: "redirect to metadata", maxRedirects: 2, target: "http://169.254.169.254/latest/meta-data/", via: 1, want: ErrBlockedAddress},
+		{name: "redirect to private network", maxRedirects: 2, target: "http://10.0.0.1/", via: 1, want: ErrBlockedAddress},
+		{name: "redirect to unsupported scheme", maxRedirects: 2, target: "gopher://example.com/", via: 1, want: ErrUnsupportedScheme},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient(&FetchOption{Timeout: time.Second, MaxRedirects: tt.maxRedirects})
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if err := client.CheckRedirect(req, via(tt.via)); !errors.Is(err, tt.want) {
+				t.Errorf("CheckRedirect() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopyBody(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		contentLength int64
+		maxSize       int64
+		want          error
+	}{
+		{name: "within limit", body: "12345", contentLength: 5, maxSize: 5, want: nil},
+		{name: "no limit", body: "1234567890", contentLength: -1, maxSize: 0, want: nil},
+		{name: "declared length too large", body: "123456", contentLength: 6, maxSize: 5, want: ErrTooLarge},
+		{name: "unknown length too large", body: "123456", contentLength: -1, maxSize: 5, want: ErrTooLarge},
+		{name: "understated length too large", body: "123456", contentLength: 3, maxSize: 5, want: ErrTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{
+				ContentLength: tt.contentLength,
+				Body:          io.NopCloser(strings.NewReader(tt.body)),
+			}
+			var buf bytes.Buffer
+			n, err := copyBody(&buf, resp, tt.maxSize)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("copyBody() error = %v, want %v", err, tt.want)
+			}
+			if tt.want == nil && (n != int64(len(tt.body)) || buf.String() != tt.body) {
+				t.Errorf("copyBody() = %d %q, want %d %q", n, buf.String(), len(tt.body), tt.body)
+			}
+			if tt.maxSize > 0 && int64(buf.Len()) > tt.maxSize+1 {
+				t.Errorf("copyBody() read %d bytes, limit %d", buf.Len(), tt.maxSize)
+			}
+		})
+	}
+}
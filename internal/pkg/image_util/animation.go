@@ -0,0 +1,267 @@
+package image_util
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	ErrInvalidGif     = errors.New("image_util: invalid gif")
+	ErrTooManyFrames  = errors.New("image_util: too many frames")
+	ErrInvalidIsobmff = errors.New("image_util: invalid isobmff box")
+)
+
+// MaxFrames - 动图允许的最大帧数
+const MaxFrames = 1000
+
+type Animation struct {
+	// 帧数, 静态图片为 1
+	Frames int
+	// 播放一次的总时长
+	Duration time.Duration
+}
+
+// DecodeAnimation - 读取 gif 与 avif 图像序列的帧数与时长, 不解码像素
+// params:
+//   - r: 图片内容, 从头开始读取
+//   - format: gif | avif, 其余格式视为静态图片
+//
+// returns:
+//   - animation
+//   - error: 帧数超过 MaxFrames 时返回 ErrTooManyFrames
+func DecodeAnimation(r io.ReadSeeker, format string) (*Animation, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	var animation *Animation
+	var err error
+	switch format {
+	case "gif":
+		animation, err = gifAnimation(bufio.NewReader(r))
+	case "avif":
+		animation, err = avifAnimation(r)
+	default:
+		return &Animation{Frames: 1}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if animation.Frames > MaxFrames {
+		return nil, ErrTooManyFrames
+	}
+	return animation, nil
+}
+
+// gifAnimation - 遍历 gif 数据块统计帧数与延时, 不解码像素
+func gifAnimation(r *bufio.Reader) (*Animation, error) {
+	header := make([]byte, 13)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, ErrInvalidGif
+	}
+	if string(header[:6]) != "GIF87a" && string(header[:6]) != "GIF89a" {
+		return nil, ErrInvalidGif
+	}
+	// 全局颜色表
+	if err := skipColorTable(r, header[10]); err != nil {
+		return nil, err
+	}
+	animation := &Animation{}
+	for {
+		introducer, err := r.ReadByte()
+		// 缺少结束符时以已读取的帧为准
+		if err != nil {
+			if animation.Frames == 0 {
+				return nil, ErrInvalidGif
+			}
+			return animation, nil
+		}
+		switch introducer {
+		case 0x21:
+			label, err := r.ReadByte()
+			if err != nil {
+				return nil, ErrInvalidGif
+			}
+			// 图形控制扩展, 延时单位为 1/100 秒
+			if label == 0xF9 {
+				block := make([]byte, 6)
+				if _, err = io.ReadFull(r, block); err != nil || block[0] != 4 {
+					return nil, ErrInvalidGif
+				}
+				delay := int(block[2]) | int(block[3])<<8
+				animation.Duration += time.Duration(delay) * 10 * time.Millisecond
+				continue
+			}
+			if err = skipSubBlocks(r); err != nil {
+				return nil, err
+			}
+		case 0x2C:
+			descriptor := make([]byte, 9)
+			if _, err = io.ReadFull(r, descriptor); err != nil {
+				return nil, ErrInvalidGif
+			}
+			if err = skipColorTable(r, descriptor[8]); err != nil {
+				return nil, err
+			}
+			// LZW 最小码长
+			if _, err = r.ReadByte(); err != nil {
+				return nil, ErrInvalidGif
+			}
+			if err = skipSubBlocks(r); err != nil {
+				return nil, err
+			}
+			animation.Frames++
+		case 0x3B:
+			if animation.Frames == 0 {
+				return nil, ErrInvalidGif
+			}
+			return animation, nil
+		default:
+			return nil, ErrInvalidGif
+		}
+	}
+}
+
+func skipColorTable(r *bufio.Reader, packed byte) error {
+	if packed&0x80 == 0 {
+		return nil
+	}
+	if _, err := r.Discard(3 << (packed&0x07 + 1)); err != nil {
+		return ErrInvalidGif
+	}
+	return nil
+}
+
+func skipSubBlocks(r *bufio.Reader) error {
+	for {
+		size, err := r.ReadByte()
+		if err != nil {
+			return ErrInvalidGif
+		}
+		if size == 0 {
+			return nil
+		}
+		if _, err = r.Discard(int(size)); err != nil {
+			return ErrInvalidGif
+		}
+	}
+}
+
+// avifAnimation - 从 avif 图像序列的图像轨道中读取帧数与时长, 静态 avif 没有 moov
+func avifAnimation(r io.Reader) (*Animation, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	moov, err := findBox(data, "moov")
+	if err != nil || moov == nil {
+		return &Animation{Frames: 1}, err
+	}
+	traks, err := findBoxes(moov, "trak")
+	if err != nil {
+		return nil, err
+	}
+	for _, trak := range traks {
+		mdia, err := findBoxPath(trak, "mdia")
+		if err != nil {
+			return nil, err
+		}
+		// 仅统计图像轨道, 透明通道等辅助轨道帧数相同
+		hdlr, err := findBox(mdia, "hdlr")
+		if err != nil {
+			return nil, err
+		}
+		if len(hdlr) < 12 || string(hdlr[8:12]) != "pict" {
+			continue
+		}
+		stsz, err := findBoxPath(mdia, "minf", "stbl", "stsz")
+		if err != nil {
+			return nil, err
+		}
+		if len(stsz) < 12 {
+			return nil, ErrInvalidIsobmff
+		}
+		animation := &Animation{Frames: int(binary.BigEndian.Uint32(stsz[8:12]))}
+		if animation.Frames > 1 {
+			if mdhd, err := findBox(mdia, "mdhd"); err == nil {
+				animation.Duration = mediaDuration(mdhd)
+			}
+		}
+		return animation, nil
+	}
+	return &Animation{Frames: 1}, nil
+}
+
+// mediaDuration - 按 mdhd 中的时间刻度计算轨道时长
+func mediaDuration(mdhd []byte) time.Duration {
+	var timescale, duration uint64
+	switch {
+	case len(mdhd) >= 20 && mdhd[0] == 0:
+		timescale = uint64(binary.BigEndian.Uint32(mdhd[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(mdhd[16:20]))
+	case len(mdhd) >= 32 && mdhd[0] == 1:
+		timescale = uint64(binary.BigEndian.Uint32(mdhd[20:24]))
+		duration = binary.BigEndian.Uint64(mdhd[24:32])
+	}
+	if timescale == 0 {
+		return 0
+	}
+	return time.Duration(float64(duration) / float64(timescale) * float64(time.Second))
+}
+
+// findBoxPath - 按路径逐层查找子 box, 返回最后一层的内容
+func findBoxPath(data []byte, path ...string) ([]byte, error) {
+	for _, boxType := range path {
+		box, err := findBox(data, boxType)
+		if err != nil {
+			return nil, err
+		}
+		if box == nil {
+			return nil, ErrInvalidIsobmff
+		}
+		data = box
+	}
+	return data, nil
+}
+
+// findBox - 查找第一个指定类型的 box, 未找到时返回 nil
+func findBox(data []byte, boxType string) ([]byte, error) {
+	boxes, err := findBoxes(data, boxType)
+	if err != nil || len(boxes) == 0 {
+		return nil, err
+	}
+	return boxes[0], nil
+}
+
+// findBoxes - 查找同一层级中全部指定类型的 box, 返回不含头部的内容
+func findBoxes(data []byte, boxType string) ([][]byte, error) {
+	var boxes [][]byte
+	for len(data) > 0 {
+		if len(data) < 8 {
+			return nil, ErrInvalidIsobmff
+		}
+		size := uint64(binary.BigEndian.Uint32(data[:4]))
+		headerSize := uint64(8)
+		switch size {
+		case 0:
+			// 延伸至数据末尾
+			size = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return nil, ErrInvalidIsobmff
+			}
+			size = binary.BigEndian.Uint64(data[8:16])
+			headerSize = 16
+		}
+		if size < headerSize || size > uint64(len(data)) {
+			return nil, ErrInvalidIsobmff
+		}
+		if string(data[4:8]) == boxType {
+			boxes = append(boxes, data[headerSize:size])
+		}
+		data = data[size:]
+	}
+	return boxes, nil
+}
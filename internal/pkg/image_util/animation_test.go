@@ -0,0 +1,152 @@
+package image_util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"testing"
+	"time"
+)
+
+// testGif - 编码指定帧数的 gif, 每帧延时 delay (1/100 秒)
+func testGif(t *testing.T, frames int, delay int) []byte {
+	t.Helper()
+	palette := color.Palette{color.Black, color.White}
+	anim := &gif.GIF{}
+	for i := 0; i < frames; i++ {
+		frame := image.NewPaletted(image.Rect(0, 0, 2, 2), palette)
+		frame.SetColorIndex(i%2, 0, 1)
+		anim.Image = append(anim.Image, frame)
+		anim.Delay = append(anim.Delay, delay)
+	}
+	var buf bytes.Buffer
+	if err := gif.EncodeAll(&buf, anim); err != nil {
+		t.Fatalf("gif.EncodeAll() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func join(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+// box - 构造 isobmff box
+func box(boxType string, payload ...[]byte) []byte {
+	content := join(payload...)
+	data := binary.BigEndian.AppendUint32(nil, uint32(8+len(content)))
+	return append(append(data, boxType...), content...)
+}
+
+func fullBox(boxType string, fields ...uint32) []byte {
+	payload := make([]byte, 4) // version 与 flags
+	for _, field := range fields {
+		payload = binary.BigEndian.AppendUint32(payload, field)
+	}
+	return box(boxType, payload)
+}
+
+// testAvifTrack - 构造 avif 图像序列的轨道
+func testAvifTrack(handler string, frames, timescale, duration uint32) []byte {
+	return box("trak",
+		fullBox("tkhd", 0, 0, 1),
+		box("mdia",
+			fullBox("mdhd", 0, 0, timescale, duration),
+			box("hdlr", make([]byte, 8), []byte(handler), make([]byte, 13)),
+			box("minf", box("stbl", fullBox("stsd", 0), fullBox("stsz", 0, frames))),
+		),
+	)
+}
+
+var (
+	avifFtyp   = box("ftyp", []byte("avis\x00\x00\x00\x00avifmif1msf1"))
+	avifMeta   = box("meta", make([]byte, 4), box("hdlr", make([]byte, 8), []byte("pict"), make([]byte, 13)))
+	avifStatic = join(box("ftyp", []byte("avif\x00\x00\x00\x00avifmif1")), avifMeta, box("mdat", make([]byte, 16)))
+)
+
+func testAvif(tracks ...[]byte) []byte {
+	moov := box("moov", fullBox("mvhd", 0, 0, 1000, 0), join(tracks...))
+	return join(avifFtyp, avifMeta, moov, box("mdat", make([]byte, 16)))
+}
+
+func TestDecodeAnimation(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		format  string
+		want    Animation
+		wantErr error
+	}{
+		{name: "static gif", data: testGif(t, 1, 0), format: "gif", want: Animation{Frames: 1}},
+		{name: "animated gif", data: testGif(t, 3, 5), format: "gif", want: Animation{Frames: 3, Duration: 150 * time.Millisecond}},
+		{name: "gif at frame limit", data: testGif(t, MaxFrames, 1), format: "gif", want: Animation{Frames: MaxFrames, Duration: MaxFrames * 10 * time.Millisecond}},
+		{name: "gif over frame limit", data: testGif(t, MaxFrames+1, 1), format: "gif", wantErr: ErrTooManyFrames},
+		{name: "static avif", data: avifStatic, format: "avif", want: Animation{Frames: 1}},
+		{
+			name:   "avif sequence",
+			data:   testAvif(testAvifTrack("pict", 24, 1000, 2000)),
+			format: "avif",
+			want:   Animation{Frames: 24, Duration: 2 * time.Second},
+		},
+		{
+			// 辅助轨道不参与统计
+			name:   "avif sequence with alpha track",
+			data:   testAvif(testAvifTrack("auxv", MaxFrames+1, 1000, 1000), testAvifTrack("pict", 10, 100, 50)),
+			format: "avif",
+			want:   Animation{Frames: 10, Duration: 500 * time.Millisecond},
+		},
+		{name: "avif single frame sequence", data: testAvif(testAvifTrack("pict", 1, 1000, 1000)), format: "avif", want: Animation{Frames: 1}},
+		{name: "avif without picture track", data: testAvif(testAvifTrack("soun", 5, 1000, 1000)), format: "avif", want: Animation{Frames: 1}},
+		{name: "avif over frame limit", data: testAvif(testAvifTrack("pict", MaxFrames+1, 1000, 1000)), format: "avif", wantErr: ErrTooManyFrames},
+		{name: "other format is static", data: []byte("RIFF\x00\x00\x00\x00WEBP"), format: "webp", want: Animation{Frames: 1}},
+		{name: "not a gif", data: []byte("GIF00a\x01\x00\x01\x00\x00\x00\x00;"), format: "gif", wantErr: ErrInvalidGif},
+		{name: "gif without frames", data: []byte("GIF89a\x01\x00\x01\x00\x00\x00\x00;"), format: "gif", wantErr: ErrInvalidGif},
+		{name: "gif unknown block", data: []byte("GIF89a\x01\x00\x01\x00\x00\x00\x00\x99"), format: "gif", wantErr: ErrInvalidGif},
+		{name: "avif box size too small", data: join(avifFtyp, []byte{0, 0, 0, 4, 'm', 'o', 'o', 'v'}), format: "avif", wantErr: ErrInvalidIsobmff},
+		{name: "avif box size out of range", data: join(avifFtyp, []byte{0xff, 0xff, 0xff, 0xff, 'm', 'o', 'o', 'v'}), format: "avif", wantErr: ErrInvalidIsobmff},
+		{
+			name:    "avif large box size out of range",
+			data:    join(avifFtyp, []byte{0, 0, 0, 1, 'm', 'o', 'o', 'v', 0xff, 0, 0, 0, 0, 0, 0, 0}),
+			format:  "avif",
+			wantErr: ErrInvalidIsobmff,
+		},
+		{name: "avif track without stsz", data: testAvif(box("trak", box("mdia", box("hdlr", make([]byte, 8), []byte("pict"))))), format: "avif", wantErr: ErrInvalidIsobmff},
+		{name: "avif track without mdia", data: testAvif(box("trak", fullBox("tkhd", 0))), format: "avif", wantErr: ErrInvalidIsobmff},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeAnimation(bytes.NewReader(tt.data), tt.format)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DecodeAnimation() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if *got != tt.want {
+				t.Errorf("DecodeAnimation() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeAnimationTruncated(t *testing.T) {
+	tests := []struct {
+		format string
+		data   []byte
+		frames int
+	}{
+		{format: "gif", data: testGif(t, 4, 10), frames: 4},
+		{format: "avif", data: testAvif(testAvifTrack("auxv", 4, 1000, 1000), testAvifTrack("pict", 4, 1000, 1000)), frames: 4},
+	}
+	for _, tt := range tests {
+		// 逐字节截断, 不应 panic, 成功时帧数不超过原文件
+		for n := 0; n < len(tt.data); n++ {
+			got, err := DecodeAnimation(bytes.NewReader(tt.data[:n]), tt.format)
+			if err == nil && (got.Frames < 1 || got.Frames > tt.frames) {
+				t.Errorf("%s truncated at %d: frames = %d", tt.format, n, got.Frames)
+			}
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package image_util
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/draw"
+)
+
+// paletteSampleSize - 提取颜色前将图片缩放到的边长
+const paletteSampleSize = 64
+
+// paletteMergeDistance - 距离小于该值的颜色视为同一颜色
+const paletteMergeDistance = 48
+
+var ErrInvalidColor = errors.New("image_util: invalid hex color")
+
+type colorBucket struct {
+	count   int
+	r, g, b int
+}
+
+func (b *colorBucket) rgba() color.RGBA {
+	return color.RGBA{
+		R: uint8(b.r / b.count),
+		G: uint8(b.g / b.count),
+		B: uint8(b.b / b.count),
+		A: 0xff,
+	}
+}
+
+// ExtractPalette - 提取图片的主要颜色, 按占比降序排列, 第一个即为主色调
+// params:
+//   - img
+//   - size: 最多返回的颜色数
+//
+// returns:
+//   - palette
+func ExtractPalette(img image.Image, size int) []color.RGBA {
+	sample := image.NewRGBA(image.Rect(0, 0, paletteSampleSize, paletteSampleSize))
+	draw.ApproxBiLinear.Scale(sample, sample.Bounds(), img, img.Bounds(), draw.Src, nil)
+
+	// 每个通道量化为 4 位后统计
+	buckets := make(map[int]*colorBucket)
+	for y := 0; y < paletteSampleSize; y++ {
+		for x := 0; x < paletteSampleSize; x++ {
+			c := sample.RGBAAt(x, y)
+			// 忽略透明像素
+			if c.A < 0x80 {
+				continue
+			}
+			key := int(c.R>>4)<<8 | int(c.G>>4)<<4 | int(c.B>>4)
+			bucket, ok := buckets[key]
+			if !ok {
+				bucket = &colorBucket{}
+				buckets[key] = bucket
+			}
+			bucket.count++
+			bucket.r += int(c.R)
+			bucket.g += int(c.G)
+			bucket.b += int(c.B)
+		}
+	}
+	sorted := make([]*colorBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		sorted = append(sorted, bucket)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].count > sorted[j].count
+	})
+
+	var palette []color.RGBA
+	for _, bucket := range sorted {
+		if len(palette) >= size {
+			break
+		}
+		c := bucket.rgba()
+		similar := false
+		for _, exist := range palette {
+			if ColorDistance(exist, c) < paletteMergeDistance {
+				similar = true
+				break
+			}
+		}
+		if !similar {
+			palette = append(palette, c)
+		}
+	}
+	return palette
+}
+
+// ColorDistance - 两个颜色在 RGB 空间中的欧氏距离, 取值 0 ~ 441
+func ColorDistance(a, b color.RGBA) int {
+	dr := int(a.R) - int(b.R)
+	dg := int(a.G) - int(b.G)
+	db := int(a.B) - int(b.B)
+	return int(math.Sqrt(float64(dr*dr + dg*dg + db*db)))
+}
+
+// HexColor - 转换为 #rrggbb 格式
+func HexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// ParseHexColor - 解析 #rrggbb 或 rrggbb 格式的颜色
+func ParseHexColor(hex string) (color.RGBA, error) {
+	hex = strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(hex) != 6 {
+		return color.RGBA{}, ErrInvalidColor
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.RGBA{}, ErrInvalidColor
+	}
+	return color.RGBA{
+		R: uint8(v >> 16),
+		G: uint8(v >> 8),
+		B: uint8(v),
+		A: 0xff,
+	}, nil
+}
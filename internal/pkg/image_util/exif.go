@@ -0,0 +1,444 @@
+package image_util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/jpeg"
+	"strings"
+	"time"
+)
+
+const (
+	markerSOI  = 0xD8
+	markerSOS  = 0xDA
+	markerAPP1 = 0xE1
+	markerAPP2 = 0xE2
+
+	tagMake             = 0x010F
+	tagModel            = 0x0110
+	tagOrientation      = 0x0112
+	tagDateTime         = 0x0132
+	tagExifIfd          = 0x8769
+	tagGpsIfd           = 0x8825
+	tagDateTimeOriginal = 0x9003
+
+	exifDateLayout = "2006:01:02 15:04:05"
+	jpegQuality    = 92
+)
+
+var (
+	ErrNotJpeg = errors.New("image_util: not a jpeg file")
+	ErrNoExif  = errors.New("image_util: exif not found")
+
+	exifHeader = []byte("Exif\x00\x00")
+	xmpHeader  = []byte("http://ns.adobe.com/xap/1.0/\x00")
+
+	// tiff 各数据类型的字节数
+	tiffTypeSize = map[uint16]uint32{1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8}
+)
+
+type Exif struct {
+	Make        string
+	Model       string
+	TakenAt     time.Time
+	Orientation int
+	HasGps      bool
+}
+
+type jpegSegment struct {
+	marker byte
+	data   []byte
+}
+
+// ProcessJpegExif - 解析 jpeg 的 exif 信息, 按方向旋转图片并按需去除定位信息
+// params:
+//   - data: jpeg 文件内容
+//   - keepGps: 是否保留定位信息
+//
+// returns:
+//   - exif: 未找到 exif 时为 nil
+//   - output: 处理后的文件内容, 未修改时为 nil
+//   - error: nil on success, non-nil on failure
+func ProcessJpegExif(data []byte, keepGps bool) (*Exif, []byte, error) {
+	segments, rest, err := splitJpeg(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	var exif *Exif
+	var tiff []byte
+	exifSeen, modified := false, false
+	kept := segments[:0]
+	for _, segment := range segments {
+		if segment.marker == markerAPP1 && bytes.HasPrefix(segment.data, exifHeader) {
+			// 仅解析第一个 exif 段, 其余 exif 段同样可能包含定位信息, 直接丢弃
+			if exifSeen {
+				modified = true
+				continue
+			}
+			exifSeen = true
+			// 复制一份, 避免修改调用方的数据
+			segment.data = append([]byte{}, segment.data...)
+			tiff = segment.data[len(exifHeader):]
+			exif, err = parseTiff(tiff)
+			if err != nil {
+				// 无法解析的 exif 直接丢弃
+				tiff = nil
+				modified = true
+				continue
+			}
+			if exif.HasGps && !keepGps {
+				modified = true
+				// 定位信息无法完整清除时丢弃整个 exif 段
+				if !stripGps(tiff) {
+					tiff = nil
+					continue
+				}
+			}
+		}
+		// xmp 中同样可能包含定位信息
+		if segment.marker == markerAPP1 && bytes.HasPrefix(segment.data, xmpHeader) && !keepGps {
+			modified = true
+			continue
+		}
+		kept = append(kept, segment)
+	}
+	if exif == nil {
+		if !modified {
+			return nil, nil, nil
+		}
+		return nil, joinJpeg(kept, rest), nil
+	}
+	if exif.Orientation <= 1 || exif.Orientation > 8 {
+		if !modified {
+			return exif, nil, nil
+		}
+		return exif, joinJpeg(kept, rest), nil
+	}
+	// 按方向旋转后重新编码
+	config, err := jpeg.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+	if int64(config.Width)*int64(config.Height) > MaxPixels {
+		return nil, nil, ErrImageTooLarge
+	}
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, err
+	}
+	var buf bytes.Buffer
+	if err = jpeg.Encode(&buf, ApplyOrientation(img, exif.Orientation), &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, nil, err
+	}
+	encoded, encodedRest, err := splitJpeg(buf.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	// 保留修正方向后的 exif 与 icc 颜色配置
+	var preserved []jpegSegment
+	if tiff != nil {
+		setOrientation(tiff, 1)
+		preserved = append(preserved, jpegSegment{marker: markerAPP1, data: append(append([]byte{}, exifHeader...), tiff...)})
+	}
+	for _, segment := range kept {
+		if segment.marker == markerAPP2 {
+			preserved = append(preserved, segment)
+		}
+	}
+	return exif, joinJpeg(append(preserved, encoded...), encodedRest), nil
+}
+
+// StripJpegMetadata - 解码后重新编码 jpeg, 不保留 exif、xmp 等任何元数据
+// 用于段结构无法解析、又不能保留原文件的情况
+// params:
+//   - data: jpeg 文件内容
+//
+// returns:
+//   - output: 重新编码后的文件内容
+//   - error: 像素数超过 MaxPixels 时返回 ErrImageTooLarge
+func StripJpegMetadata(data []byte) ([]byte, error) {
+	config, err := jpeg.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if int64(config.Width)*int64(config.Height) > MaxPixels {
+		return nil, ErrImageTooLarge
+	}
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// splitJpeg - 拆分 jpeg 图像数据之前的段
+func splitJpeg(data []byte) ([]jpegSegment, []byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != markerSOI {
+		return nil, nil, ErrNotJpeg
+	}
+	var segments []jpegSegment
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil, nil, ErrNotJpeg
+		}
+		marker := data[pos+1]
+		if marker == 0xFF {
+			pos++
+			continue
+		}
+		if marker == markerSOS {
+			return segments, data[pos:], nil
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return nil, nil, ErrNotJpeg
+		}
+		segments = append(segments, jpegSegment{marker: marker, data: data[pos+4 : pos+2+length]})
+		pos += 2 + length
+	}
+	return nil, nil, ErrNotJpeg
+}
+
+func joinJpeg(segments []jpegSegment, rest []byte) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0xFF, markerSOI})
+	for _, segment := range segments {
+		buf.Write([]byte{0xFF, segment.marker})
+		_ = binary.Write(&buf, binary.BigEndian, uint16(len(segment.data)+2))
+		buf.Write(segment.data)
+	}
+	buf.Write(rest)
+	return buf.Bytes()
+}
+
+type tiffReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+type ifdEntry struct {
+	offset uint32 // 条目在 tiff 中的偏移
+	tag    uint16
+	typ    uint16
+	count  uint32
+}
+
+func newTiffReader(tiff []byte) (*tiffReader, uint32, error) {
+	if len(tiff) < 8 {
+		return nil, 0, ErrNoExif
+	}
+	r := &tiffReader{data: tiff}
+	switch string(tiff[:2]) {
+	case "II":
+		r.order = binary.LittleEndian
+	case "MM":
+		r.order = binary.BigEndian
+	default:
+		return nil, 0, ErrNoExif
+	}
+	return r, r.order.Uint32(tiff[4:]), nil
+}
+
+func (r *tiffReader) entries(ifdOffset uint32) ([]ifdEntry, error) {
+	if uint64(ifdOffset)+2 > uint64(len(r.data)) {
+		return nil, ErrNoExif
+	}
+	count := uint32(r.order.Uint16(r.data[ifdOffset:]))
+	if uint64(ifdOffset)+2+uint64(count)*12 > uint64(len(r.data)) {
+		return nil, ErrNoExif
+	}
+	entries := make([]ifdEntry, 0, count)
+	for i := uint32(0); i < count; i++ {
+		offset := ifdOffset + 2 + i*12
+		entries = append(entries, ifdEntry{
+			offset: offset,
+			tag:    r.order.Uint16(r.data[offset:]),
+			typ:    r.order.Uint16(r.data[offset+2:]),
+			count:  r.order.Uint32(r.data[offset+4:]),
+		})
+	}
+	return entries, nil
+}
+
+// valueRange - 条目值所在的区间, 不超过 4 字节的值内联在条目中
+func (r *tiffReader) valueRange(entry ifdEntry) (uint32, uint32, bool) {
+	size := uint64(tiffTypeSize[entry.typ]) * uint64(entry.count)
+	if size <= 4 {
+		return entry.offset + 8, uint32(size), true
+	}
+	offset := r.order.Uint32(r.data[entry.offset+8:])
+	if uint64(offset)+size > uint64(len(r.data)) {
+		return 0, 0, false
+	}
+	return offset, uint32(size), true
+}
+
+func (r *tiffReader) uint(entry ifdEntry) uint32 {
+	switch entry.typ {
+	case 3:
+		return uint32(r.order.Uint16(r.data[entry.offset+8:]))
+	case 4:
+		return r.order.Uint32(r.data[entry.offset+8:])
+	}
+	return 0
+}
+
+func (r *tiffReader) string(entry ifdEntry) string {
+	if entry.typ != 2 {
+		return ""
+	}
+	offset, size, ok := r.valueRange(entry)
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimRight(string(r.data[offset:offset+size]), "\x00"))
+}
+
+func parseTiff(tiff []byte) (*Exif, error) {
+	r, ifd0, err := newTiffReader(tiff)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := r.entries(ifd0)
+	if err != nil {
+		return nil, err
+	}
+	exif := &Exif{}
+	var dateTime string
+	for _, entry := range entries {
+		switch entry.tag {
+		case tagMake:
+			exif.Make = r.string(entry)
+		case tagModel:
+			exif.Model = r.string(entry)
+		case tagOrientation:
+			exif.Orientation = int(r.uint(entry))
+		case tagDateTime:
+			dateTime = r.string(entry)
+		case tagGpsIfd:
+			// gps ifd 无法解析时同样视为含有定位信息, 由调用方决定是否丢弃
+			gpsEntries, err := r.entries(r.uint(entry))
+			exif.HasGps = err != nil || len(gpsEntries) > 0
+		case tagExifIfd:
+			subEntries, err := r.entries(r.uint(entry))
+			if err != nil {
+				continue
+			}
+			for _, subEntry := range subEntries {
+				if subEntry.tag == tagDateTimeOriginal {
+					dateTime = r.string(subEntry)
+				}
+			}
+		}
+	}
+	if dateTime != "" {
+		if takenAt, err := time.ParseInLocation(exifDateLayout, dateTime, time.Local); err == nil {
+			exif.TakenAt = takenAt
+		}
+	}
+	return exif, nil
+}
+
+// stripGps - 清空 gps ifd 中的全部条目及其数据
+// returns:
+//   - ok: gps ifd 无法完整解析时返回 false
+func stripGps(tiff []byte) bool {
+	r, ifd0, err := newTiffReader(tiff)
+	if err != nil {
+		return false
+	}
+	entries, err := r.entries(ifd0)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if entry.tag != tagGpsIfd {
+			continue
+		}
+		gpsOffset := r.uint(entry)
+		gpsEntries, err := r.entries(gpsOffset)
+		if err != nil {
+			return false
+		}
+		for _, gpsEntry := range gpsEntries {
+			offset, size, ok := r.valueRange(gpsEntry)
+			if !ok {
+				return false
+			}
+			clear(tiff[offset : offset+size])
+		}
+		// 条目数置 0, 原条目区域清零后下一个 ifd 偏移也为 0
+		clear(tiff[gpsOffset : gpsOffset+2+uint32(len(gpsEntries))*12])
+		if int(gpsOffset+2+uint32(len(gpsEntries))*12+4) <= len(tiff) {
+			clear(tiff[gpsOffset+2+uint32(len(gpsEntries))*12:][:4])
+		}
+	}
+	return true
+}
+
+// setOrientation - 修改 ifd0 中的方向
+func setOrientation(tiff []byte, orientation uint16) {
+	r, ifd0, err := newTiffReader(tiff)
+	if err != nil {
+		return
+	}
+	entries, err := r.entries(ifd0)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.tag == tagOrientation && entry.typ == 3 {
+			r.order.PutUint16(tiff[entry.offset+8:], orientation)
+		}
+	}
+}
+
+// ApplyOrientation - 按 exif 方向将图片转正
+// params:
+//   - img
+//   - orientation: exif 方向 1 ~ 8
+//
+// returns:
+//   - img: 转正后的图片
+func ApplyOrientation(img image.Image, orientation int) image.Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	// 5 ~ 8 需要交换宽高
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			default:
+				dx, dy = x, y
+			}
+			dst.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return dst
+}
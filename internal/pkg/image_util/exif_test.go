@@ -0,0 +1,354 @@
+package image_util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+// gpsMarker - 测试用的纬度数值, 用于判断定位信息是否残留
+var gpsMarker = []byte{0x0D, 0xF0, 0xAD, 0x0B}
+
+type testIfdEntry struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+// buildTiff - 构造小端序的 tiff, ifd0 紧跟文件头, gps 不为 nil 时在 ifd0 之后写入 gps ifd
+func buildTiff(ifd0, gps []testIfdEntry) []byte {
+	order := binary.LittleEndian
+	if gps != nil {
+		ifd0 = append(ifd0, testIfdEntry{tag: tagGpsIfd, typ: 4, count: 1, value: make([]byte, 4)})
+	}
+	// appendIfd - 追加 ifd 及其超过 4 字节的值
+	appendIfd := func(tiff []byte, entries []testIfdEntry) []byte {
+		data := uint32(len(tiff) + 2 + 12*len(entries) + 4)
+		var values []byte
+		tiff = order.AppendUint16(tiff, uint16(len(entries)))
+		for _, entry := range entries {
+			tiff = order.AppendUint16(tiff, entry.tag)
+			tiff = order.AppendUint16(tiff, entry.typ)
+			tiff = order.AppendUint32(tiff, entry.count)
+			if len(entry.value) > 4 {
+				tiff = order.AppendUint32(tiff, data+uint32(len(values)))
+				values = append(values, entry.value...)
+				continue
+			}
+			tiff = append(tiff, entry.value...)
+			tiff = append(tiff, make([]byte, 4-len(entry.value))...)
+		}
+		tiff = order.AppendUint32(tiff, 0)
+		return append(tiff, values...)
+	}
+	tiff := appendIfd([]byte{'I', 'I', 42, 0, 8, 0, 0, 0}, ifd0)
+	if gps != nil {
+		order.PutUint32(ifd0[len(ifd0)-1].value, uint32(len(tiff)))
+		tiff = appendIfd(tiff[:8], ifd0)
+		tiff = appendIfd(tiff, gps)
+	}
+	return tiff
+}
+
+func shortValue(v uint16) []byte {
+	return binary.LittleEndian.AppendUint16(nil, v)
+}
+
+// exifTiff - 含厂商、方向与可选定位信息的 tiff
+func exifTiff(orientation uint16, withGps bool) []byte {
+	ifd0 := []testIfdEntry{
+		{tag: tagMake, typ: 2, count: 8, value: []byte("TestCam\x00")},
+		{tag: tagOrientation, typ: 3, count: 1, value: shortValue(orientation)},
+	}
+	var gps []testIfdEntry
+	if withGps {
+		latitude := make([]byte, 0, 24)
+		for i := 0; i < 3; i++ {
+			latitude = append(latitude, gpsMarker...)
+			latitude = binary.LittleEndian.AppendUint32(latitude, 1)
+		}
+		gps = []testIfdEntry{
+			{tag: 0x0001, typ: 2, count: 2, value: []byte("N\x00")},
+			{tag: 0x0002, typ: 5, count: 3, value: latitude},
+		}
+	}
+	return buildTiff(ifd0, gps)
+}
+
+// testJpeg - 编码指定尺寸的 jpeg, 并在 SOI 之后插入给定的段
+func testJpeg(t *testing.T, width, height int, segments ...jpegSegment) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 16), G: uint8(y * 16), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatalf("jpeg.Encode() error = %v", err)
+	}
+	encoded, rest, err := splitJpeg(buf.Bytes())
+	if err != nil {
+		t.Fatalf("splitJpeg() error = %v", err)
+	}
+	return joinJpeg(append(segments, encoded...), rest)
+}
+
+func exifSegment(tiff []byte) jpegSegment {
+	return jpegSegment{marker: markerAPP1, data: append(append([]byte{}, exifHeader...), tiff...)}
+}
+
+func xmpSegment() jpegSegment {
+	return jpegSegment{marker: markerAPP1, data: append(append([]byte{}, xmpHeader...), `<x:xmpmeta exif:GPSLatitude="31,14N"/>`...)}
+}
+
+// countSegments - 统计文件中以 header 开头的 APP1 段数量
+func countSegments(t *testing.T, data []byte, header []byte) int {
+	t.Helper()
+	segments, _, err := splitJpeg(data)
+	if err != nil {
+		t.Fatalf("splitJpeg() error = %v", err)
+	}
+	count := 0
+	for _, segment := range segments {
+		if segment.marker == markerAPP1 && bytes.HasPrefix(segment.data, header) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestProcessJpegExifGps(t *testing.T) {
+	data := testJpeg(t, 8, 8, exifSegment(exifTiff(1, true)))
+
+	exif, output, err := ProcessJpegExif(data, false)
+	if err != nil {
+		t.Fatalf("ProcessJpegExif() error = %v", err)
+	}
+	if exif == nil || !exif.HasGps || exif.Make != "TestCam" {
+		t.Fatalf("ProcessJpegExif() exif = %+v, want make TestCam with gps", exif)
+	}
+	if output == nil || bytes.Contains(output, gpsMarker) {
+		t.Fatalf("ProcessJpegExif() output still contains gps data")
+	}
+	// 去除定位信息后其余 exif 保留
+	stripped, _, err := ProcessJpegExif(output, true)
+	if err != nil || stripped == nil || stripped.HasGps || stripped.Make != "TestCam" {
+		t.Errorf("ProcessJpegExif(output) exif = %+v, err = %v, want make TestCam without gps", stripped, err)
+	}
+
+	exif, output, err = ProcessJpegExif(data, true)
+	if err != nil || exif == nil || !exif.HasGps {
+		t.Fatalf("ProcessJpegExif(keepGps) exif = %+v, err = %v", exif, err)
+	}
+	if output != nil {
+		t.Errorf("ProcessJpegExif(keepGps) modified the file")
+	}
+	if !bytes.Contains(data, gpsMarker) {
+		t.Errorf("ProcessJpegExif(keepGps) modified the input")
+	}
+}
+
+func TestProcessJpegExifOrientation(t *testing.T) {
+	for orientation := uint16(1); orientation <= 8; orientation++ {
+		data := testJpeg(t, 16, 8, exifSegment(exifTiff(orientation, true)))
+		exif, output, err := ProcessJpegExif(data, false)
+		if err != nil {
+			t.Fatalf("orientation %d: ProcessJpegExif() error = %v", orientation, err)
+		}
+		if exif.Orientation != int(orientation) {
+			t.Errorf("orientation %d: exif orientation = %d", orientation, exif.Orientation)
+		}
+		config, err := jpeg.DecodeConfig(bytes.NewReader(output))
+		if err != nil {
+			t.Fatalf("orientation %d: decode output error = %v", orientation, err)
+		}
+		wantW, wantH := 16, 8
+		if orientation >= 5 {
+			wantW, wantH = 8, 16
+		}
+		if config.Width != wantW || config.Height != wantH {
+			t.Errorf("orientation %d: output size = %dx%d, want %dx%d", orientation, config.Width, config.Height, wantW, wantH)
+		}
+		rotated, _, err := ProcessJpegExif(output, false)
+		if err != nil || rotated == nil || rotated.Orientation != 1 || rotated.HasGps {
+			t.Errorf("orientation %d: output exif = %+v, err = %v, want orientation 1 without gps", orientation, rotated, err)
+		}
+		if bytes.Contains(output, gpsMarker) {
+			t.Errorf("orientation %d: output still contains gps data", orientation)
+		}
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 原图 3x2:
+	//   a b c
+	//   d e f
+	a, b, c, d, e, f := uint8(1), uint8(2), uint8(3), uint8(4), uint8(5), uint8(6)
+	src := image.NewGray(image.Rect(0, 0, 3, 2))
+	for i, v := range []uint8{a, b, c, d, e, f} {
+		src.SetGray(i%3, i/3, color.Gray{Y: v})
+	}
+	tests := []struct {
+		orientation int
+		want        [][]uint8
+	}{
+		{orientation: 1, want: [][]uint8{{a, b, c}, {d, e, f}}},
+		{orientation: 2, want: [][]uint8{{c, b, a}, {f, e, d}}},
+		{orientation: 3, want: [][]uint8{{f, e, d}, {c, b, a}}},
+		{orientation: 4, want: [][]uint8{{d, e, f}, {a, b, c}}},
+		{orientation: 5, want: [][]uint8{{a, d}, {b, e}, {c, f}}},
+		{orientation: 6, want: [][]uint8{{d, a}, {e, b}, {f, c}}},
+		{orientation: 7, want: [][]uint8{{f, c}, {e, b}, {d, a}}},
+		{orientation: 8, want: [][]uint8{{c, f}, {b, e}, {a, d}}},
+	}
+	for _, tt := range tests {
+		img := ApplyOrientation(src, tt.orientation)
+		if img.Bounds().Dx() != len(tt.want[0]) || img.Bounds().Dy() != len(tt.want) {
+			t.Errorf("orientation %d: size = %v", tt.orientation, img.Bounds())
+			continue
+		}
+		for y, row := range tt.want {
+			for x, v := range row {
+				if got := color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y; got != v {
+					t.Errorf("orientation %d: pixel (%d, %d) = %d, want %d", tt.orientation, x, y, got, v)
+				}
+			}
+		}
+	}
+}
+
+func TestProcessJpegExifMalformed(t *testing.T) {
+	le := binary.LittleEndian
+	tests := []struct {
+		name string
+		tiff []byte
+	}{
+		{name: "empty", tiff: nil},
+		{name: "bad byte order", tiff: []byte("XX*\x00\x08\x00\x00\x00")},
+		{name: "ifd0 out of range", tiff: []byte("II*\x00\xff\xff\x00\x00")},
+		{name: "ifd0 count out of range", tiff: append([]byte("II*\x00\x08\x00\x00\x00"), 0xff, 0x00)},
+		{
+			name: "value offset out of range",
+			tiff: buildTiff([]testIfdEntry{{tag: tagMake, typ: 2, count: 0xffff, value: le.AppendUint32(nil, 0xfffffff0)}}, nil),
+		},
+		{
+			name: "huge count",
+			tiff: buildTiff([]testIfdEntry{{tag: tagModel, typ: 12, count: 0xffffffff, value: le.AppendUint32(nil, 8)}}, nil),
+		},
+		{
+			name: "gps ifd out of range",
+			tiff: buildTiff([]testIfdEntry{
+				{tag: tagOrientation, typ: 3, count: 1, value: shortValue(6)},
+				{tag: tagGpsIfd, typ: 4, count: 1, value: le.AppendUint32(nil, 0xfffffff0)},
+			}, nil),
+		},
+		{
+			name: "exif ifd out of range",
+			tiff: buildTiff([]testIfdEntry{{tag: tagExifIfd, typ: 4, count: 1, value: le.AppendUint32(nil, 0x7fffffff)}}, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testJpeg(t, 8, 8, exifSegment(tt.tiff))
+			for _, keepGps := range []bool{false, true} {
+				if _, _, err := ProcessJpegExif(data, keepGps); err != nil {
+					t.Errorf("ProcessJpegExif(keepGps=%v) error = %v", keepGps, err)
+				}
+			}
+		})
+	}
+
+	// 逐字节截断 exif, 不应 panic, 且不残留定位信息
+	tiff := exifTiff(6, true)
+	for n := 0; n <= len(tiff); n++ {
+		data := testJpeg(t, 8, 8, exifSegment(tiff[:n]))
+		_, output, err := ProcessJpegExif(data, false)
+		if err != nil {
+			t.Fatalf("truncated at %d: ProcessJpegExif() error = %v", n, err)
+		}
+		if output == nil {
+			output = data
+		}
+		if bytes.Contains(output, gpsMarker) {
+			t.Errorf("truncated at %d: output still contains gps data", n)
+		}
+	}
+
+	// 段长度超出文件
+	data := testJpeg(t, 8, 8, exifSegment(exifTiff(1, true)))
+	for _, n := range []int{0, 3, 10, 30} {
+		if _, _, err := ProcessJpegExif(data[:n], false); err == nil {
+			t.Errorf("truncated file at %d: ProcessJpegExif() error = nil", n)
+		}
+	}
+}
+
+func TestProcessJpegExifMultipleApp1(t *testing.T) {
+	data := testJpeg(t, 8, 8, exifSegment(exifTiff(1, false)), exifSegment(exifTiff(1, true)), exifSegment(exifTiff(1, true)))
+	exif, output, err := ProcessJpegExif(data, false)
+	if err != nil {
+		t.Fatalf("ProcessJpegExif() error = %v", err)
+	}
+	if exif == nil || exif.Make != "TestCam" {
+		t.Fatalf("ProcessJpegExif() exif = %+v", exif)
+	}
+	if output == nil || bytes.Contains(output, gpsMarker) {
+		t.Fatalf("ProcessJpegExif() output still contains gps data")
+	}
+	if count := countSegments(t, output, exifHeader); count != 1 {
+		t.Errorf("ProcessJpegExif() output has %d exif segments, want 1", count)
+	}
+
+	// 需要转正时同样只保留一个 exif 段
+	data = testJpeg(t, 8, 8, exifSegment(exifTiff(6, false)), exifSegment(exifTiff(1, true)))
+	_, output, err = ProcessJpegExif(data, false)
+	if err != nil {
+		t.Fatalf("ProcessJpegExif() error = %v", err)
+	}
+	if bytes.Contains(output, gpsMarker) || countSegments(t, output, exifHeader) != 1 {
+		t.Errorf("ProcessJpegExif() rotated output keeps extra exif segments")
+	}
+}
+
+func TestProcessJpegExifXmp(t *testing.T) {
+	data := testJpeg(t, 8, 8, xmpSegment(), exifSegment(exifTiff(1, false)))
+
+	_, output, err := ProcessJpegExif(data, false)
+	if err != nil {
+		t.Fatalf("ProcessJpegExif() error = %v", err)
+	}
+	if output == nil || countSegments(t, output, xmpHeader) != 0 {
+		t.Errorf("ProcessJpegExif() output keeps xmp")
+	}
+	if countSegments(t, output, exifHeader) != 1 {
+		t.Errorf("ProcessJpegExif() output drops exif")
+	}
+
+	_, output, err = ProcessJpegExif(data, true)
+	if err != nil {
+		t.Fatalf("ProcessJpegExif(keepGps) error = %v", err)
+	}
+	if output != nil {
+		t.Errorf("ProcessJpegExif(keepGps) modified the file")
+	}
+}
+
+func TestStripJpegMetadata(t *testing.T) {
+	data := testJpeg(t, 8, 8, xmpSegment(), exifSegment(exifTiff(6, true)))
+	output, err := StripJpegMetadata(data)
+	if err != nil {
+		t.Fatalf("StripJpegMetadata() error = %v", err)
+	}
+	if countSegments(t, output, exifHeader) != 0 || countSegments(t, output, xmpHeader) != 0 || bytes.Contains(output, gpsMarker) {
+		t.Errorf("StripJpegMetadata() output keeps metadata")
+	}
+	if _, err = StripJpegMetadata([]byte("not a jpeg")); err == nil {
+		t.Errorf("StripJpegMetadata() error = nil for invalid input")
+	}
+}
@@ -0,0 +1,29 @@
+package image_util
+
+import (
+	"image"
+
+	"golang.org/x/image/draw"
+)
+
+// DHash - 计算图片的差异哈希 (dHash)
+// 缩放为 9x8 灰度图后比较相邻像素亮度, 对缩放、重压缩等修改不敏感
+// params:
+//   - img
+//
+// returns:
+//   - hash: 64 位感知哈希
+func DHash(img image.Image) uint64 {
+	gray := image.NewGray(image.Rect(0, 0, 9, 8))
+	draw.ApproxBiLinear.Scale(gray, gray.Bounds(), img, img.Bounds(), draw.Src, nil)
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			hash <<= 1
+			if gray.GrayAt(x, y).Y > gray.GrayAt(x+1, y).Y {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
@@ -0,0 +1,183 @@
+package image_util
+
+import (
+	"encoding/xml"
+	"errors"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gen2brain/avif"
+	"github.com/gen2brain/heic"
+	_ "golang.org/x/image/webp"
+)
+
+var (
+	ErrUnknownFormat = errors.New("image_util: unknown image format")
+	ErrImageTooLarge = errors.New("image_util: image too large")
+)
+
+// MaxPixels - 允许解码的最大像素数, 避免声明超大尺寸的图片在解码时耗尽内存
+const MaxPixels = 40 * 1000 * 1000
+
+type Info struct {
+	Width  int
+	Height int
+	Format string
+}
+
+// DecodeInfo - 读取图片宽高与格式 (jpeg/png/gif/webp/avif/heic/svg)
+// params:
+//   - r: 图片内容, 读取完毕后不会重置偏移
+//
+// returns:
+//   - info
+//   - error: nil on success, non-nil on failure
+func DecodeInfo(r io.ReadSeeker) (*Info, error) {
+	format, err := Sniff(r)
+	if err == nil && (format == "avif" || format == "heic") {
+		return decodeIsobmffInfo(r, format)
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	config, format, err := image.DecodeConfig(r)
+	if err == nil {
+		return &Info{
+			Width:  config.Width,
+			Height: config.Height,
+			Format: format,
+		}, nil
+	}
+	// 位图解析失败时尝试按 svg 解析
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return decodeSvgInfo(r)
+}
+
+// Decode - 解码位图 (jpeg/png/gif/webp/avif/heic), 动图取第一帧
+// params:
+//   - r: 图片内容, 从头开始读取
+//
+// returns:
+//   - img
+//   - format
+//   - error: 像素数超过 MaxPixels 时返回 ErrImageTooLarge, 不进行解码
+func Decode(r io.ReadSeeker) (image.Image, string, error) {
+	info, err := DecodeInfo(r)
+	if err != nil {
+		return nil, "", err
+	}
+	if info.TooLarge() {
+		return nil, "", ErrImageTooLarge
+	}
+	format, err := Sniff(r)
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, "", err
+	}
+	// heif 的主品牌可能为 mif1 等, 无法通过 image.Decode 注册的文件头识别
+	switch {
+	case err == nil && format == "avif":
+		img, err := avif.Decode(r)
+		return img, format, err
+	case err == nil && format == "heic":
+		img, err := heic.Decode(r)
+		return img, format, err
+	}
+	return image.Decode(r)
+}
+
+// TooLarge - 像素数是否超过 MaxPixels, svg 为矢量图不受限制
+func (i *Info) TooLarge() bool {
+	return i.Format != "svg" && int64(i.Width)*int64(i.Height) > MaxPixels
+}
+
+// decodeIsobmffInfo - 读取 avif 与 heic 的宽高
+func decodeIsobmffInfo(r io.ReadSeeker, format string) (*Info, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	var config image.Config
+	var err error
+	if format == "avif" {
+		config, err = avif.DecodeConfig(r)
+	} else {
+		config, err = heic.DecodeConfig(r)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Info{
+		Width:  config.Width,
+		Height: config.Height,
+		Format: format,
+	}, nil
+}
+
+// decodeSvgInfo - 从 svg 根节点的 width/height 或 viewBox 中读取尺寸
+func decodeSvgInfo(r io.Reader) (*Info, error) {
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, ErrUnknownFormat
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "svg" {
+			return nil, ErrUnknownFormat
+		}
+		var width, height float64
+		var viewBox string
+		for _, attr := range start.Attr {
+			switch attr.Name.Local {
+			case "width":
+				width = parseSvgLength(attr.Value)
+			case "height":
+				height = parseSvgLength(attr.Value)
+			case "viewBox":
+				viewBox = attr.Value
+			}
+		}
+		if (width == 0 || height == 0) && viewBox != "" {
+			fields := strings.Fields(strings.ReplaceAll(viewBox, ",", " "))
+			if len(fields) == 4 {
+				width, _ = strconv.ParseFloat(fields[2], 64)
+				height, _ = strconv.ParseFloat(fields[3], 64)
+			}
+		}
+		if width <= 0 || height <= 0 {
+			return nil, ErrUnknownFormat
+		}
+		return &Info{
+			Width:  int(math.Round(width)),
+			Height: int(math.Round(height)),
+			Format: "svg",
+		}, nil
+	}
+}
+
+// parseSvgLength - 解析 svg 长度, 百分比等相对单位视为未知
+func parseSvgLength(value string) float64 {
+	value = strings.TrimSpace(value)
+	if strings.HasSuffix(value, "%") {
+		return 0
+	}
+	value = strings.TrimRightFunc(value, func(r rune) bool {
+		return r >= 'a' && r <= 'z'
+	})
+	length, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return length
+}
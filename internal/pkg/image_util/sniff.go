@@ -0,0 +1,98 @@
+package image_util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// sniffLen - 识别文件类型时读取的字节数, svg 根节点前可能有较长的声明与注释
+const sniffLen = 4096
+
+var utf8Bom = []byte{0xEF, 0xBB, 0xBF}
+
+// Sniff - 根据文件头识别图片格式, 不依赖文件名与 Content-Type
+// params:
+//   - r: 图片内容, 从头开始读取
+//
+// returns:
+//   - format: jpeg | png | gif | webp | avif | heic | svg
+//   - error: 无法识别时返回 ErrUnknownFormat
+func Sniff(r io.ReadSeeker) (string, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+	head = head[:n]
+	switch {
+	case bytes.HasPrefix(head, []byte{0xFF, 0xD8, 0xFF}):
+		return "jpeg", nil
+	case bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n")):
+		return "png", nil
+	case bytes.HasPrefix(head, []byte("GIF87a")), bytes.HasPrefix(head, []byte("GIF89a")):
+		return "gif", nil
+	case len(head) >= 12 && bytes.Equal(head[:4], []byte("RIFF")) && bytes.Equal(head[8:12], []byte("WEBP")):
+		return "webp", nil
+	case isSvg(head):
+		return "svg", nil
+	}
+	if format := sniffFtyp(head); format != "" {
+		return format, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// sniffFtyp - 按 ISO BMFF ftyp 盒中的主品牌与兼容品牌识别 avif 与 heic
+func sniffFtyp(head []byte) string {
+	if len(head) < 16 || !bytes.Equal(head[4:8], []byte("ftyp")) {
+		return ""
+	}
+	size := int(binary.BigEndian.Uint32(head[:4]))
+	if size < 16 || size > len(head) || size%4 != 0 {
+		return ""
+	}
+	// 主品牌之后为版本号, 其后均为兼容品牌
+	brands := [][]byte{head[8:12]}
+	for i := 16; i+4 <= size; i += 4 {
+		brands = append(brands, head[i:i+4])
+	}
+	format := ""
+	for _, brand := range brands {
+		switch string(brand) {
+		case "avif", "avis":
+			return "avif"
+		case "heic", "heix", "heim", "heis", "hevc", "hevx", "mif1", "msf1":
+			format = "heic"
+		}
+	}
+	return format
+}
+
+// isSvg - 跳过 xml 声明、注释与 doctype 后, 根节点为 svg
+func isSvg(head []byte) bool {
+	head = bytes.TrimPrefix(head, utf8Bom)
+	for {
+		head = bytes.TrimLeft(head, " \t\r\n")
+		var end []byte
+		switch {
+		case bytes.HasPrefix(head, []byte("<?")):
+			end = []byte("?>")
+		case bytes.HasPrefix(head, []byte("<!--")):
+			end = []byte("-->")
+		case bytes.HasPrefix(head, []byte("<!")):
+			end = []byte(">")
+		default:
+			return bytes.HasPrefix(head, []byte("<svg")) && len(head) > 4 &&
+				bytes.IndexByte([]byte(" \t\r\n>/"), head[4]) >= 0
+		}
+		i := bytes.Index(head, end)
+		if i < 0 {
+			return false
+		}
+		head = head[i+len(end):]
+	}
+}
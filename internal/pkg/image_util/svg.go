@@ -0,0 +1,270 @@
+package image_util
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"image"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/srwiley/oksvg"
+	"github.com/srwiley/rasterx"
+)
+
+var ErrInvalidSvg = errors.New("image_util: invalid svg")
+
+var (
+	svgTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	svgAttrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;",
+		"\n", "&#xA;", "\r", "&#xD;", "\t", "&#x9;")
+)
+
+// svgUnsafeElements - 可执行脚本或嵌入外部内容的元素, 连同子节点一并移除
+var svgUnsafeElements = map[string]struct{}{
+	"script":        {},
+	"foreignobject": {},
+	"iframe":        {},
+	"object":        {},
+	"embed":         {},
+	"handler":       {},
+	"listener":      {},
+}
+
+// svgAnimateElements - 可在运行时修改属性的动画元素
+var svgAnimateElements = map[string]struct{}{
+	"set":              {},
+	"animate":          {},
+	"animatecolor":     {},
+	"animatemotion":    {},
+	"animatetransform": {},
+}
+
+// SanitizeSvg - 移除 svg 中的脚本、事件属性、foreignObject 与外部引用
+// params:
+//   - r: svg 内容
+//   - w: 清理后的 svg 内容
+//
+// returns:
+//   - error: 不是合法的 svg 时返回 ErrInvalidSvg
+func SanitizeSvg(r io.Reader, w io.Writer) error {
+	decoder := xml.NewDecoder(r)
+	// 不展开实体, 未定义的实体视为错误
+	decoder.Strict = true
+	var buf bytes.Buffer
+	depth, styleDepth := 0, 0
+	rootSeen := false
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return ErrInvalidSvg
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := strings.ToLower(t.Name.Local)
+			if depth == 0 {
+				if rootSeen || name != "svg" {
+					return ErrInvalidSvg
+				}
+				rootSeen = true
+			}
+			if !safeSvgElement(name, t.Attr) {
+				if err = skipElement(decoder); err != nil {
+					return ErrInvalidSvg
+				}
+				continue
+			}
+			depth++
+			if name == "style" && styleDepth == 0 {
+				styleDepth = depth
+			}
+			buf.WriteByte('<')
+			writeXmlName(&buf, t.Name)
+			for _, attr := range t.Attr {
+				if !safeSvgAttr(attr) {
+					continue
+				}
+				buf.WriteByte(' ')
+				writeXmlName(&buf, attr.Name)
+				buf.WriteString(`="`)
+				buf.WriteString(svgAttrEscaper.Replace(attr.Value))
+				buf.WriteByte('"')
+			}
+			buf.WriteByte('>')
+		case xml.EndElement:
+			if depth == 0 {
+				return ErrInvalidSvg
+			}
+			if depth == styleDepth {
+				styleDepth = 0
+			}
+			depth--
+			buf.WriteString("</")
+			writeXmlName(&buf, t.Name)
+			buf.WriteByte('>')
+		case xml.CharData:
+			// 样式中含外部引用时清空
+			if styleDepth > 0 && unsafeCss(string(t)) {
+				continue
+			}
+			buf.WriteString(svgTextEscaper.Replace(string(t)))
+		case xml.ProcInst:
+			if t.Target == "xml" && !rootSeen {
+				buf.WriteString(`<?xml version="1.0" encoding="UTF-8"?>`)
+			}
+		}
+		// 注释与 DOCTYPE 等声明直接丢弃
+	}
+	if !rootSeen || depth != 0 {
+		return ErrInvalidSvg
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func writeXmlName(buf *bytes.Buffer, name xml.Name) {
+	if name.Space != "" {
+		buf.WriteString(name.Space)
+		buf.WriteByte(':')
+	}
+	buf.WriteString(name.Local)
+}
+
+// skipElement - 跳过当前元素的全部子节点
+func skipElement(decoder *xml.Decoder) error {
+	for depth := 1; depth > 0; {
+		token, err := decoder.RawToken()
+		if err != nil {
+			return err
+		}
+		switch token.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
+
+func safeSvgElement(name string, attrs []xml.Attr) bool {
+	if _, ok := svgUnsafeElements[name]; ok {
+		return false
+	}
+	// 动画元素可在运行时将链接改写为外部地址
+	if _, ok := svgAnimateElements[name]; ok {
+		for _, attr := range attrs {
+			if attr.Name.Local == "attributeName" && strings.HasSuffix(strings.ToLower(strings.TrimSpace(attr.Value)), "href") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func safeSvgAttr(attr xml.Attr) bool {
+	name := strings.ToLower(attr.Name.Local)
+	// 命名空间声明仅为标识, 不会被加载
+	if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && name == "xmlns") {
+		return true
+	}
+	// 事件属性
+	if strings.HasPrefix(name, "on") {
+		return false
+	}
+	// xml:base 会改变相对地址的解析
+	if attr.Name.Space == "xml" && name == "base" {
+		return false
+	}
+	if name == "href" || name == "src" {
+		return localRef(attr.Value)
+	}
+	value := compactValue(attr.Value)
+	if strings.Contains(value, "javascript:") || strings.Contains(value, "vbscript:") {
+		return false
+	}
+	return !unsafeCss(attr.Value)
+}
+
+// localRef - 仅允许文档内引用与内嵌位图
+func localRef(value string) bool {
+	value = compactValue(value)
+	if strings.HasPrefix(value, "#") {
+		return true
+	}
+	for _, prefix := range []string{"data:image/png", "data:image/jpeg", "data:image/gif", "data:image/webp"} {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// unsafeCss - 样式中含外部引用或表达式
+func unsafeCss(css string) bool {
+	css = compactValue(css)
+	if strings.Contains(css, "@import") || strings.Contains(css, "expression(") ||
+		strings.Contains(css, "javascript:") || strings.Contains(css, "vbscript:") {
+		return true
+	}
+	for rest := css; ; {
+		i := strings.Index(rest, "url(")
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+len("url("):]
+		target := strings.TrimLeft(rest, `"'`)
+		if !localRef(target) {
+			return true
+		}
+	}
+}
+
+// compactValue - 移除空白与控制字符并转为小写, 避免通过插入空白绕过检测
+func compactValue(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7F {
+			return -1
+		}
+		return r
+	}, strings.ToLower(value))
+}
+
+// RasterizeSvg - 将 svg 渲染为位图
+// params:
+//   - r: svg 内容
+//   - maxSize: 最长边上限, 超出时等比缩小
+//
+// returns:
+//   - img
+//   - error: nil on success, non-nil on failure
+func RasterizeSvg(r io.ReadSeeker, maxSize int) (image.Image, error) {
+	info, err := DecodeInfo(r)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	icon, err := oksvg.ReadIconStream(r)
+	if err != nil {
+		return nil, ErrInvalidSvg
+	}
+	w, h := float64(info.Width), float64(info.Height)
+	if longest := math.Max(w, h); maxSize > 0 && longest > float64(maxSize) {
+		w, h = w*float64(maxSize)/longest, h*float64(maxSize)/longest
+	}
+	width, height := max(int(math.Round(w)), 1), max(int(math.Round(h)), 1)
+	if icon.ViewBox.W == 0 || icon.ViewBox.H == 0 {
+		icon.ViewBox.W, icon.ViewBox.H = float64(info.Width), float64(info.Height)
+	}
+	icon.SetTarget(0, 0, float64(width), float64(height))
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	scanner := rasterx.NewScannerGV(width, height, img, img.Bounds())
+	icon.Draw(rasterx.NewDasher(width, height, scanner), 1)
+	return img, nil
+}
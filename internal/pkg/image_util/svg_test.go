@@ -0,0 +1,133 @@
+package image_util
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSanitizeSvg(t *testing.T) {
+	tests := []struct {
+		name string
+		svg  string
+		// 清理后不应出现的内容
+		removed []string
+		// 清理后应保留的内容
+		kept []string
+	}{
+		{
+			name:    "script element",
+			svg:     `<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script><rect width="1" height="1"/></svg>`,
+			removed: []string{"script", "alert"},
+			kept:    []string{`<rect width="1" height="1">`},
+		},
+		{
+			name:    "nested script in foreignObject",
+			svg:     `<svg><foreignObject><div><script>alert(1)</script></div></foreignObject><g/></svg>`,
+			removed: []string{"foreignObject", "div", "alert"},
+			kept:    []string{"<g>"},
+		},
+		{
+			name:    "event attributes",
+			svg:     `<svg onload="alert(1)"><rect ONCLICK="alert(2)" onMouseOver="alert(3)" fill="red"/></svg>`,
+			removed: []string{"onload", "ONCLICK", "onMouseOver", "alert"},
+			kept:    []string{`fill="red"`},
+		},
+		{
+			name:    "javascript href",
+			svg:     `<svg xmlns:xlink="http://www.w3.org/1999/xlink"><a href="javascript:alert(1)"><use xlink:href=" JaVaScRiPt:alert(2)"/></a></svg>`,
+			removed: []string{"href", "alert"},
+			kept:    []string{`xmlns:xlink="http://www.w3.org/1999/xlink"`, "<a>", "<use>"},
+		},
+		{
+			name:    "data href",
+			svg:     `<svg><image href="data:text/html;base64,PHNjcmlwdD4="/><image href="data:image/svg+xml;base64,PHN2Zz4="/></svg>`,
+			removed: []string{"text/html", "image/svg+xml"},
+		},
+		{
+			name:    "external href",
+			svg:     `<svg><use href="https://example.com/a.svg#x"/><image src="//example.com/a.png"/></svg>`,
+			removed: []string{"example.com"},
+		},
+		{
+			name: "local and embedded bitmap href",
+			svg:  `<svg><use href="#shape"/><image href="data:image/png;base64,iVBORw0KGgo="/></svg>`,
+			kept: []string{`href="#shape"`, `href="data:image/png;base64,iVBORw0KGgo="`},
+		},
+		{
+			name:    "entity encoded javascript",
+			svg:     `<svg><a href="&#106;ava&#x73;cript:alert(1)"/><a href="java&#9;script:alert(2)"/><rect style="fill:url(&#x6A;avascript:alert(3))"/></svg>`,
+			removed: []string{"script", "alert"},
+		},
+		{
+			name:    "css url in attribute",
+			svg:     `<svg><rect style="fill: URL( 'https://example.com/a.png' )"/><rect fill="url(#grad)"/></svg>`,
+			removed: []string{"example.com"},
+			kept:    []string{`fill="url(#grad)"`},
+		},
+		{
+			name:    "css import and url in style element",
+			svg:     `<svg><style>@import "https://example.com/a.css";</style><style>rect { fill: url(https://example.com/a.png) }</style><style>rect { fill: red }</style></svg>`,
+			removed: []string{"@import", "example.com"},
+			kept:    []string{"rect { fill: red }"},
+		},
+		{
+			name:    "set href",
+			svg:     `<svg><a><set attributeName="href" to="javascript:alert(1)"/><animate attributeName="xlink:href" values="https://example.com"/><set attributeName="fill" to="red"/></a></svg>`,
+			removed: []string{"javascript", "example.com", `attributeName="href"`},
+			kept:    []string{`<set attributeName="fill" to="red">`},
+		},
+		{
+			name:    "xml base",
+			svg:     `<svg xml:base="https://example.com/"><use href="#a"/></svg>`,
+			removed: []string{"xml:base", "example.com"},
+		},
+		{
+			name:    "doctype and comments",
+			svg:     `<?xml version="1.0"?><!DOCTYPE svg><!-- comment --><svg><g/></svg>`,
+			removed: []string{"DOCTYPE", "comment"},
+			kept:    []string{`<?xml version="1.0" encoding="UTF-8"?>`, "<svg><g></g></svg>"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := SanitizeSvg(strings.NewReader(tt.svg), &buf); err != nil {
+				t.Fatalf("SanitizeSvg() error = %v", err)
+			}
+			output := buf.String()
+			for _, s := range tt.removed {
+				if strings.Contains(strings.ToLower(output), strings.ToLower(s)) {
+					t.Errorf("SanitizeSvg() = %s, should not contain %q", output, s)
+				}
+			}
+			for _, s := range tt.kept {
+				if !strings.Contains(output, s) {
+					t.Errorf("SanitizeSvg() = %s, should contain %q", output, s)
+				}
+			}
+		})
+	}
+}
+
+func TestSanitizeSvgInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		svg  string
+	}{
+		{name: "not svg root", svg: `<html><svg/></html>`},
+		{name: "multiple roots", svg: `<svg/><svg/>`},
+		{name: "unclosed", svg: `<svg><g></svg>`},
+		{name: "undefined entity", svg: `<!DOCTYPE svg [<!ENTITY x "javascript:">]><svg><a href="&x;alert(1)"/></svg>`},
+		{name: "empty", svg: ``},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := SanitizeSvg(strings.NewReader(tt.svg), &buf); !errors.Is(err, ErrInvalidSvg) {
+				t.Errorf("SanitizeSvg() error = %v, want %v", err, ErrInvalidSvg)
+			}
+		})
+	}
+}
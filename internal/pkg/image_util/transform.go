@@ -0,0 +1,139 @@
+package image_util
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+)
+
+const (
+	FitInside  = "inside"
+	FitCover   = "cover"
+	FitContain = "contain"
+	FitFill    = "fill"
+
+	FormatJpeg = "jpeg"
+	FormatPng  = "png"
+	FormatWebp = "webp"
+)
+
+var ErrUnsupportedFormat = errors.New("image_util: unsupported output format")
+
+type TransformOption struct {
+	// 目标宽高, 为 0 时按比例计算
+	Width  int
+	Height int
+	// 缩放方式 inside | cover | contain | fill
+	Fit string
+}
+
+// Transform - 按目标尺寸缩放图片, 不会放大原图
+// params:
+//   - img
+//   - opt: 缩放选项, 宽高均为 0 时返回原图
+//
+// returns:
+//   - img: 缩放后的图片
+func Transform(img image.Image, opt *TransformOption) image.Image {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if srcW == 0 || srcH == 0 || (opt.Width <= 0 && opt.Height <= 0) {
+		return img
+	}
+	dstW, dstH := opt.Width, opt.Height
+	// 只指定一边时其余按比例计算, 各方式效果一致
+	if dstW <= 0 {
+		dstW = int(math.Round(float64(srcW) * float64(dstH) / float64(srcH)))
+	}
+	if dstH <= 0 {
+		dstH = int(math.Round(float64(srcH) * float64(dstW) / float64(srcW)))
+	}
+	scaleW, scaleH := float64(dstW)/float64(srcW), float64(dstH)/float64(srcH)
+	// 按比例计算的一边沿用指定边的缩放比例, 避免取整误差
+	if opt.Width <= 0 {
+		scaleW = scaleH
+	}
+	if opt.Height <= 0 {
+		scaleH = scaleW
+	}
+	switch opt.Fit {
+	case FitFill:
+		// 目标尺寸不超过原图
+		return resize(img, bounds, min(dstW, srcW), min(dstH, srcH))
+	case FitCover:
+		scale := math.Min(math.Max(scaleW, scaleH), 1)
+		cropW := min(int(math.Round(float64(dstW)/scale)), srcW)
+		cropH := min(int(math.Round(float64(dstH)/scale)), srcH)
+		x := bounds.Min.X + (srcW-cropW)/2
+		y := bounds.Min.Y + (srcH-cropH)/2
+		return resize(img, image.Rect(x, y, x+cropW, y+cropH), min(dstW, cropW), min(dstH, cropH))
+	case FitContain:
+		scale := math.Min(math.Min(scaleW, scaleH), 1)
+		w := max(int(math.Round(float64(srcW)*scale)), 1)
+		h := max(int(math.Round(float64(srcH)*scale)), 1)
+		// 居中放置在透明画布上, 画布仅按指定的边补齐, 按比例计算的边可能远超原图
+		canvasW, canvasH := w, h
+		if opt.Width > 0 {
+			canvasW = max(opt.Width, w)
+		}
+		if opt.Height > 0 {
+			canvasH = max(opt.Height, h)
+		}
+		canvas := image.NewRGBA(image.Rect(0, 0, canvasW, canvasH))
+		offset := image.Pt((canvas.Rect.Dx()-w)/2, (canvas.Rect.Dy()-h)/2)
+		draw.CatmullRom.Scale(canvas, image.Rectangle{Min: offset, Max: offset.Add(image.Pt(w, h))}, img, bounds, draw.Over, nil)
+		return canvas
+	default:
+		scale := math.Min(math.Min(scaleW, scaleH), 1)
+		w := max(int(math.Round(float64(srcW)*scale)), 1)
+		h := max(int(math.Round(float64(srcH)*scale)), 1)
+		return resize(img, bounds, w, h)
+	}
+}
+
+func resize(img image.Image, src image.Rectangle, w, h int) image.Image {
+	if src == img.Bounds() && w == src.Dx() && h == src.Dy() {
+		return img
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Rect, img, src, draw.Src, nil)
+	return dst
+}
+
+// Encode - 按指定格式编码图片
+// params:
+//   - w
+//   - img
+//   - format: jpeg | png | webp
+//   - quality: jpeg 质量 1 ~ 100, 其余格式为无损编码
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func Encode(w io.Writer, img image.Image, format string, quality int) error {
+	switch format {
+	case FormatJpeg:
+		// jpeg 不支持透明, 以白色为底
+		canvas := image.NewRGBA(img.Bounds())
+		draw.Draw(canvas, canvas.Rect, image.NewUniform(color.White), image.Point{}, draw.Src)
+		draw.Draw(canvas, canvas.Rect, img, img.Bounds().Min, draw.Over)
+		return jpeg.Encode(w, canvas, &jpeg.Options{Quality: quality})
+	case FormatPng:
+		return png.Encode(w, img)
+	case FormatWebp:
+		return nativewebp.Encode(w, img, nil)
+	default:
+		return ErrUnsupportedFormat
+	}
+}
+
+// ContentType - 输出格式对应的 Content-Type
+func ContentType(format string) string {
+	return "image/" + format
+}
@@ -0,0 +1,122 @@
+package image_util
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	PositionTopLeft     = "top-left"
+	PositionTopRight    = "top-right"
+	PositionBottomLeft  = "bottom-left"
+	PositionBottomRight = "bottom-right"
+	PositionCenter      = "center"
+
+	// 文字水印先以该字号渲染, 再缩放至目标宽度
+	watermarkFontSize = 64
+)
+
+var ErrEmptyText = errors.New("image_util: empty watermark text")
+
+type Watermark struct {
+	// 水印内容, 已渲染为透明背景的图片
+	Mark     image.Image
+	Position string
+	// 不透明度 0 ~ 1
+	Opacity float64
+	// 水印宽度占图片宽度的比例 0 ~ 1
+	Scale float64
+}
+
+// RenderText - 将文字渲染为透明背景的图片, 用作水印内容
+// params:
+//   - text
+//   - c: 文字颜色
+//
+// returns:
+//   - mark
+//   - error: nil on success, non-nil on failure
+func RenderText(text string, c color.Color) (image.Image, error) {
+	otf, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, err
+	}
+	face, err := opentype.NewFace(otf, &opentype.FaceOptions{Size: watermarkFontSize, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, err
+	}
+	defer face.Close()
+	bounds, _ := font.BoundString(face, text)
+	width := (bounds.Max.X - bounds.Min.X).Ceil()
+	height := (bounds.Max.Y - bounds.Min.Y).Ceil()
+	if width <= 0 || height <= 0 {
+		return nil, ErrEmptyText
+	}
+	mark := image.NewRGBA(image.Rect(0, 0, width, height))
+	drawer := &font.Drawer{
+		Dst:  mark,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.Point26_6{X: -bounds.Min.X, Y: -bounds.Min.Y},
+	}
+	drawer.DrawString(text)
+	return mark, nil
+}
+
+// ApplyWatermark - 按位置、比例与不透明度将水印绘制到图片上
+// params:
+//   - img
+//   - wm: 水印配置, 为 nil 时返回原图
+//
+// returns:
+//   - img: 添加水印后的图片
+func ApplyWatermark(img image.Image, wm *Watermark) image.Image {
+	if wm == nil || wm.Mark == nil || wm.Opacity <= 0 {
+		return img
+	}
+	bounds := img.Bounds()
+	markBounds := wm.Mark.Bounds()
+	if bounds.Dx() == 0 || bounds.Dy() == 0 || markBounds.Dx() == 0 || markBounds.Dy() == 0 {
+		return img
+	}
+	// 水印按宽度比例缩放, 且不超出图片
+	scale := math.Min(math.Max(wm.Scale, 0), 1)
+	w := float64(bounds.Dx()) * scale
+	h := w * float64(markBounds.Dy()) / float64(markBounds.Dx())
+	if h > float64(bounds.Dy()) {
+		w, h = w*float64(bounds.Dy())/h, float64(bounds.Dy())
+	}
+	markW, markH := int(math.Round(w)), int(math.Round(h))
+	if markW <= 0 || markH <= 0 {
+		return img
+	}
+	margin := int(math.Round(math.Min(float64(bounds.Dx()), float64(bounds.Dy())) * 0.02))
+	var x, y int
+	switch wm.Position {
+	case PositionTopLeft:
+		x, y = margin, margin
+	case PositionTopRight:
+		x, y = bounds.Dx()-markW-margin, margin
+	case PositionBottomLeft:
+		x, y = margin, bounds.Dy()-markH-margin
+	case PositionCenter:
+		x, y = (bounds.Dx()-markW)/2, (bounds.Dy()-markH)/2
+	default:
+		x, y = bounds.Dx()-markW-margin, bounds.Dy()-markH-margin
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Rect, img, bounds.Min, draw.Src)
+	scaled := image.NewRGBA(image.Rect(0, 0, markW, markH))
+	draw.CatmullRom.Scale(scaled, scaled.Rect, wm.Mark, markBounds, draw.Src, nil)
+	mask := image.NewUniform(color.Alpha{A: uint8(math.Round(math.Min(wm.Opacity, 1) * 255))})
+	draw.DrawMask(dst, image.Rect(x, y, x+markW, y+markH), scaled, image.Point{}, mask, image.Point{}, draw.Over)
+	return dst
+}
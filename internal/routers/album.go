@@ -0,0 +1,26 @@
+package routers
+
+import (
+	"github.com/Alf-Grindel/clide/internal/handlers/album_handler"
+	"github.com/Alf-Grindel/clide/internal/mw"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterAlbumRouters(h *server.Hertz) {
+	// public album router
+	albumPublicGroup := h.Group("/album", mw.OptionalAuthMiddleware())
+
+	// auth album router
+	albumAuthGroup := h.Group("/album", mw.AuthMiddleware())
+
+	albumPublicGroup.GET("/get", album_handler.GetAlbum)
+	albumPublicGroup.GET("/list", album_handler.ListAlbum)
+	albumPublicGroup.GET("/picture/list", album_handler.ListAlbumPicture)
+
+	albumAuthGroup.POST("/add", album_handler.AddAlbum)
+	albumAuthGroup.POST("/edit", album_handler.EditAlbum)
+	albumAuthGroup.POST("/delete", album_handler.DeleteAlbum)
+	albumAuthGroup.POST("/picture/add", album_handler.AddAlbumPicture)
+	albumAuthGroup.POST("/picture/remove", album_handler.RemoveAlbumPicture)
+	albumAuthGroup.POST("/picture/sort", album_handler.SortAlbumPicture)
+}
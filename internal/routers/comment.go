@@ -0,0 +1,29 @@
+package routers
+
+import (
+	"github.com/Alf-Grindel/clide/internal/handlers/comment_handler"
+	"github.com/Alf-Grindel/clide/internal/mw"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterCommentRouters(h *server.Hertz) {
+	// public comment router
+	commentPublicGroup := h.Group("/comment", mw.OptionalAuthMiddleware())
+
+	// auth comment router
+	commentAuthGroup := h.Group("/comment", mw.AuthMiddleware())
+
+	// admin-only comment router
+	adminGroup := commentAuthGroup.Group("/admin", mw.AdminMiddleware())
+
+	commentPublicGroup.GET("/list", comment_handler.ListComment)
+	commentPublicGroup.GET("/reply/list", comment_handler.ListCommentReply)
+
+	commentAuthGroup.POST("/add", comment_handler.AddComment)
+	commentAuthGroup.POST("/edit", comment_handler.EditComment)
+	commentAuthGroup.POST("/delete", comment_handler.DeleteComment)
+	commentAuthGroup.POST("/hide", comment_handler.HideComment)
+
+	adminGroup.GET("/query", comment_handler.QueryComment)
+	adminGroup.POST("/review", comment_handler.ReviewComment)
+}
@@ -16,13 +16,28 @@ func RegisterFileRouters(h *server.Hertz) {
 	// admin-only file router
 	adminGroup := fileAuthGroup.Group("/admin", mw.AdminMiddleware())
 
-	filePublicGroup.GET("/search", file_handler.PictureSearch)
-	filePublicGroup.GET("/get", file_handler.PictureGetById)
+	filePublicGroup.GET("/search", mw.OptionalAuthMiddleware(), file_handler.PictureSearch)
+	filePublicGroup.GET("/get", mw.OptionalAuthMiddleware(), file_handler.PictureGetById)
 	filePublicGroup.GET("/tag_category", file_handler.PictureListTagCategory)
+	filePublicGroup.GET("/image/:id", mw.OptionalAuthMiddleware(), file_handler.PictureImage)
 
 	fileAuthGroup.POST("/edit", file_handler.PictureEdit)
+	fileAuthGroup.POST("/delete", file_handler.PictureDelete)
+	fileAuthGroup.POST("/like", file_handler.PictureLike)
+	fileAuthGroup.POST("/favorite", file_handler.PictureFavorite)
+	fileAuthGroup.GET("/favorite/list", file_handler.ListFavoritePicture)
+	fileAuthGroup.GET("/feed", file_handler.PictureFeed)
 	fileAuthGroup.POST("/upload", file_handler.UploadPicture)
 	fileAuthGroup.POST("/upload/url", file_handler.UploadPictureByUrl)
+	fileAuthGroup.POST("/upload/archive", file_handler.UploadPictureByArchive)
+	fileAuthGroup.POST("/search/similar", file_handler.SearchSimilarPicture)
+	fileAuthGroup.POST("/upload/session/init", file_handler.InitUploadSession)
+	fileAuthGroup.PUT("/upload/session/chunk", file_handler.UploadSessionChunk)
+	fileAuthGroup.GET("/upload/session/get", file_handler.GetUploadSession)
+	fileAuthGroup.POST("/upload/session/complete", file_handler.CompleteUploadSession)
+	fileAuthGroup.POST("/upload/session/abort", file_handler.AbortUploadSession)
+	fileAuthGroup.POST("/upload/presign", file_handler.PresignUpload)
+	fileAuthGroup.POST("/upload/presign/confirm", file_handler.ConfirmUpload)
 
 	// admin - only file
 	adminGroup.POST("/delete", file_handler.DeletePicture)
@@ -1,8 +1,40 @@
 package routers
 
-import "github.com/cloudwego/hertz/pkg/app/server"
+import (
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/mw"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
 
 func RegisterRouters(h *server.Hertz) {
+	h.Use(mw.BodyLimitMiddleware(constants.MaxRequestBodySize, bodyLimits()))
+
 	RegisterUserRouters(h)
 	RegisterFileRouters(h)
+	RegisterSpaceRouters(h)
+	RegisterAlbumRouters(h)
+	RegisterCommentRouters(h)
+	RegisterStorageRouters(h)
+}
+
+// bodyLimits - 请求体需超出默认上限的路由
+func bodyLimits() map[string]int64 {
+	maxArchiveSize := config.Upload.MaxArchiveSize
+	if maxArchiveSize <= 0 {
+		maxArchiveSize = constants.DefaultMaxArchiveSize
+	}
+	limits := map[string]int64{
+		// 需容纳压缩包与表单字段
+		"/file/upload/archive": maxArchiveSize + constants.MaxFileSize,
+	}
+	// 本地存储驱动的预签名直传
+	if config.Storage.Driver == constants.StorageDriverLocal {
+		maxPresignSize := config.Upload.MaxSessionFileSize
+		if maxPresignSize <= 0 {
+			maxPresignSize = constants.DefaultMaxSessionFileSize
+		}
+		limits[localStorageRoute()+"/*key"] = maxPresignSize
+	}
+	return limits
 }
@@ -0,0 +1,31 @@
+package routers
+
+import (
+	"github.com/Alf-Grindel/clide/internal/handlers/space_handler"
+	"github.com/Alf-Grindel/clide/internal/mw"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+func RegisterSpaceRouters(h *server.Hertz) {
+	// auth space router
+	spaceAuthGroup := h.Group("/space", mw.AuthMiddleware())
+	// admin - only router
+	adminGroup := spaceAuthGroup.Group("/admin", mw.AdminMiddleware())
+
+	spaceAuthGroup.POST("/add", space_handler.AddSpace)
+	spaceAuthGroup.GET("/get", space_handler.GetSpace)
+	spaceAuthGroup.POST("/edit", space_handler.EditSpace)
+	spaceAuthGroup.GET("/level/list", space_handler.ListSpaceLevel)
+	spaceAuthGroup.GET("/picture/search", space_handler.SearchSpacePicture)
+	spaceAuthGroup.GET("/list/my", space_handler.ListMySpace)
+	spaceAuthGroup.POST("/member/add", space_handler.AddSpaceUser)
+	spaceAuthGroup.POST("/member/delete", space_handler.DeleteSpaceUser)
+	spaceAuthGroup.POST("/member/edit", space_handler.EditSpaceUser)
+	spaceAuthGroup.GET("/member/list", space_handler.ListSpaceUser)
+	spaceAuthGroup.GET("/invite/list/my", space_handler.ListMySpaceInvite)
+	spaceAuthGroup.POST("/invite/accept", space_handler.AcceptSpaceInvite)
+	spaceAuthGroup.POST("/invite/decline", space_handler.DeclineSpaceInvite)
+
+	adminGroup.POST("/update", space_handler.UpdateSpace)
+	adminGroup.GET("/query", space_handler.QuerySpace)
+}
@@ -0,0 +1,31 @@
+package routers
+
+import (
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/handlers/storage_handler"
+	"github.com/Alf-Grindel/clide/internal/mw"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/server"
+	"strings"
+)
+
+func RegisterStorageRouters(h *server.Hertz) {
+	// 仅本地存储驱动需要由服务自身提供文件访问
+	if config.Storage.Driver != constants.StorageDriverLocal {
+		return
+	}
+	route := localStorageRoute()
+	// 私有对象需要携带签名访问
+	h.Group(route, mw.StorageSignMiddleware()).StaticFS("/", &app.FS{
+		Root:        config.Storage.Local.Root,
+		PathRewrite: app.NewPathSlashesStripper(strings.Count(route, "/")),
+	})
+	// 预签名直传
+	h.PUT(route+"/*key", storage_handler.PutObject)
+}
+
+// localStorageRoute - 本地存储驱动的文件访问路由
+func localStorageRoute() string {
+	return "/" + strings.Trim(config.Storage.Local.Route, "/")
+}
@@ -21,6 +21,10 @@ func RegisterUserRouters(h *server.Hertz) {
 	userAuthGroup.POST("/logout", user_handler.UserLogout)
 	userAuthGroup.POST("/edit", user_handler.UserEdit)
 	userAuthGroup.GET("/search", user_handler.UserSearch)
+	userAuthGroup.POST("/follow", user_handler.FollowUser)
+	userAuthGroup.POST("/unfollow", user_handler.UnfollowUser)
+	userAuthGroup.GET("/follower/list", user_handler.ListFollower)
+	userAuthGroup.GET("/following/list", user_handler.ListFollowing)
 
 	adminGroup.POST("/add", user_handler.AddUser)
 	adminGroup.POST("/delete", user_handler.DeleteUser)
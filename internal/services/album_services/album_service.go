@@ -0,0 +1,119 @@
+package album_services
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_album"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
+	"github.com/Alf-Grindel/clide/internal/model"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/services/picture_services"
+	"github.com/Alf-Grindel/clide/internal/services/user_services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"time"
+)
+
+type AlbumService struct {
+	ctx context.Context
+}
+
+func NewAlbumService(ctx context.Context) *AlbumService {
+	return &AlbumService{ctx}
+}
+
+// ObjToVo - 转换为脱敏对象
+func ObjToVo(oldAlbum *db_album.Album, oldUser *db_user.User, cover *base.PictureVo, pictureCount int64) *base.AlbumVo {
+	if oldAlbum == nil {
+		return nil
+	}
+	return &base.AlbumVo{
+		ID:           oldAlbum.Id,
+		AlbumName:    oldAlbum.AlbumName,
+		Introduction: oldAlbum.Introduction,
+		CoverId:      oldAlbum.CoverId,
+		Cover:        cover,
+		Visibility:   int32(oldAlbum.Visibility),
+		PictureCount: pictureCount,
+		UserId:       oldAlbum.UserId,
+		User:         user_services.ObjToVo(oldUser),
+		EditTime:     oldAlbum.EditTime.Format(time.DateTime),
+		CreateTime:   oldAlbum.CreateTime.Format(time.DateTime),
+	}
+}
+
+// ObjsToVos - 转换为脱敏列表
+// params:
+//   - oldAlbums
+//   - loginUser: 当前用户, 决定封面与图片数量中可见的图片, 未登录时为 nil
+func ObjsToVos(ctx context.Context, oldAlbums []*db_album.Album, loginUser *model.LoginUser) []*base.AlbumVo {
+	if oldAlbums == nil {
+		return nil
+	}
+	var albums []*base.AlbumVo
+	for _, oldAlbum := range oldAlbums {
+		album, err := albumToVo(ctx, oldAlbum, loginUser)
+		if err != nil {
+			return nil
+		}
+		albums = append(albums, album)
+	}
+	return albums
+}
+
+// albumToVo - 查询创建者、封面与可见图片数量后转换为脱敏对象, 封面不可见时使用第一张可见图片
+func albumToVo(ctx context.Context, oldAlbum *db_album.Album, loginUser *model.LoginUser) (*base.AlbumVo, error) {
+	userId := loginUserId(loginUser)
+	oldUser, err := db_user.QueryUserById(ctx, oldAlbum.UserId)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	total, firstPictures, err := db_album.QueryAlbumPicture(ctx, oldAlbum.Id, userId, 1, 1)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	var coverPicture *db_picture.Picture
+	if oldAlbum.CoverId != 0 {
+		oldPicture, err := db_picture.QueryPictureById(ctx, oldAlbum.CoverId)
+		if err == nil && canAddPicture(oldPicture, userId) {
+			coverPicture = oldPicture
+		}
+	}
+	if coverPicture == nil && len(firstPictures) > 0 {
+		coverPicture = firstPictures[0]
+	}
+	var cover *base.PictureVo
+	if coverPicture != nil {
+		coverUser, err := db_user.QueryUserById(ctx, coverPicture.UserId)
+		if err != nil {
+			return nil, errno.NotFoundErr
+		}
+		cover = picture_services.ObjToVo(coverPicture, coverUser, loginUser)
+	}
+	return ObjToVo(oldAlbum, oldUser, cover, total), nil
+}
+
+// canAddPicture - 相册仅能收录公共图库中本人的图片或他人已审核通过的图片
+func canAddPicture(oldPicture *db_picture.Picture, userId int64) bool {
+	if oldPicture.SpaceId != 0 {
+		return false
+	}
+	return oldPicture.UserId == userId || oldPicture.ReviewStatus == constants.ReviewPictureMap["通过"]
+}
+
+// checkAlbumView - 公开相册所有人可见, 私密相册仅创建者与管理员可见
+// params:
+//   - oldAlbum
+//   - loginUser: 未登录时为 nil
+//
+// returns:
+//   - error: 无权查看时返回 NotFoundErr, 不暴露相册是否存在
+func checkAlbumView(oldAlbum *db_album.Album, loginUser *model.LoginUser) error {
+	if oldAlbum.Visibility == constants.AlbumVisibilityPublic {
+		return nil
+	}
+	if loginUser == nil || (loginUser.Id != oldAlbum.UserId && loginUser.Role != "admin") {
+		return errno.NotFoundErr.WithMessage("相册不存在")
+	}
+	return nil
+}
@@ -0,0 +1,339 @@
+package album_services
+
+import (
+	"context"
+	"errors"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_album"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/model"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/model/clide/album"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"time"
+)
+
+// AddAlbum 创建相册 - 默认为私密相册
+// params:
+//   - req: 创建相册请求体
+//     required: albumName
+//     optional: introduction, visibility
+//   - c: 请求上下文
+//
+// returns:
+//   - albumId
+//   - error: nil on success, non-nil on failure
+func (s *AlbumService) AddAlbum(req *album.AddAlbumReq, c *app.RequestContext) (int64, error) {
+	if req == nil {
+		return 0, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return 0, err
+	}
+	addAlbum := &db_album.Album{
+		AlbumName:    req.AlbumName,
+		Introduction: req.GetIntroduction(),
+		Visibility:   int(req.GetVisibility()),
+		UserId:       loginUser.Id,
+	}
+	id, err := db_album.CreateAlbum(s.ctx, addAlbum)
+	if err != nil {
+		return 0, errno.OperationErr.WithMessage("创建相册失败")
+	}
+	return id, nil
+}
+
+// EditAlbum 编辑相册 - 只能编辑自己的相册, 封面需为相册内的图片
+// params:
+//   - req: 编辑相册请求体
+//     required: albumId
+//     optional: albumName, introduction, visibility, coverId
+//   - c: 请求上下文
+//
+// returns:
+//   - albumVo: 相册脱敏信息
+//   - error: nil on success, non-nil on failure
+func (s *AlbumService) EditAlbum(req *album.EditAlbumReq, c *app.RequestContext) (*base.AlbumVo, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	if req.AlbumName == nil && req.Introduction == nil && req.Visibility == nil && req.CoverID == nil {
+		return nil, errno.ParamErr.WithMessage("未有更新数据")
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	oldAlbum, err := s.checkAlbumOwner(req.ID, loginUser)
+	if err != nil {
+		return nil, err
+	}
+	if req.CoverID != nil && req.GetCoverID() != 0 {
+		pictureIds, err := db_album.QueryAlbumPictureIds(s.ctx, req.ID)
+		if err != nil {
+			return nil, errno.OperationErr
+		}
+		if !containsId(pictureIds, req.GetCoverID()) {
+			return nil, errno.ParamErr.WithMessage("封面图片不在相册中")
+		}
+	}
+	updates := &db_album.Album{
+		Id:           req.ID,
+		AlbumName:    req.GetAlbumName(),
+		Introduction: req.GetIntroduction(),
+		EditTime:     time.Now(),
+	}
+	var visibility *int
+	if req.Visibility != nil {
+		v := int(req.GetVisibility())
+		visibility = &v
+	}
+	if err = db_album.UpdateAlbum(s.ctx, updates); err != nil {
+		return nil, errno.OperationErr.WithMessage("更新失败")
+	}
+	// 可见性与封面可能为 0, 单独写入
+	if err = db_album.UpdateAlbumSetting(s.ctx, req.ID, visibility, req.CoverID); err != nil {
+		return nil, errno.OperationErr.WithMessage("更新失败")
+	}
+	oldAlbum, err = db_album.QueryAlbumById(s.ctx, oldAlbum.Id)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	return albumToVo(s.ctx, oldAlbum, loginUser)
+}
+
+// DeleteAlbum 删除相册 - 仅创建者或管理员可删除, 相册内的图片不受影响
+// params:
+//   - req: 删除相册请求体
+//     required: albumId
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *AlbumService) DeleteAlbum(req *album.DeleteAlbumReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	oldAlbum, err := db_album.QueryAlbumById(s.ctx, req.ID)
+	if err != nil {
+		return errno.NotFoundErr.WithMessage("相册不存在")
+	}
+	if oldAlbum.UserId != loginUser.Id && loginUser.Role != "admin" {
+		return errno.NoAuthErr
+	}
+	if err = db_album.DeleteAlbum(s.ctx, req.ID); err != nil {
+		return errno.OperationErr.WithMessage("删除相册失败")
+	}
+	return nil
+}
+
+// AddAlbumPicture 向相册添加图片 - 追加到相册末尾, 已在相册中的图片会被忽略
+// params:
+//   - req: 添加图片请求体
+//     required: albumId, pictureIds
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *AlbumService) AddAlbumPicture(req *album.AddAlbumPictureReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	if _, err = s.checkAlbumOwner(req.AlbumID, loginUser); err != nil {
+		return err
+	}
+	var addIds []int64
+	for _, pictureId := range req.PictureIds {
+		if containsId(addIds, pictureId) {
+			continue
+		}
+		oldPicture, err := db_picture.QueryPictureById(s.ctx, pictureId)
+		if err != nil {
+			return errno.NotFoundErr.WithMessage("图片不存在")
+		}
+		if !canAddPicture(oldPicture, loginUser.Id) {
+			return errno.NoAuthErr.WithMessage("仅能添加本人或已审核通过的图片")
+		}
+		addIds = append(addIds, pictureId)
+	}
+	// 锁定相册, 避免并发添加时顺序冲突或超出数量上限
+	err = db.Transaction(s.ctx, func(ctx context.Context) error {
+		if err := db_album.LockAlbumById(ctx, req.AlbumID); err != nil {
+			return err
+		}
+		pictureIds, err := db_album.QueryAlbumPictureIds(ctx, req.AlbumID)
+		if err != nil {
+			return err
+		}
+		// 审核未通过等不可见的图片不计入数量上限
+		visibleIds, err := db_album.QueryVisibleAlbumPictureIds(ctx, req.AlbumID, loginUser.Id)
+		if err != nil {
+			return err
+		}
+		var newIds []int64
+		for _, pictureId := range addIds {
+			if !containsId(pictureIds, pictureId) {
+				newIds = append(newIds, pictureId)
+			}
+		}
+		if len(visibleIds)+len(newIds) > constants.MaxAlbumPictures {
+			return errno.OperationErr.WithMessage("相册图片数量已达上限")
+		}
+		return db_album.CreateAlbumPictures(ctx, req.AlbumID, newIds)
+	})
+	if err != nil {
+		var errNo errno.ErrNo
+		if errors.As(err, &errNo) {
+			return errNo
+		}
+		return errno.OperationErr.WithMessage("添加图片失败")
+	}
+	return nil
+}
+
+// RemoveAlbumPicture 从相册移除图片 - 图片本身不受影响, 移除封面时改用第一张图片
+// params:
+//   - req: 移除图片请求体
+//     required: albumId, pictureIds
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *AlbumService) RemoveAlbumPicture(req *album.RemoveAlbumPictureReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	oldAlbum, err := s.checkAlbumOwner(req.AlbumID, loginUser)
+	if err != nil {
+		return err
+	}
+	err = db.Transaction(s.ctx, func(ctx context.Context) error {
+		if err := db_album.DeleteAlbumPictures(ctx, req.AlbumID, req.PictureIds); err != nil {
+			return err
+		}
+		if oldAlbum.CoverId == 0 || !containsId(req.PictureIds, oldAlbum.CoverId) {
+			return nil
+		}
+		var coverId int64
+		return db_album.UpdateAlbumSetting(ctx, req.AlbumID, nil, &coverId)
+	})
+	if err != nil {
+		return errno.OperationErr.WithMessage("移除图片失败")
+	}
+	return nil
+}
+
+// SortAlbumPicture 调整相册图片顺序 - 需包含相册内的全部可见图片, 不可见的图片保持原有顺序排在最后
+// params:
+//   - req: 图片排序请求体
+//     required: albumId, pictureIds
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *AlbumService) SortAlbumPicture(req *album.SortAlbumPictureReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	if _, err = s.checkAlbumOwner(req.AlbumID, loginUser); err != nil {
+		return err
+	}
+	err = db.Transaction(s.ctx, func(ctx context.Context) error {
+		if err := db_album.LockAlbumById(ctx, req.AlbumID); err != nil {
+			return err
+		}
+		pictureIds, err := db_album.QueryAlbumPictureIds(ctx, req.AlbumID)
+		if err != nil {
+			return err
+		}
+		visibleIds, err := db_album.QueryVisibleAlbumPictureIds(ctx, req.AlbumID, loginUser.Id)
+		if err != nil {
+			return err
+		}
+		if !sameIds(visibleIds, req.PictureIds) {
+			return errno.ParamErr.WithMessage("排序需包含相册内的全部图片")
+		}
+		sortedIds := append([]int64{}, req.PictureIds...)
+		for _, pictureId := range pictureIds {
+			if !containsId(visibleIds, pictureId) {
+				sortedIds = append(sortedIds, pictureId)
+			}
+		}
+		return db_album.UpdateAlbumPictureOrder(ctx, req.AlbumID, sortedIds)
+	})
+	if err != nil {
+		var errNo errno.ErrNo
+		if errors.As(err, &errNo) {
+			return errNo
+		}
+		return errno.OperationErr.WithMessage("调整顺序失败")
+	}
+	return nil
+}
+
+// checkAlbumOwner - 校验登录用户为相册创建者
+// params:
+//   - albumId
+//   - loginUser
+//
+// returns:
+//   - album
+//   - error: 相册不存在返回 NotFoundErr, 非创建者返回 NoAuthErr
+func (s *AlbumService) checkAlbumOwner(albumId int64, loginUser *model.LoginUser) (*db_album.Album, error) {
+	oldAlbum, err := db_album.QueryAlbumById(s.ctx, albumId)
+	if err != nil {
+		return nil, errno.NotFoundErr.WithMessage("相册不存在")
+	}
+	if oldAlbum.UserId != loginUser.Id {
+		return nil, errno.NoAuthErr
+	}
+	return oldAlbum, nil
+}
+
+// containsId - 判断 ids 中是否包含 id
+func containsId(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// sameIds - 判断两组 id 是否为相同集合且无重复
+func sameIds(ids, others []int64) bool {
+	if len(ids) != len(others) {
+		return false
+	}
+	set := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	for _, id := range others {
+		if _, ok := set[id]; !ok {
+			return false
+		}
+		delete(set, id)
+	}
+	return true
+}
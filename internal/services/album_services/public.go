@@ -0,0 +1,132 @@
+package album_services
+
+import (
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_album"
+	"github.com/Alf-Grindel/clide/internal/model"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/model/clide/album"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/internal/services/picture_services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// GetAlbum 获取相册 - 私密相册仅创建者可获取
+// params:
+//   - req: 获取相册请求体
+//     required: albumId
+//   - c: 请求上下文, 未登录时视为匿名访客
+//
+// returns:
+//   - albumVo: 相册脱敏信息
+//   - error: nil on success, non-nil on failure
+func (s *AlbumService) GetAlbum(req *album.GetAlbumReq, c *app.RequestContext) (*base.AlbumVo, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	loginUser := optionalLoginUser(c)
+	oldAlbum, err := db_album.QueryAlbumById(s.ctx, req.ID)
+	if err != nil {
+		return nil, errno.NotFoundErr.WithMessage("相册不存在")
+	}
+	if err = checkAlbumView(oldAlbum, loginUser); err != nil {
+		return nil, err
+	}
+	return albumToVo(s.ctx, oldAlbum, loginUser)
+}
+
+// ListAlbumPicture 获取相册内的图片[分页] - 按相册顺序返回, 他人仅能看到已审核通过的图片
+// params:
+//   - req: 相册图片请求体
+//     required: albumId, currentPage, pageSize
+//   - c: 请求上下文, 未登录时视为匿名访客
+//
+// returns:
+//   - total: total number of visible pictures
+//   - pictureVos: 图片脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *AlbumService) ListAlbumPicture(req *album.ListAlbumPictureReq, c *app.RequestContext) (int64, []*base.PictureVo, error) {
+	if req == nil {
+		return 0, nil, errno.ParamErr
+	}
+	loginUser := optionalLoginUser(c)
+	oldAlbum, err := db_album.QueryAlbumById(s.ctx, req.AlbumID)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr.WithMessage("相册不存在")
+	}
+	if err = checkAlbumView(oldAlbum, loginUser); err != nil {
+		return 0, nil, err
+	}
+	currentPage := req.CurrentPage
+	if currentPage < 1 {
+		currentPage = constants.CurrentPage
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = constants.PageSize
+	}
+	total, oldPictures, err := db_album.QueryAlbumPicture(s.ctx, oldAlbum.Id, loginUserId(loginUser), currentPage, pageSize)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	pictureVos := picture_services.ObjsToVos(s.ctx, oldPictures, loginUser)
+	picture_services.FillPictureState(s.ctx, pictureVos, loginUserId(loginUser))
+	return total, pictureVos, nil
+}
+
+// ListAlbum 相册列表[分页] - 仅返回公开相册, 查询本人相册时包含私密相册
+// params:
+//   - req: 相册列表请求体
+//     required: currentPage, pageSize
+//     optional: userId, albumName
+//   - c: 请求上下文, 未登录时视为匿名访客
+//
+// returns:
+//   - total: total number of matched albums
+//   - albumVos: 相册脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *AlbumService) ListAlbum(req *album.ListAlbumReq, c *app.RequestContext) (int64, []*base.AlbumVo, error) {
+	if req == nil {
+		return 0, nil, errno.ParamErr
+	}
+	loginUser := optionalLoginUser(c)
+	currentPage := req.CurrentPage
+	if currentPage < 1 {
+		currentPage = constants.CurrentPage
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = constants.PageSize
+	}
+	search := &db_album.Album{
+		UserId:     req.GetUserID(),
+		AlbumName:  req.GetAlbumName(),
+		Visibility: constants.AlbumVisibilityPublic,
+	}
+	if loginUser != nil && search.UserId == loginUser.Id {
+		search.Visibility = -1
+	}
+	total, oldAlbums, err := db_album.QueryAlbum(s.ctx, search, currentPage, pageSize)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	return total, ObjsToVos(s.ctx, oldAlbums, loginUser), nil
+}
+
+// optionalLoginUser - 获取登录用户, 未登录时返回 nil
+func optionalLoginUser(c *app.RequestContext) *model.LoginUser {
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil
+	}
+	return loginUser
+}
+
+// loginUserId - 未登录时返回 0
+func loginUserId(loginUser *model.LoginUser) int64 {
+	if loginUser == nil {
+		return 0
+	}
+	return loginUser.Id
+}
@@ -0,0 +1,94 @@
+package comment_services
+
+import (
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_comment"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/model/clide/comment"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"time"
+)
+
+// QueryComment 分页查询评论
+// params:
+//   - req: 查询评论请求体
+//     required: currentPage, pageSize
+//     optional: pictureId, userId, content, reviewStatus
+//
+// returns:
+//   - total: total number of matched comments
+//   - commentVos: 评论脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *CommentService) QueryComment(req *comment.QueryCommentReq) (int64, []*base.CommentVo, error) {
+	if req == nil {
+		return 0, nil, errno.ParamErr
+	}
+	currentPage := req.CurrentPage
+	pageSize := req.PageSize
+	if currentPage < 1 {
+		currentPage = constants.CurrentPage
+	}
+	if pageSize < 1 || pageSize > 30 {
+		pageSize = constants.PageSize
+	}
+	search := &db_comment.Comment{
+		PictureId:    req.GetPictureID(),
+		UserId:       req.GetUserID(),
+		Content:      req.GetContent(),
+		ReviewStatus: -1,
+	}
+	if req.ReviewStatus != nil {
+		status, ok := constants.ReviewPictureMap[req.GetReviewStatus()]
+		if !ok {
+			return 0, nil, errno.ParamErr.WithMessage("审核状态不存在")
+		}
+		search.ReviewStatus = status
+	}
+	total, oldComments, err := db_comment.QueryComment(s.ctx, search, currentPage, pageSize)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	return total, ObjsToVos(s.ctx, oldComments), nil
+}
+
+// ReviewComment 评论审核
+// params:
+//   - req: 评论审核请求体
+//     required: commentId, reviewStatus, reviewMessage
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *CommentService) ReviewComment(req *comment.ReviewCommentReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	status, ok := constants.ReviewPictureMap[req.ReviewStatus]
+	if !ok || req.ID == 0 || req.ReviewMessage == "" {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	oldComment, err := db_comment.QueryCommentById(s.ctx, req.ID)
+	if err != nil {
+		return errno.NotFoundErr
+	}
+	if oldComment.ReviewStatus == status {
+		return errno.OperationErr.WithMessage("请勿重复审核")
+	}
+	updates := &db_comment.Comment{
+		Id:            req.ID,
+		ReviewStatus:  status,
+		ReviewMessage: req.ReviewMessage,
+		ReviewId:      loginUser.Id,
+		ReviewTime:    time.Now(),
+	}
+	if err = db_comment.UpdateCommentReview(s.ctx, updates); err != nil {
+		return errno.OperationErr
+	}
+	return nil
+}
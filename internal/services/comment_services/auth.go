@@ -0,0 +1,174 @@
+package comment_services
+
+import (
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_comment"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/model/clide/comment"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/internal/services/picture_services"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"time"
+)
+
+// AddComment 发表评论 - 仅支持一级回复, 回复二级评论时归入其所属的一级评论, 审核规则与图片相同
+// params:
+//   - req: 发表评论请求体
+//     required: pictureId, content
+//     optional: parentId
+//   - c: 请求上下文
+//
+// returns:
+//   - commentId
+//   - error: nil on success, non-nil on failure
+func (s *CommentService) AddComment(req *comment.AddCommentReq, c *app.RequestContext) (int64, error) {
+	if req == nil {
+		return 0, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return 0, err
+	}
+	oldPicture, err := s.checkPictureComment(req.PictureID, loginUser)
+	if err != nil {
+		return 0, err
+	}
+	review := picture_services.AutoReview(oldPicture.SpaceId, loginUser)
+	addComment := &db_comment.Comment{
+		PictureId:     oldPicture.Id,
+		UserId:        loginUser.Id,
+		Content:       req.Content,
+		ReviewStatus:  review.ReviewStatus,
+		ReviewMessage: review.ReviewMessage,
+		ReviewId:      review.ReviewId,
+		ReviewTime:    review.ReviewTime,
+	}
+	if req.ParentID != nil {
+		parent, err := db_comment.QueryCommentById(s.ctx, req.GetParentID())
+		if err != nil || parent.PictureId != oldPicture.Id || !canViewComment(parent, oldPicture, loginUser) {
+			return 0, errno.NotFoundErr.WithMessage("回复的评论不存在")
+		}
+		addComment.ParentId = parent.Id
+		if parent.ParentId != 0 {
+			addComment.ParentId = parent.ParentId
+		}
+		addComment.ReplyUserId = parent.UserId
+	}
+	id, err := db_comment.CreateComment(s.ctx, addComment)
+	if err != nil {
+		return 0, errno.OperationErr.WithMessage("评论失败")
+	}
+	return id, nil
+}
+
+// EditComment 编辑评论 - 只能编辑自己的评论, 编辑后重新审核
+// params:
+//   - req: 编辑评论请求体
+//     required: commentId, content
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *CommentService) EditComment(req *comment.EditCommentReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	oldComment, err := db_comment.QueryCommentById(s.ctx, req.ID)
+	if err != nil {
+		return errno.NotFoundErr.WithMessage("评论不存在")
+	}
+	if oldComment.UserId != loginUser.Id {
+		return errno.NoAuthErr
+	}
+	oldPicture, err := db_picture.QueryPictureById(s.ctx, oldComment.PictureId)
+	if err != nil {
+		return errno.NotFoundErr
+	}
+	review := picture_services.AutoReview(oldPicture.SpaceId, loginUser)
+	updates := &db_comment.Comment{
+		Id:            req.ID,
+		Content:       req.Content,
+		EditTime:      time.Now(),
+		ReviewStatus:  review.ReviewStatus,
+		ReviewMessage: review.ReviewMessage,
+		ReviewId:      review.ReviewId,
+		ReviewTime:    review.ReviewTime,
+	}
+	if err = db_comment.UpdateCommentContent(s.ctx, updates); err != nil {
+		return errno.OperationErr.WithMessage("更新失败")
+	}
+	return nil
+}
+
+// DeleteComment 删除评论 - 评论作者、图片作者与管理员可删除, 删除一级评论时一并删除其回复
+// params:
+//   - req: 删除评论请求体
+//     required: commentId
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *CommentService) DeleteComment(req *comment.DeleteCommentReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	oldComment, err := db_comment.QueryCommentById(s.ctx, req.ID)
+	if err != nil {
+		return errno.NotFoundErr.WithMessage("评论不存在")
+	}
+	if oldComment.UserId != loginUser.Id && loginUser.Role != "admin" {
+		oldPicture, err := db_picture.QueryPictureById(s.ctx, oldComment.PictureId)
+		if err != nil || oldPicture.UserId != loginUser.Id {
+			return errno.NoAuthErr
+		}
+	}
+	if err = db_comment.DeleteComment(s.ctx, req.ID); err != nil {
+		return errno.OperationErr.WithMessage("删除评论失败")
+	}
+	return nil
+}
+
+// HideComment 隐藏或取消隐藏评论 - 图片作者与管理员可操作, 隐藏后仅评论作者、图片作者与管理员可见
+// params:
+//   - req: 隐藏评论请求体
+//     required: commentId, hidden
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *CommentService) HideComment(req *comment.HideCommentReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	oldComment, err := db_comment.QueryCommentById(s.ctx, req.ID)
+	if err != nil {
+		return errno.NotFoundErr.WithMessage("评论不存在")
+	}
+	oldPicture, err := db_picture.QueryPictureById(s.ctx, oldComment.PictureId)
+	if err != nil {
+		return errno.NotFoundErr
+	}
+	if !isModerator(oldPicture, loginUser) {
+		return errno.NoAuthErr
+	}
+	isHidden := 0
+	if req.Hidden {
+		isHidden = 1
+	}
+	if err = db_comment.UpdateCommentHidden(s.ctx, req.ID, isHidden); err != nil {
+		return errno.OperationErr.WithMessage("更新失败")
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package comment_services
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_comment"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
+	"github.com/Alf-Grindel/clide/internal/model"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/internal/services/user_services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"time"
+)
+
+type CommentService struct {
+	ctx context.Context
+}
+
+func NewCommentService(ctx context.Context) *CommentService {
+	return &CommentService{ctx}
+}
+
+// ObjToVo - 转换为脱敏对象
+func ObjToVo(oldComment *db_comment.Comment, oldUser, replyUser *db_user.User) *base.CommentVo {
+	if oldComment == nil {
+		return nil
+	}
+	return &base.CommentVo{
+		ID:           oldComment.Id,
+		PictureId:    oldComment.PictureId,
+		UserId:       oldComment.UserId,
+		User:         user_services.ObjToVo(oldUser),
+		ParentId:     oldComment.ParentId,
+		ReplyUserId:  oldComment.ReplyUserId,
+		ReplyUser:    user_services.ObjToVo(replyUser),
+		Content:      oldComment.Content,
+		ReviewStatus: constants.ReviewStatusMap[oldComment.ReviewStatus],
+		IsHidden:     oldComment.IsHidden == 1,
+		EditTime:     oldComment.EditTime.Format(time.DateTime),
+		CreateTime:   oldComment.CreateTime.Format(time.DateTime),
+	}
+}
+
+// ObjsToVos - 转换为脱敏列表
+func ObjsToVos(ctx context.Context, oldComments []*db_comment.Comment) []*base.CommentVo {
+	if oldComments == nil {
+		return nil
+	}
+	var comments []*base.CommentVo
+	for _, oldComment := range oldComments {
+		oldUser, err := db_user.QueryUserById(ctx, oldComment.UserId)
+		if err != nil {
+			return nil
+		}
+		var replyUser *db_user.User
+		if oldComment.ReplyUserId != 0 {
+			replyUser, err = db_user.QueryUserById(ctx, oldComment.ReplyUserId)
+			if err != nil {
+				return nil
+			}
+		}
+		comments = append(comments, ObjToVo(oldComment, oldUser, replyUser))
+	}
+	return comments
+}
+
+// checkPictureComment - 图片需对当前用户可见, 公共图库中他人的图片需已审核通过
+// params:
+//   - pictureId
+//   - loginUser: 未登录时为 nil
+//
+// returns:
+//   - picture
+//   - error: 不可见时返回 NotFoundErr, 不暴露图片是否存在
+func (s *CommentService) checkPictureComment(pictureId int64, loginUser *model.LoginUser) (*db_picture.Picture, error) {
+	oldPicture, err := db_picture.QueryPictureById(s.ctx, pictureId)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	if oldPicture.SpaceId != 0 {
+		if loginUser == nil {
+			return nil, errno.NotFoundErr
+		}
+		if _, err = services.CheckSpaceRole(s.ctx, oldPicture.SpaceId, loginUser, constants.SpaceRoleViewer); err != nil {
+			return nil, errno.NotFoundErr
+		}
+		return oldPicture, nil
+	}
+	if oldPicture.ReviewStatus != constants.ReviewPictureMap["通过"] && !isModerator(oldPicture, loginUser) {
+		return nil, errno.NotFoundErr
+	}
+	return oldPicture, nil
+}
+
+// isModerator - 图片作者与管理员可管理图片下的评论
+func isModerator(oldPicture *db_picture.Picture, loginUser *model.LoginUser) bool {
+	if loginUser == nil {
+		return false
+	}
+	return oldPicture.UserId == loginUser.Id || loginUser.Role == "admin"
+}
+
+// canViewComment - 评论作者可见本人的全部评论, 其余用户仅可见已审核通过且未隐藏的评论, 图片作者与管理员可见被隐藏的评论
+func canViewComment(oldComment *db_comment.Comment, oldPicture *db_picture.Picture, loginUser *model.LoginUser) bool {
+	if loginUser != nil && oldComment.UserId == loginUser.Id {
+		return true
+	}
+	if oldComment.ReviewStatus != constants.ReviewPictureMap["通过"] {
+		return false
+	}
+	return oldComment.IsHidden == 0 || isModerator(oldPicture, loginUser)
+}
@@ -0,0 +1,107 @@
+package comment_services
+
+import (
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_comment"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/model/clide/comment"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListComment 获取图片的一级评论[分页] - 按时间倒序, 附带回复数量与前几条回复
+// 他人仅能看到已审核通过且未隐藏的评论, 图片作者与管理员可看到被隐藏的评论
+// params:
+//   - req: 评论列表请求体
+//     required: pictureId, currentPage, pageSize
+//   - c: 请求上下文, 未登录时视为匿名访客
+//
+// returns:
+//   - total: total number of visible comments
+//   - commentVos: 评论脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *CommentService) ListComment(req *comment.ListCommentReq, c *app.RequestContext) (int64, []*base.CommentVo, error) {
+	if req == nil {
+		return 0, nil, errno.ParamErr
+	}
+	loginUser, _ := services.GetLoginUserIdRole(c)
+	oldPicture, err := s.checkPictureComment(req.PictureID, loginUser)
+	if err != nil {
+		return 0, nil, err
+	}
+	currentPage, pageSize := pageParams(req.CurrentPage, req.PageSize)
+	var viewerId int64
+	if loginUser != nil {
+		viewerId = loginUser.Id
+	}
+	showHidden := isModerator(oldPicture, loginUser)
+	total, oldComments, err := db_comment.QueryPictureComment(s.ctx, oldPicture.Id, 0, viewerId, showHidden, currentPage, pageSize)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	commentVos := ObjsToVos(s.ctx, oldComments)
+	for _, commentVo := range commentVos {
+		replyCount, oldReplies, err := db_comment.QueryPictureComment(s.ctx, oldPicture.Id, commentVo.ID, viewerId, showHidden,
+			1, constants.CommentReplyPreview)
+		if err != nil {
+			return 0, nil, errno.NotFoundErr
+		}
+		commentVo.ReplyCount = replyCount
+		commentVo.Replies = ObjsToVos(s.ctx, oldReplies)
+	}
+	return total, commentVos, nil
+}
+
+// ListCommentReply 获取一级评论的回复[分页] - 按时间正序, 可见规则与一级评论相同
+// params:
+//   - req: 回复列表请求体
+//     required: commentId, currentPage, pageSize
+//   - c: 请求上下文, 未登录时视为匿名访客
+//
+// returns:
+//   - total: total number of visible replies
+//   - commentVos: 回复脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *CommentService) ListCommentReply(req *comment.ListCommentReplyReq, c *app.RequestContext) (int64, []*base.CommentVo, error) {
+	if req == nil {
+		return 0, nil, errno.ParamErr
+	}
+	loginUser, _ := services.GetLoginUserIdRole(c)
+	oldComment, err := db_comment.QueryCommentById(s.ctx, req.CommentID)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr.WithMessage("评论不存在")
+	}
+	if oldComment.ParentId != 0 {
+		return 0, nil, errno.ParamErr.WithMessage("仅一级评论有回复")
+	}
+	oldPicture, err := s.checkPictureComment(oldComment.PictureId, loginUser)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !canViewComment(oldComment, oldPicture, loginUser) {
+		return 0, nil, errno.NotFoundErr.WithMessage("评论不存在")
+	}
+	currentPage, pageSize := pageParams(req.CurrentPage, req.PageSize)
+	var viewerId int64
+	if loginUser != nil {
+		viewerId = loginUser.Id
+	}
+	total, oldReplies, err := db_comment.QueryPictureComment(s.ctx, oldPicture.Id, oldComment.Id, viewerId,
+		isModerator(oldPicture, loginUser), currentPage, pageSize)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	return total, ObjsToVos(s.ctx, oldReplies), nil
+}
+
+// pageParams - 分页参数缺省时使用默认值
+func pageParams(currentPage, pageSize int64) (int64, int64) {
+	if currentPage < 1 {
+		currentPage = constants.CurrentPage
+	}
+	if pageSize < 1 {
+		pageSize = constants.PageSize
+	}
+	return currentPage, pageSize
+}
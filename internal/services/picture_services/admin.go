@@ -1,6 +1,7 @@
 package picture_services
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -11,6 +12,8 @@ import (
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/gocolly/colly"
 
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_album"
 	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
 	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
 	"github.com/Alf-Grindel/clide/internal/model/base"
@@ -31,12 +34,35 @@ func (s *PictureService) DeletePicture(req *picture.DeletePictureReq) error {
 	if req == nil {
 		return errno.ParamErr
 	}
-	if _, err := db_picture.QueryPictureById(s.ctx, req.ID); err != nil {
+	oldPicture, err := db_picture.QueryPictureById(s.ctx, req.ID)
+	if err != nil {
 		return errno.NotFoundErr
 	}
-	if err := db_picture.DeletePicture(s.ctx, req.ID); err != nil {
+	return s.deletePicture(oldPicture)
+}
+
+// deletePicture - 删除图片记录并释放用量, 同时移出所在相册, 存储对象在保留期后清理
+// params:
+//   - oldPicture
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) deletePicture(oldPicture *db_picture.Picture) error {
+	// 删除图片与释放用量在同一事务中
+	err := db.Transaction(s.ctx, func(ctx context.Context) error {
+		if err := db_picture.DeletePicture(ctx, oldPicture.Id); err != nil {
+			return err
+		}
+		if err := db_album.DeletePictureFromAlbums(ctx, oldPicture.Id); err != nil {
+			return err
+		}
+		return releaseUsage(ctx, oldPicture)
+	})
+	if err != nil {
 		return errno.OperationErr.WithMessage("删除图片失败")
 	}
+	// 保留期内不删除存储对象
+	schedulePurge(s.ctx, oldPicture.StorageKey, oldPicture.ThumbnailKey, oldPicture.CompressedKey)
 	return nil
 }
 
@@ -93,7 +119,7 @@ func (s *PictureService) UpdatePicture(req *picture.UpdatePictureReq, c *app.Req
 //   - req: 查询图片请求体
 //     required: currentPage, pageSize
 //     optional: pictureId, picName, introduction, category, tags, picSize, picWidth, picHeight
-//     optional: picScale, picFormat, searchText, userId
+//     optional: picScale, picFormat, searchText, userId, spaceId 为空时查询公共图库
 //
 // returns:
 //   - total: total number of matched users
@@ -125,6 +151,7 @@ func (s *PictureService) QueryPicture(req *picture.QueryPictureReq) (int64, []*b
 		UserId:        req.GetUserID(),
 		ReviewMessage: req.GetReviewMessage(),
 		ReviewId:      req.GetReviewID(),
+		SpaceId:       req.GetSpaceID(),
 	}
 	var tags []string
 	if req.Tags != nil {
@@ -142,7 +169,7 @@ func (s *PictureService) QueryPicture(req *picture.QueryPictureReq) (int64, []*b
 	}
 	searchText := req.GetSearchText()
 
-	total, oldPictures, err := db_picture.QueryPicture(s.ctx, search, searchText, tags, currentPage, pageSize)
+	total, oldPictures, err := db_picture.QueryPicture(s.ctx, search, searchText, tags, nil, currentPage, pageSize)
 	if err != nil {
 		return 0, nil, errno.NotFoundErr
 	}
@@ -0,0 +1,185 @@
+package picture_services
+
+import (
+	"fmt"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/model/clide/picture"
+	tencentCos "github.com/Alf-Grindel/clide/internal/pkg/cos_client"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/bytedance/sonic"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"mime/multipart"
+	"unicode/utf8"
+)
+
+// archiveManifest - 压缩包根目录下 manifest.json 的内容, 顶层的分类与标签作用于全部图片
+type archiveManifest struct {
+	Category string   `json:"category"`
+	Tags     []string `json:"tags"`
+	// 按压缩包内的路径覆盖单个图片的信息
+	Entries map[string]*archiveManifestEntry `json:"entries"`
+}
+
+// 清单字段的长度限制, 与接口及表字段一致
+const (
+	maxManifestPicName      = 128
+	maxManifestIntroduction = 800
+	maxManifestCategory     = 64
+	maxManifestTags         = 512
+)
+
+type archiveManifestEntry struct {
+	PicName      string   `json:"picName"`
+	Introduction string   `json:"introduction"`
+	Category     string   `json:"category"`
+	Tags         []string `json:"tags"`
+}
+
+// UploadPictureByArchive 压缩包批量上传 - 每个图片单独校验与入库, 单个失败不影响其余图片
+// params:
+//   - req: 压缩包上传请求体
+//     optional: keepGps, spaceId
+//   - file: zip 或 tar.gz 压缩包, 可包含 manifest.json 指定分类与标签
+//   - c: 请求上下文
+//
+// returns:
+//   - results: 每个文件的上传结果
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) UploadPictureByArchive(req *picture.UploadPictureByArchiveReq, file *multipart.FileHeader, c *app.RequestContext) ([]*picture.ArchiveEntryResult, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	if file == nil {
+		return nil, errno.ParamErr.WithMessage("未上传文件")
+	}
+	maxSize := config.Upload.MaxArchiveSize
+	if maxSize <= 0 {
+		maxSize = constants.DefaultMaxArchiveSize
+	}
+	if file.Size > maxSize {
+		return nil, errno.ParamErr.WithMessage(fmt.Sprintf("压缩包大小不能超过 %d MB", maxSize/1024/1024))
+	}
+	manifest, err := readArchiveManifest(file)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*picture.ArchiveEntryResult, 0)
+	err = tencentCos.WalkArchive(file, constants.MaxArchiveEntries, func(entry *tencentCos.ArchiveEntry) error {
+		if entry.Name == constants.ArchiveManifest {
+			return nil
+		}
+		uploadReq := &picture.UploadPictureReq{
+			KeepGps: req.KeepGps,
+			SpaceID: req.SpaceID,
+		}
+		// 清单中单个图片的配置有误时仅该图片失败
+		var id int64
+		err := manifest.Entries[entry.Name].validate()
+		if err == nil {
+			id, err = s.savePicture(uploadReq, loginUser, func(opt *tencentCos.UploadOption) (*tencentCos.File, error) {
+				return tencentCos.UploadPictureByArchiveEntry(s.ctx, entry, opt)
+			}, manifest.filler(entry.Name))
+		}
+		result := &picture.ArchiveEntryResult{
+			Path:    entry.Name,
+			Success: err == nil,
+			ID:      id,
+		}
+		if err != nil {
+			hlog.Infof("picture_services - UploadPictureByArchive: upload entry failed, %s, %s\n", entry.Name, err)
+			result.Message = errno.ConvertErr(err).ErrMsg
+		}
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// readArchiveManifest - 读取压缩包中的 manifest.json, 不存在时返回空清单
+func readArchiveManifest(file *multipart.FileHeader) (*archiveManifest, error) {
+	manifest := &archiveManifest{}
+	data, err := tencentCos.ReadArchiveFile(file, constants.ArchiveManifest, constants.MaxManifestSize)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return manifest, nil
+	}
+	if err = sonic.Unmarshal(data, manifest); err != nil {
+		hlog.Infof("picture_services - readArchiveManifest: unmarshal manifest failed, %s\n", err)
+		return nil, errno.ParamErr.WithMessage(fmt.Sprintf("%s 格式错误", constants.ArchiveManifest))
+	}
+	// 顶层配置作用于全部图片, 有误时整体拒绝
+	if err = validateManifestFields("", "", manifest.Category, manifest.Tags); err != nil {
+		return nil, errno.ParamErr.WithMessage(fmt.Sprintf("%s %s", constants.ArchiveManifest, errno.ConvertErr(err).ErrMsg))
+	}
+	return manifest, nil
+}
+
+// validate - 校验单个图片的配置, 未配置时视为通过
+func (e *archiveManifestEntry) validate() error {
+	if e == nil {
+		return nil
+	}
+	return validateManifestFields(e.PicName, e.Introduction, e.Category, e.Tags)
+}
+
+// validateManifestFields - 按与编辑图片接口一致的限制校验清单字段
+func validateManifestFields(picName, introduction, category string, tags []string) error {
+	if utf8.RuneCountInString(picName) > maxManifestPicName {
+		return errno.ParamErr.WithMessage(fmt.Sprintf("图片名称不能超过 %d 个字符", maxManifestPicName))
+	}
+	if len(introduction) >= maxManifestIntroduction {
+		return errno.ParamErr.WithMessage(fmt.Sprintf("简介需少于 %d 个字节", maxManifestIntroduction))
+	}
+	if utf8.RuneCountInString(category) > maxManifestCategory {
+		return errno.ParamErr.WithMessage(fmt.Sprintf("分类不能超过 %d 个字符", maxManifestCategory))
+	}
+	if len(tags) > 0 {
+		b, err := sonic.Marshal(tags)
+		if err != nil || utf8.RuneCount(b) > maxManifestTags {
+			return errno.ParamErr.WithMessage(fmt.Sprintf("标签总长度不能超过 %d 个字符", maxManifestTags))
+		}
+	}
+	return nil
+}
+
+// filler - 按清单填充图片信息, 单个图片的配置优先于顶层配置
+func (m *archiveManifest) filler(name string) pictureFiller {
+	return func(pictureInfo *db_picture.Picture) error {
+		category, tags := m.Category, m.Tags
+		if entry := m.Entries[name]; entry != nil {
+			if entry.PicName != "" {
+				pictureInfo.PicName = entry.PicName
+			}
+			pictureInfo.Introduction = entry.Introduction
+			if entry.Category != "" {
+				category = entry.Category
+			}
+			if entry.Tags != nil {
+				tags = entry.Tags
+			}
+		}
+		pictureInfo.Category = category
+		if len(tags) > 0 {
+			b, err := sonic.Marshal(tags)
+			if err != nil {
+				hlog.Errorf("picture_services - UploadPictureByArchive: marshal tags failed, %s\n", err)
+				return errno.SystemErr
+			}
+			pictureInfo.Tags = string(b)
+		}
+		return nil
+	}
+}
@@ -1,9 +1,13 @@
 package picture_services
 
 import (
+	"context"
 	"fmt"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
 	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
 	"github.com/Alf-Grindel/clide/internal/model"
+	"github.com/Alf-Grindel/clide/internal/model/base"
 	"github.com/Alf-Grindel/clide/internal/model/clide/picture"
 	tencentCos "github.com/Alf-Grindel/clide/internal/pkg/cos_client"
 	"github.com/Alf-Grindel/clide/internal/services"
@@ -17,7 +21,7 @@ import (
 	"time"
 )
 
-// PictureEdit 图片编辑【用户】 - 只能更新自己创建的
+// PictureEdit 图片编辑【用户】 - 公共图库只能更新自己创建的, 空间内的图片需空间编辑者及以上角色
 // params:
 //   - req: 图片编辑请求体
 //     required: pictureId
@@ -41,8 +45,13 @@ func (s *PictureService) PictureEdit(req *picture.PictureEditReq, c *app.Request
 	if err != nil {
 		return err
 	}
-	if oldPicture.UserId != loginUser.Id {
-		return errno.NoAuthErr
+	// 公共图库的图片管理员通过后台更新
+	if oldPicture.SpaceId == 0 {
+		if oldPicture.UserId != loginUser.Id {
+			return errno.NoAuthErr
+		}
+	} else if err = s.checkPictureEdit(oldPicture, loginUser); err != nil {
+		return err
 	}
 	updates := &db_picture.Picture{
 		Id:           req.ID,
@@ -50,6 +59,7 @@ func (s *PictureService) PictureEdit(req *picture.PictureEditReq, c *app.Request
 		Introduction: req.GetIntroduction(),
 		Category:     req.GetCategory(),
 		EditTime:     time.Now(),
+		SpaceId:      oldPicture.SpaceId,
 	}
 	if req.Tags != nil {
 		b, err := sonic.Marshal(req.Tags)
@@ -66,15 +76,41 @@ func (s *PictureService) PictureEdit(req *picture.PictureEditReq, c *app.Request
 	return nil
 }
 
+// PictureDelete 图片删除【用户】 - 公共图库只能删除自己创建的, 空间内的图片需空间编辑者及以上角色
+// params:
+//   - req: 图片删除请求体
+//     required: pictureId
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) PictureDelete(req *picture.PictureDeleteReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	oldPicture, err := db_picture.QueryPictureById(s.ctx, req.ID)
+	if err != nil {
+		return errno.NotFoundErr
+	}
+	if err = s.checkPictureEdit(oldPicture, loginUser); err != nil {
+		return err
+	}
+	return s.deletePicture(oldPicture)
+}
+
 // UploadPicture 上传图片
 // params:
 //   - req: 图片上传请求体
-//     optional: pictureId , pictureUrl
+//     optional: pictureId , pictureUrl, picName, keepGps, spaceId
 //   - file: 图片
 //   - c: 请求上下文
 //
 // returns:
-//   - pictureId
+//   - pictureId: 重复图片且 dedupMode 为 reuse 时返回已有图片id
 //   - error: nil on success, non-nil on failure
 func (s *PictureService) UploadPicture(req *picture.UploadPictureReq, file *multipart.FileHeader, c *app.RequestContext) (int64, error) {
 	if req == nil {
@@ -84,85 +120,297 @@ func (s *PictureService) UploadPicture(req *picture.UploadPictureReq, file *mult
 	if err != nil {
 		return 0, err
 	}
+	var upload uploadFunc
+	switch {
+	case file != nil:
+		upload = func(opt *tencentCos.UploadOption) (*tencentCos.File, error) {
+			return tencentCos.UploadPicture(s.ctx, file, opt)
+		}
+	case req.FileURL != nil:
+		upload = func(opt *tencentCos.UploadOption) (*tencentCos.File, error) {
+			return tencentCos.UploadPictureByUrl(s.ctx, req.GetFileURL(), opt)
+		}
+	default:
+		return 0, errno.ParamErr.WithMessage("无上传文件")
+	}
+	return s.savePicture(req, loginUser, upload, nil)
+}
+
+// uploadFunc - 按指定选项将图片写入存储
+type uploadFunc func(opt *tencentCos.UploadOption) (*tencentCos.File, error)
+
+// pictureFiller - 写入图片记录前补充字段, 如简介、分类与标签
+type pictureFiller func(pictureInfo *db_picture.Picture) error
+
+// savePicture - 上传图片并新增或更新图片记录
+// params:
+//   - req: 图片上传请求体
+//     optional: pictureId, picName, keepGps, spaceId
+//   - loginUser: 当前登录用户
+//   - upload: 实际的上传方式
+//   - fill: 可为 nil
+//
+// returns:
+//   - pictureId: 重复图片且 dedupMode 为 reuse 时返回已有图片id
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) savePicture(req *picture.UploadPictureReq, loginUser *model.LoginUser, upload uploadFunc, fill pictureFiller) (int64, error) {
 	// 判断是新增还是更新
+	var err error
 	var id int64
+	var oldPicture *db_picture.Picture
+	spaceId := req.GetSpaceID()
 	if req.ID != nil {
 		id = req.GetID()
 		// 如果是更新，判断图片是否存在
-		oldPicture, err := db_picture.QueryPictureById(s.ctx, id)
+		oldPicture, err = db_picture.QueryPictureById(s.ctx, id)
 		if err != nil {
 			return 0, errno.NotFoundErr.WithMessage("图片不存在")
 		}
-		// 仅能更新本人或管理员编辑图片
-		if oldPicture.UserId != loginUser.Id && loginUser.Role != "admin" {
-			return 0, errno.NoAuthErr
+		// 替换文件不改变图片所属空间
+		if req.SpaceID != nil && spaceId != oldPicture.SpaceId {
+			return 0, errno.ParamErr.WithMessage("不能更改图片所属空间")
+		}
+		spaceId = oldPicture.SpaceId
+		if err = s.checkPictureEdit(oldPicture, loginUser); err != nil {
+			return 0, err
 		}
 	}
 	// 上传图片，获取图片信息
-	// 根据用户id划分目录
-	userId := strconv.FormatInt(loginUser.Id, 10)
-	dirPrefix := fmt.Sprintf(constants.PublicSpace, userId)
-	var fileInfo *tencentCos.File
-	switch {
-	case file != nil:
-		fileInfo, err = tencentCos.UploadPicture(s.ctx, file, dirPrefix)
-		if err != nil {
+	// 公共图库根据用户id划分目录, 空间根据空间id划分目录
+	dirPrefix := fmt.Sprintf(constants.PublicSpace, strconv.FormatInt(loginUser.Id, 10))
+	if spaceId != 0 {
+		// 上传到空间需空间编辑者及以上角色
+		if _, err = services.CheckSpaceRole(s.ctx, spaceId, loginUser, constants.SpaceRoleEditor); err != nil {
 			return 0, err
 		}
-	case req.FileURL != nil:
-		fileInfo, err = tencentCos.UploadPictureByUrl(s.ctx, req.GetFileURL(), dirPrefix)
-		if err != nil {
-			return 0, err
+		dirPrefix = fmt.Sprintf(constants.PrivateSpace, strconv.FormatInt(spaceId, 10))
+	}
+	var duplicateId int64
+	opt := &tencentCos.UploadOption{
+		DirPrefix: dirPrefix,
+		KeepGps:   req.GetKeepGps(),
+		// 写入存储前检测重复图片与配额
+		BeforeStore: func(fileInfo *tencentCos.File) error {
+			duplicate := s.findDuplicatePicture(fileInfo.PicHash, loginUser.Id, spaceId)
+			if duplicate == nil || duplicate.Id == id {
+				return s.checkQuota(oldPicture, loginUser.Id, spaceId, fileInfo.PicSize)
+			}
+			if id == 0 && config.Upload.DedupMode == constants.DedupModeReuse {
+				duplicateId = duplicate.Id
+			}
+			return errno.DuplicateErr
+		},
+	}
+	fileInfo, err := upload(opt)
+	if err != nil {
+		if duplicateId != 0 {
+			return duplicateId, nil
 		}
-	default:
-		return 0, errno.ParamErr.WithMessage("无上传文件")
+		return 0, err
 	}
 
 	pictureInfo := &db_picture.Picture{
-		Url:       fileInfo.Url,
-		PicName:   fileInfo.PicName,
-		PicSize:   fileInfo.PicSize,
-		PicWidth:  fileInfo.PicWidth,
-		PicHeight: fileInfo.PicHeight,
-		PicScale:  fileInfo.PicScale,
-		PicFormat: fileInfo.PicFormat,
-		UserId:    loginUser.Id,
+		Url:             fileInfo.Url,
+		StorageKey:      fileInfo.Key,
+		ThumbnailKey:    fileInfo.ThumbnailKey,
+		CompressedKey:   fileInfo.CompressedKey,
+		PicName:         fileInfo.PicName,
+		PicSize:         fileInfo.PicSize,
+		PicWidth:        fileInfo.PicWidth,
+		PicHeight:       fileInfo.PicHeight,
+		PicScale:        fileInfo.PicScale,
+		PicFormat:       fileInfo.PicFormat,
+		PicHash:         fileInfo.PicHash,
+		PicPhash:        fileInfo.PicPhash,
+		PicColor:        fileInfo.PicColor,
+		ExifMake:        fileInfo.ExifMake,
+		ExifModel:       fileInfo.ExifModel,
+		ExifTakenAt:     fileInfo.ExifTakenAt,
+		ExifOrientation: fileInfo.ExifOrientation,
+		PicFrames:       fileInfo.PicFrames,
+		PicDuration:     fileInfo.PicDuration.Milliseconds(),
+		UserId:          loginUser.Id,
+		SpaceId:         spaceId,
+	}
+	if len(fileInfo.PicPalette) > 0 {
+		b, err := sonic.Marshal(fileInfo.PicPalette)
+		if err != nil {
+			hlog.Errorf("picture_services - savePicture: marshal palette failed, %s\n", err)
+			return 0, errno.SystemErr
+		}
+		pictureInfo.PicPalette = string(b)
 	}
 	if req.PicName != nil {
 		pictureInfo.PicName = req.GetPicName()
 	}
+	if fill != nil {
+		if err = fill(pictureInfo); err != nil {
+			schedulePurge(s.ctx, fileInfo.Key, fileInfo.ThumbnailKey, fileInfo.CompressedKey)
+			return 0, err
+		}
+	}
 	fillReviewParams(pictureInfo, loginUser)
 	// 如果是更新
 	if id != 0 {
 		pictureInfo.Id = id
 		pictureInfo.EditTime = time.Now()
-		err = db_picture.UpdatePicture(s.ctx, pictureInfo)
+		// 替换文件与用量变更在同一事务中
+		err = db.Transaction(s.ctx, func(ctx context.Context) error {
+			if err := db_picture.ReplacePictureFile(ctx, pictureInfo); err != nil {
+				return err
+			}
+			return chargeUsage(ctx, oldPicture, loginUser.Id, spaceId, fileInfo.PicSize)
+		})
 		if err != nil {
-			return 0, errno.SystemErr
+			schedulePurge(s.ctx, fileInfo.Key, fileInfo.ThumbnailKey, fileInfo.CompressedKey)
+			return 0, txErr(err)
 		}
+		// 替换后的旧文件在保留期后清理
+		schedulePurge(s.ctx, oldPicture.StorageKey, oldPicture.ThumbnailKey, oldPicture.CompressedKey)
 	} else {
-		id, err = db_picture.CreatePicture(s.ctx, pictureInfo)
+		err = db.Transaction(s.ctx, func(ctx context.Context) error {
+			var err error
+			if id, err = db_picture.CreatePicture(ctx, pictureInfo); err != nil {
+				return err
+			}
+			return chargeUsage(ctx, nil, loginUser.Id, spaceId, fileInfo.PicSize)
+		})
 		if err != nil {
-			return 0, errno.SystemErr
+			schedulePurge(s.ctx, fileInfo.Key, fileInfo.ThumbnailKey, fileInfo.CompressedKey)
+			return 0, txErr(err)
 		}
 	}
 	return id, nil
 }
 
-// fillReviewParams - 填充审核信息
+// SearchSimilarPicture 以图搜图 - 按感知哈希的汉明距离升序返回已审核图片
+// params:
+//   - req: 以图搜图请求体
+//     optional: pictureId, maxDistance, limit
+//   - file: 上传的图片, 与 pictureId 二选一
+//   - c: 请求上下文
+//
+// returns:
+//   - pictureVos: 相似图片脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) SearchSimilarPicture(req *picture.SearchSimilarPictureReq, file *multipart.FileHeader, c *app.RequestContext) ([]*base.PictureVo, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	var phash uint64
+	var excludeId int64
+	switch {
+	case file != nil:
+		hash, err := tencentCos.PerceptualHashPicture(file)
+		if err != nil {
+			return nil, err
+		}
+		phash = hash
+	case req.ID != nil:
+		oldPicture, err := db_picture.QueryPictureById(s.ctx, req.GetID())
+		if err != nil {
+			return nil, errno.NotFoundErr
+		}
+		if err = s.checkPictureView(oldPicture, c); err != nil {
+			return nil, err
+		}
+		if oldPicture.PicPhash == nil {
+			return nil, errno.OperationErr.WithMessage("该图片暂不支持以图搜图")
+		}
+		phash = *oldPicture.PicPhash
+		excludeId = oldPicture.Id
+	default:
+		return nil, errno.ParamErr.WithMessage("未指定图片")
+	}
+	maxDistance := constants.SimilarMaxDistance
+	if req.MaxDistance != nil {
+		maxDistance = int(req.GetMaxDistance())
+	}
+	limit := constants.SimilarLimit
+	if req.Limit != nil {
+		limit = int(req.GetLimit())
+	}
+	oldPictures, err := db_picture.QuerySimilarPicture(s.ctx, phash, excludeId, maxDistance, limit)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	pictureVos := ObjsToVos(s.ctx, oldPictures, optionalLoginUser(c))
+	FillPictureState(s.ctx, pictureVos, LoginUserId(c))
+	return pictureVos, nil
+}
+
+// findDuplicatePicture - 按配置的范围查找内容相同的图片
+// params:
+//   - picHash: 图片内容哈希
+//   - userId: 上传用户id
+//   - spaceId: 上传的空间, 仅在同一空间内检测
+//
+// returns:
+//   - picture: 未找到或未开启检测时为 nil
+func (s *PictureService) findDuplicatePicture(picHash string, userId, spaceId int64) *db_picture.Picture {
+	if picHash == "" {
+		return nil
+	}
+	switch config.Upload.DedupScope {
+	case constants.DedupScopeUser:
+	case constants.DedupScopeGlobal:
+		userId = 0
+	default:
+		return nil
+	}
+	duplicate, err := db_picture.QueryPictureByHash(s.ctx, picHash, userId, spaceId)
+	if err != nil {
+		return nil
+	}
+	return duplicate
+}
+
+// fillReviewParams - 填充审核信息, 空间内的图片无需审核
 // params:
 //   - picture: 待填充picture
+//     optional: spaceId
 //   - loginUser: 从请求上下文获取的信息
 //     required: user_id, user_role
 //
 // returns:
 func fillReviewParams(picture *db_picture.Picture, loginUser *model.LoginUser) {
+	review := AutoReview(picture.SpaceId, loginUser)
+	picture.ReviewStatus = review.ReviewStatus
+	picture.ReviewMessage = review.ReviewMessage
+	picture.ReviewId = review.ReviewId
+	picture.ReviewTime = review.ReviewTime
+}
+
+// ReviewInfo - 内容提交后的审核信息
+type ReviewInfo struct {
+	ReviewStatus  int
+	ReviewMessage string
+	ReviewId      int64
+	ReviewTime    time.Time
+}
+
+// AutoReview - 图片与评论等用户内容共用的审核规则, 空间内的内容与管理员提交的内容自动通过, 其余待审核
+// params:
+//   - spaceId: 内容所属空间, 0 表示公共图库
+//   - loginUser: 提交内容的用户
+//
+// returns:
+//   - review
+func AutoReview(spaceId int64, loginUser *model.LoginUser) ReviewInfo {
+	if spaceId != 0 {
+		return ReviewInfo{
+			ReviewStatus:  constants.ReviewPictureMap["通过"],
+			ReviewMessage: "空间内容无需审核",
+			ReviewTime:    time.Now(),
+		}
+	}
 	if loginUser.Role == "admin" {
-		picture.ReviewStatus = constants.ReviewPictureMap["通过"]
-		picture.ReviewMessage = "管理员自动审核"
-		picture.ReviewId = loginUser.Id
-		picture.ReviewTime = time.Now()
-	} else {
-		picture.ReviewStatus = constants.ReviewPictureMap["待审核"]
+		return ReviewInfo{
+			ReviewStatus:  constants.ReviewPictureMap["通过"],
+			ReviewMessage: "管理员自动审核",
+			ReviewId:      loginUser.Id,
+			ReviewTime:    time.Now(),
+		}
 	}
+	return ReviewInfo{ReviewStatus: constants.ReviewPictureMap["待审核"]}
 }
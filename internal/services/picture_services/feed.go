@@ -0,0 +1,75 @@
+package picture_services
+
+import (
+	"fmt"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/model/clide/picture"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"time"
+)
+
+// PictureFeed 关注动态[游标分页] - 已关注用户在公共图库中审核通过的图片, 按审核时间倒序
+// params:
+//   - req: 动态请求体
+//     required: pageSize
+//     optional: cursor, 为空时从最新的图片开始
+//   - c: 请求上下文
+//
+// returns:
+//   - pictureVos: 图片脱敏信息列表
+//   - nextCursor: 下一页游标, 没有更多时为空
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) PictureFeed(req *picture.PictureFeedReq, c *app.RequestContext) ([]*base.PictureVo, string, error) {
+	if req == nil {
+		return nil, "", errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, "", err
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = constants.PageSize
+	}
+	var cursorTime time.Time
+	var cursorId int64
+	if req.GetCursor() != "" {
+		if cursorTime, cursorId, err = decodeFeedCursor(req.GetCursor()); err != nil {
+			return nil, "", errno.ParamErr.WithMessage("游标错误")
+		}
+	}
+	// 多查询一条用于判断是否还有下一页
+	oldPictures, err := db_picture.QueryFeedPicture(s.ctx, loginUser.Id, cursorTime, cursorId, int(pageSize)+1)
+	if err != nil {
+		return nil, "", errno.NotFoundErr
+	}
+	var nextCursor string
+	if int64(len(oldPictures)) > pageSize {
+		oldPictures = oldPictures[:pageSize]
+		last := oldPictures[len(oldPictures)-1]
+		nextCursor = encodeFeedCursor(last.ReviewTime, last.Id)
+	}
+	pictureVos := ObjsToVos(s.ctx, oldPictures, loginUser)
+	FillPictureState(s.ctx, pictureVos, loginUser.Id)
+	return pictureVos, nextCursor, nil
+}
+
+// encodeFeedCursor - 游标由审核时间戳与图片id组成
+func encodeFeedCursor(reviewTime time.Time, id int64) string {
+	return fmt.Sprintf("%d_%d", reviewTime.Unix(), id)
+}
+
+func decodeFeedCursor(cursor string) (time.Time, int64, error) {
+	var unix, id int64
+	if _, err := fmt.Sscanf(cursor, "%d_%d", &unix, &id); err != nil {
+		return time.Time{}, 0, err
+	}
+	if id <= 0 {
+		return time.Time{}, 0, fmt.Errorf("invalid cursor id %d", id)
+	}
+	return time.Unix(unix, 0), id, nil
+}
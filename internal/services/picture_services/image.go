@@ -0,0 +1,229 @@
+package picture_services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/model/clide/picture"
+	tencentCos "github.com/Alf-Grindel/clide/internal/pkg/cos_client"
+	"github.com/Alf-Grindel/clide/internal/pkg/image_util"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"io"
+	"math"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+type DerivativeImage struct {
+	Data        []byte
+	ContentType string
+	ETag        string
+	// 私有对象不允许共享缓存
+	Private bool
+	// 请求的 If-None-Match 与 ETag 一致, 未读取内容
+	NotModified bool
+}
+
+// PictureImage 获取图片衍生图 - 按尺寸、缩放方式、质量与格式渲染, 结果缓存在存储中
+// 宽高与质量仅支持预设值, 缓存未命中时的渲染受频率限制
+// ETag 仅由衍生图的存储路径决定, If-None-Match 命中时不读取存储也不渲染
+// 开启水印时, 除作者与管理员外获取的衍生图均带有水印, 空间内的图片仅空间成员可获取
+// params:
+//   - req: 获取衍生图请求体
+//     required: pictureId
+//     optional: width, height, fit, quality, format
+//   - c: 请求上下文, 未登录时视为匿名访客
+//
+// returns:
+//   - image: 衍生图内容
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) PictureImage(req *picture.PictureImageReq, c *app.RequestContext) (*DerivativeImage, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	oldPicture, err := db_picture.QueryPictureById(s.ctx, req.ID)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	if err = s.checkPictureView(oldPicture, c); err != nil {
+		return nil, err
+	}
+	if oldPicture.StorageKey == "" {
+		return nil, errno.OperationErr.WithMessage("该图片暂不支持转换")
+	}
+	if !isImagePreset(req.GetWidth(), 0, constants.ImagePresetThumbnail, constants.ImagePresetPreview) ||
+		!isImagePreset(req.GetHeight(), 0, constants.ImagePresetThumbnail, constants.ImagePresetPreview) {
+		return nil, errno.ParamErr.WithMessage(fmt.Sprintf("宽高仅支持 %d 或 %d", constants.ImagePresetThumbnail, constants.ImagePresetPreview))
+	}
+	if req.Quality != nil && !isImagePreset(req.GetQuality(), constants.ImageQualityLow, constants.ImageQuality, constants.ImageQualityHigh) {
+		return nil, errno.ParamErr.WithMessage(fmt.Sprintf("质量仅支持 %d、%d 或 %d", constants.ImageQualityLow, constants.ImageQuality, constants.ImageQualityHigh))
+	}
+	opt := &image_util.TransformOption{
+		Width:  int(req.GetWidth()),
+		Height: int(req.GetHeight()),
+		Fit:    image_util.FitInside,
+	}
+	if req.Fit != nil {
+		opt.Fit = req.GetFit()
+	}
+	format := req.GetFormat()
+	if format == "" {
+		format = outputFormat(oldPicture.PicFormat)
+	}
+	// 仅 jpeg 使用质量参数
+	quality := 0
+	if format == image_util.FormatJpeg {
+		quality = constants.ImageQuality
+		if req.Quality != nil {
+			quality = int(req.GetQuality())
+		}
+	}
+	key := derivativeKey(oldPicture.StorageKey, opt, quality, format)
+	watermark, fingerprint := currentWatermark()
+	// 作者与管理员获取无水印的衍生图, 同一地址内容因人而异, 不允许共享缓存
+	private := tencentCos.IsPrivateKey(key)
+	if watermark != nil {
+		loginUser, err := services.GetLoginUserIdRole(c)
+		if err == nil && (loginUser.Id == oldPicture.UserId || loginUser.Role == "admin") {
+			watermark = nil
+			private = true
+		} else {
+			key = strings.TrimSuffix(key, path.Ext(key)) + "_wm" + fingerprint + path.Ext(key)
+			// 开启水印后原图不对外公开, 带水印的衍生图对所有访客一致, 公共图库中的图片仍允许共享缓存
+			private = oldPicture.SpaceId != 0 || config.Storage.Private
+		}
+	}
+	sum := sha256.Sum256([]byte(key))
+	result := &DerivativeImage{
+		ContentType: image_util.ContentType(format),
+		ETag:        `"` + hex.EncodeToString(sum[:8]) + `"`,
+		Private:     private,
+	}
+	if matchETag(string(c.GetHeader("If-None-Match")), result.ETag) {
+		result.NotModified = true
+		return result, nil
+	}
+	storage := tencentCos.NewStorage()
+	// 优先读取已缓存的衍生图
+	if body, err := storage.GetObj(s.ctx, key); err == nil {
+		result.Data, err = io.ReadAll(body)
+		body.Close()
+		if err == nil {
+			return result, nil
+		}
+	}
+	if !allowRender() {
+		return nil, errno.OperationErr.WithMessage("请求过于频繁, 请稍后重试")
+	}
+	data, err := s.renderDerivative(storage, oldPicture.StorageKey, opt, watermark, quality, format)
+	if err != nil {
+		return nil, err
+	}
+	if err = storage.PutObj(s.ctx, key, bytes.NewReader(data)); err == nil {
+		// 衍生图在保留期后清理, 再次访问时重新生成
+		schedulePurge(s.ctx, key)
+	}
+	result.Data = data
+	return result, nil
+}
+
+// renderDerivative - 从原图渲染衍生图
+func (s *PictureService) renderDerivative(storage tencentCos.Storage, storageKey string, opt *image_util.TransformOption, watermark *image_util.Watermark, quality int, format string) ([]byte, error) {
+	body, err := storage.GetObj(s.ctx, storageKey)
+	if err != nil {
+		return nil, errno.NotFoundErr.WithMessage("图片文件不存在")
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		hlog.Errorf("picture_services - renderDerivative: read origin picture failed, %s\n", err)
+		return nil, errno.OperationErr
+	}
+	img, _, err := image_util.Decode(bytes.NewReader(data))
+	if errors.Is(err, image_util.ErrImageTooLarge) {
+		return nil, errno.OperationErr.WithMessage("图片尺寸过大, 暂不支持转换")
+	}
+	if err != nil {
+		return nil, errno.OperationErr.WithMessage("该图片暂不支持转换")
+	}
+	var buf bytes.Buffer
+	img = image_util.ApplyWatermark(image_util.Transform(img, opt), watermark)
+	if err = image_util.Encode(&buf, img, format, quality); err != nil {
+		hlog.Errorf("picture_services - renderDerivative: encode picture failed, %s\n", err)
+		return nil, errno.OperationErr
+	}
+	return buf.Bytes(), nil
+}
+
+// matchETag - If-None-Match 中是否包含指定的 ETag, 忽略弱校验前缀
+func matchETag(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// isImagePreset - 参数是否为允许的预设值
+func isImagePreset(value int32, presets ...int32) bool {
+	for _, preset := range presets {
+		if value == preset {
+			return true
+		}
+	}
+	return false
+}
+
+// renderLimiter - 令牌桶, 限制缓存未命中时渲染衍生图的频率
+var renderLimiter struct {
+	sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// allowRender - 取得一次渲染的令牌, 超出频率时返回 false
+func allowRender() bool {
+	renderLimiter.Lock()
+	defer renderLimiter.Unlock()
+	now := time.Now()
+	if renderLimiter.last.IsZero() {
+		renderLimiter.tokens = constants.ImageRenderBurst
+	} else {
+		renderLimiter.tokens = math.Min(renderLimiter.tokens+now.Sub(renderLimiter.last).Seconds()*constants.ImageRenderRate, constants.ImageRenderBurst)
+	}
+	renderLimiter.last = now
+	if renderLimiter.tokens < 1 {
+		return false
+	}
+	renderLimiter.tokens--
+	return true
+}
+
+// derivativeKey - 衍生图的存储 key, 与原图位于同一目录
+func derivativeKey(storageKey string, opt *image_util.TransformOption, quality int, format string) string {
+	return fmt.Sprintf("%s_w%d_h%d_%s_q%d.%s", strings.TrimSuffix(storageKey, path.Ext(storageKey)), opt.Width, opt.Height, opt.Fit, quality, format)
+}
+
+// outputFormat - 未指定格式时沿用原图格式, 不支持输出的格式转换为 png
+func outputFormat(picFormat string) string {
+	switch strings.ToLower(picFormat) {
+	case "jpg", image_util.FormatJpeg, "avif", "heic":
+		return image_util.FormatJpeg
+	case image_util.FormatWebp:
+		return image_util.FormatWebp
+	default:
+		return image_util.FormatPng
+	}
+}
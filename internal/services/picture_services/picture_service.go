@@ -2,13 +2,20 @@ package picture_services
 
 import (
 	"context"
+	"fmt"
+	"github.com/Alf-Grindel/clide/config"
 	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
 	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
+	"github.com/Alf-Grindel/clide/internal/model"
 	"github.com/Alf-Grindel/clide/internal/model/base"
+	tencentCos "github.com/Alf-Grindel/clide/internal/pkg/cos_client"
+	"github.com/Alf-Grindel/clide/internal/pkg/image_util"
 	"github.com/Alf-Grindel/clide/internal/services/user_services"
 	"github.com/Alf-Grindel/clide/pkg/constants"
 	"github.com/bytedance/sonic"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -23,7 +30,14 @@ func NewPictureService(ctx context.Context) *PictureService {
 }
 
 // ObjToVo - 转化为脱敏对象
-func ObjToVo(oldPicture *db_picture.Picture, user *db_user.User) *base.PictureVo {
+// params:
+//   - oldPicture
+//   - user: 图片作者
+//   - viewer: 当前登录用户, 未登录时为 nil; 开启水印时除作者与管理员外获取带水印的衍生图地址
+//
+// returns:
+//   - pictureVo
+func ObjToVo(oldPicture *db_picture.Picture, user *db_user.User, viewer *model.LoginUser) *base.PictureVo {
 	if oldPicture == nil || user == nil {
 		return nil
 	}
@@ -36,29 +50,45 @@ func ObjToVo(oldPicture *db_picture.Picture, user *db_user.User) *base.PictureVo
 		}
 	}
 
+	palette := unmarshalPalette(oldPicture.PicPalette)
+
 	currentUser := user_services.ObjToVo(user)
 
+	url, thumbnailUrl, compressedUrl := pictureUrls(oldPicture, viewer)
+
 	return &base.PictureVo{
-		ID:           oldPicture.Id,
-		URL:          oldPicture.Url,
-		PicName:      oldPicture.PicName,
-		Introduction: oldPicture.Introduction,
-		Category:     oldPicture.Category,
-		Tags:         tagsList,
-		PicSize:      oldPicture.PicSize,
-		PicWidth:     oldPicture.PicWidth,
-		PicHeight:    oldPicture.PicHeight,
-		PicScale:     oldPicture.PicScale,
-		PicFormat:    oldPicture.PicFormat,
-		EditTime:     oldPicture.EditTime.Format(time.DateTime),
-		CreateTime:   oldPicture.CreateTime.Format(time.DateTime),
-		UserId:       oldPicture.UserId,
-		User:         currentUser,
+		ID:            oldPicture.Id,
+		URL:           url,
+		PicName:       oldPicture.PicName,
+		Introduction:  oldPicture.Introduction,
+		Category:      oldPicture.Category,
+		Tags:          tagsList,
+		PicSize:       oldPicture.PicSize,
+		PicWidth:      oldPicture.PicWidth,
+		PicHeight:     oldPicture.PicHeight,
+		PicScale:      oldPicture.PicScale,
+		PicFormat:     oldPicture.PicFormat,
+		EditTime:      oldPicture.EditTime.Format(time.DateTime),
+		CreateTime:    oldPicture.CreateTime.Format(time.DateTime),
+		UserId:        oldPicture.UserId,
+		User:          currentUser,
+		PicColor:      oldPicture.PicColor,
+		PicPalette:    palette,
+		ExifMake:      oldPicture.ExifMake,
+		ExifModel:     oldPicture.ExifModel,
+		ExifTakenAt:   formatTime(oldPicture.ExifTakenAt),
+		ThumbnailUrl:  thumbnailUrl,
+		CompressedUrl: compressedUrl,
+		PicFrames:     int32(oldPicture.PicFrames),
+		PicDuration:   oldPicture.PicDuration,
+		SpaceId:       oldPicture.SpaceId,
+		LikeCount:     oldPicture.LikeCount,
+		FavoriteCount: oldPicture.FavoriteCount,
 	}
 }
 
 // ObjsToVos - 转化为脱敏列表
-func ObjsToVos(ctx context.Context, oldPictures []*db_picture.Picture) []*base.PictureVo {
+func ObjsToVos(ctx context.Context, oldPictures []*db_picture.Picture, viewer *model.LoginUser) []*base.PictureVo {
 	if oldPictures == nil {
 		return nil
 	}
@@ -68,7 +98,7 @@ func ObjsToVos(ctx context.Context, oldPictures []*db_picture.Picture) []*base.P
 		if err != nil {
 			return nil
 		}
-		pictures = append(pictures, ObjToVo(oldPicture, user))
+		pictures = append(pictures, ObjToVo(oldPicture, user, viewer))
 	}
 	return pictures
 }
@@ -87,30 +117,45 @@ func ObjToObj(oldPicture *db_picture.Picture, oldUser *db_user.User) *base.Pictu
 		}
 	}
 
+	palette := unmarshalPalette(oldPicture.PicPalette)
+
 	user := user_services.ObjToObj(oldUser)
 
 	return &base.Picture{
-		ID:            oldPicture.Id,
-		URL:           oldPicture.Url,
-		PicName:       oldPicture.PicName,
-		Introduction:  oldPicture.Introduction,
-		Category:      oldPicture.Category,
-		Tags:          tagsList,
-		PicSize:       oldPicture.PicSize,
-		PicWidth:      oldPicture.PicWidth,
-		PicHeight:     oldPicture.PicHeight,
-		PicScale:      oldPicture.PicScale,
-		PicFormat:     oldPicture.PicFormat,
-		EditTime:      oldPicture.EditTime.Format(time.DateTime),
-		CreateTime:    oldPicture.CreateTime.Format(time.DateTime),
-		UpdateTime:    oldPicture.UpdateTime.Format(time.DateTime),
-		IsDelete:      constants.IsDeleteMap[oldPicture.IsDelete],
-		UserId:        oldPicture.UserId,
-		User:          user,
-		ReviewStatus:  constants.ReviewStatusMap[oldPicture.ReviewStatus],
-		ReviewMessage: oldPicture.ReviewMessage,
-		ReviewId:      oldPicture.ReviewId,
-		ReviewTime:    oldPicture.ReviewTime.Format(time.DateTime),
+		ID:              oldPicture.Id,
+		URL:             tencentCos.AccessUrl(oldPicture.StorageKey, oldPicture.Url),
+		PicName:         oldPicture.PicName,
+		Introduction:    oldPicture.Introduction,
+		Category:        oldPicture.Category,
+		Tags:            tagsList,
+		PicSize:         oldPicture.PicSize,
+		PicWidth:        oldPicture.PicWidth,
+		PicHeight:       oldPicture.PicHeight,
+		PicScale:        oldPicture.PicScale,
+		PicFormat:       oldPicture.PicFormat,
+		EditTime:        oldPicture.EditTime.Format(time.DateTime),
+		CreateTime:      oldPicture.CreateTime.Format(time.DateTime),
+		UpdateTime:      oldPicture.UpdateTime.Format(time.DateTime),
+		IsDelete:        constants.IsDeleteMap[oldPicture.IsDelete],
+		UserId:          oldPicture.UserId,
+		User:            user,
+		ReviewStatus:    constants.ReviewStatusMap[oldPicture.ReviewStatus],
+		ReviewMessage:   oldPicture.ReviewMessage,
+		ReviewId:        oldPicture.ReviewId,
+		ReviewTime:      oldPicture.ReviewTime.Format(time.DateTime),
+		PicColor:        oldPicture.PicColor,
+		PicPalette:      palette,
+		ExifMake:        oldPicture.ExifMake,
+		ExifModel:       oldPicture.ExifModel,
+		ExifTakenAt:     formatTime(oldPicture.ExifTakenAt),
+		ExifOrientation: int32(oldPicture.ExifOrientation),
+		ThumbnailUrl:    variantUrl(oldPicture, oldPicture.ThumbnailKey),
+		CompressedUrl:   variantUrl(oldPicture, oldPicture.CompressedKey),
+		PicFrames:       int32(oldPicture.PicFrames),
+		PicDuration:     oldPicture.PicDuration,
+		SpaceId:         oldPicture.SpaceId,
+		LikeCount:       oldPicture.LikeCount,
+		FavoriteCount:   oldPicture.FavoriteCount,
 	}
 }
 
@@ -129,3 +174,55 @@ func ObjsToObjs(ctx context.Context, oldPictures []*db_picture.Picture) []*base.
 	}
 	return pictures
 }
+
+// unmarshalPalette - 解析调色板, 解析失败时忽略
+func unmarshalPalette(palette string) []string {
+	if palette == "" {
+		return nil
+	}
+	var paletteList []string
+	if err := sonic.Unmarshal([]byte(palette), &paletteList); err != nil {
+		hlog.Errorf("picture_services - unmarshalPalette: unmarshal palette failed, %s\n", err)
+		return nil
+	}
+	return paletteList
+}
+
+// pictureUrls - 获取原图、缩略图与压缩图地址
+// 开启水印时, 除作者与管理员外均指向带水印的 /file/image 衍生图, 不暴露原图与预生成的变体
+func pictureUrls(oldPicture *db_picture.Picture, viewer *model.LoginUser) (string, string, string) {
+	if watermark, _ := currentWatermark(); watermark != nil && oldPicture.StorageKey != "" &&
+		(viewer == nil || (viewer.Id != oldPicture.UserId && viewer.Role != "admin")) {
+		return imageUrl(oldPicture.Id, ""),
+			imageUrl(oldPicture.Id, fmt.Sprintf("width=%d", constants.ImagePresetThumbnail)),
+			imageUrl(oldPicture.Id, fmt.Sprintf("width=%d&format=%s", constants.ImagePresetPreview, image_util.FormatWebp))
+	}
+	return tencentCos.AccessUrl(oldPicture.StorageKey, oldPicture.Url),
+		variantUrl(oldPicture, oldPicture.ThumbnailKey),
+		variantUrl(oldPicture, oldPicture.CompressedKey)
+}
+
+// imageUrl - 获取 /file/image 衍生图地址
+func imageUrl(id int64, query string) string {
+	url := strings.TrimSuffix(config.Watermark.Host, "/") + "/file/image/" + strconv.FormatInt(id, 10)
+	if query != "" {
+		url += "?" + query
+	}
+	return url
+}
+
+// variantUrl - 获取缩略图或压缩图地址, 未生成时使用原图地址
+func variantUrl(oldPicture *db_picture.Picture, key string) string {
+	if key == "" {
+		return tencentCos.AccessUrl(oldPicture.StorageKey, oldPicture.Url)
+	}
+	return tencentCos.ObjectUrl(key)
+}
+
+// formatTime - 格式化可能为空的时间
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateTime)
+}
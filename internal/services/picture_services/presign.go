@@ -0,0 +1,85 @@
+package picture_services
+
+import (
+	"fmt"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/model/clide/picture"
+	tencentCos "github.com/Alf-Grindel/clide/internal/pkg/cos_client"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PresignUpload 获取直传地址 - 客户端直接将图片上传至暂存目录, 再调用 ConfirmUpload 创建图片
+// params:
+//   - req: 获取直传地址请求体
+//     required: fileName, fileSize
+//   - c: 请求上下文
+//
+// returns:
+//   - key: 对象 key
+//   - uploadUrl: 限时上传地址, 使用 PUT 上传
+//   - expireTime: 地址过期时间
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) PresignUpload(req *picture.PresignUploadReq, c *app.RequestContext) (string, string, time.Time, error) {
+	if req == nil {
+		return "", "", time.Time{}, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	if req.FileSize > maxSessionFileSize() {
+		return "", "", time.Time{}, errno.ParamErr.WithMessage(fmt.Sprintf("上传文件大小不能超过 %d MB", maxSessionFileSize()/1024/1024))
+	}
+	expire := config.Upload.PresignExpire
+	if expire <= 0 {
+		expire = constants.DefaultPresignExpire
+	}
+	userId := strconv.FormatInt(loginUser.Id, 10)
+	key, uploadUrl, err := tencentCos.PresignPicture(s.ctx, fmt.Sprintf(constants.StagingSpace, userId), req.FileName, req.FileSize, expire)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	// 未确认的暂存对象在保留期后清理
+	schedulePurge(s.ctx, key)
+	return key, uploadUrl, time.Now().Add(expire), nil
+}
+
+// ConfirmUpload 确认直传 - 校验暂存对象, 处理后写入正式目录并创建或更新图片
+// params:
+//   - req: 确认直传请求体
+//     required: key
+//     optional: pictureId, picName, keepGps
+//   - c: 请求上下文
+//
+// returns:
+//   - pictureId: 重复图片且 dedupMode 为 reuse 时返回已有图片id
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) ConfirmUpload(req *picture.ConfirmUploadReq, c *app.RequestContext) (int64, error) {
+	if req == nil {
+		return 0, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return 0, err
+	}
+	// 仅能确认本人暂存目录下的对象, 确认后暂存对象被删除, 不能重复确认
+	userId := strconv.FormatInt(loginUser.Id, 10)
+	dirPrefix := fmt.Sprintf(constants.StagingSpace, userId) + "/"
+	if !strings.HasPrefix(req.Key, dirPrefix) || strings.Contains(req.Key, "..") {
+		return 0, errno.NoAuthErr
+	}
+	uploadReq := &picture.UploadPictureReq{
+		ID:      req.ID,
+		PicName: req.PicName,
+		KeepGps: req.KeepGps,
+	}
+	return s.savePicture(uploadReq, loginUser, func(opt *tencentCos.UploadOption) (*tencentCos.File, error) {
+		return tencentCos.UploadPictureByObject(s.ctx, req.Key, maxSessionFileSize(), opt)
+	}, nil)
+}
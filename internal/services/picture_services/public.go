@@ -5,22 +5,25 @@ import (
 	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
 	"github.com/Alf-Grindel/clide/internal/model/base"
 	"github.com/Alf-Grindel/clide/internal/model/clide/picture"
+	"github.com/Alf-Grindel/clide/internal/pkg/image_util"
 	"github.com/Alf-Grindel/clide/pkg/constants"
 	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
 )
 
-// PictureSearch - 图片搜索[分页]
+// PictureSearch - 公共图库图片搜索[分页]
 // params:
 //   - req: 图片搜索请求体
 //     required: currentPage, pageSize
 //     optional: pictureId, picName, introduction, category, tags, picSize, picWidth, picHeight
-//     optional: picScale, picFormat, searchText, userId
+//     optional: picScale, picFormat, searchText, userId, picColor, colorTolerance
+//   - c: 请求上下文, 未登录时视为匿名访客
 //
 // returns:
 //   - total: total number of matched users
 //   - picturesVos: 图片脱敏信息列表
 //   - error: nil on success, non-nil on failure
-func (s *PictureService) PictureSearch(req *picture.PictureSearchReq) (int64, []*base.PictureVo, error) {
+func (s *PictureService) PictureSearch(req *picture.PictureSearchReq, c *app.RequestContext) (int64, []*base.PictureVo, error) {
 	if req == nil {
 		return 0, nil, errno.ParamErr
 	}
@@ -48,23 +51,42 @@ func (s *PictureService) PictureSearch(req *picture.PictureSearchReq) (int64, []
 		tags = req.GetTags()
 	}
 	searchText := req.GetSearchText()
+	var colorFilter *db_picture.ColorFilter
+	if req.PicColor != nil {
+		rgb, err := image_util.ParseHexColor(req.GetPicColor())
+		if err != nil {
+			return 0, nil, errno.ParamErr.WithMessage("颜色格式错误")
+		}
+		colorFilter = &db_picture.ColorFilter{
+			R:         int(rgb.R),
+			G:         int(rgb.G),
+			B:         int(rgb.B),
+			Tolerance: constants.ColorTolerance,
+		}
+		if req.ColorTolerance != nil {
+			colorFilter.Tolerance = int(req.GetColorTolerance())
+		}
+	}
 
-	total, oldPictures, err := db_picture.QueryPicture(s.ctx, search, searchText, tags, currentPage, pageSize)
+	total, oldPictures, err := db_picture.QueryPicture(s.ctx, search, searchText, tags, colorFilter, currentPage, pageSize)
 	if err != nil {
 		return 0, nil, errno.NotFoundErr
 	}
-	return total, ObjsToVos(s.ctx, oldPictures), nil
+	pictureVos := ObjsToVos(s.ctx, oldPictures, optionalLoginUser(c))
+	FillPictureState(s.ctx, pictureVos, LoginUserId(c))
+	return total, pictureVos, nil
 }
 
-// PictureGetById - 根据id获取图片
+// PictureGetById - 根据id获取图片, 空间内的图片仅空间成员可获取
 // params:
 //   - req: 图片获取请求体
 //     required: pictureId
+//   - c: 请求上下文, 未登录时视为匿名访客
 //
 // returns:
 //   - pictureVo: 脱敏图片数据
 //   - error: nil on success, non-nil on failure
-func (s *PictureService) PictureGetById(req *picture.PictureGetByIdReq) (*base.PictureVo, error) {
+func (s *PictureService) PictureGetById(req *picture.PictureGetByIdReq, c *app.RequestContext) (*base.PictureVo, error) {
 	if req == nil {
 		return nil, errno.ParamErr
 	}
@@ -72,9 +94,14 @@ func (s *PictureService) PictureGetById(req *picture.PictureGetByIdReq) (*base.P
 	if err != nil {
 		return nil, errno.NotFoundErr
 	}
+	if err = s.checkPictureView(oldPicture, c); err != nil {
+		return nil, err
+	}
 	oldUser, err := db_user.QueryUserById(s.ctx, oldPicture.UserId)
 	if err != nil {
 		return nil, errno.NotFoundErr
 	}
-	return ObjToVo(oldPicture, oldUser), nil
+	pictureVo := ObjToVo(oldPicture, oldUser, optionalLoginUser(c))
+	FillPictureState(s.ctx, []*base.PictureVo{pictureVo}, LoginUserId(c))
+	return pictureVo, nil
 }
@@ -0,0 +1,81 @@
+package picture_services
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	tencentCos "github.com/Alf-Grindel/clide/internal/pkg/cos_client"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"time"
+)
+
+// schedulePurge - 登记待清理的存储对象, 保留期过后由 RunStoragePurge 删除
+// params:
+//   - ctx
+//   - storageKeys: 为空的 key 会被忽略
+//
+// returns:
+func schedulePurge(ctx context.Context, storageKeys ...string) {
+	retention := config.Storage.PurgeRetention
+	if retention <= 0 {
+		retention = constants.DefaultPurgeRetention
+	}
+	for _, storageKey := range storageKeys {
+		if storageKey == "" {
+			continue
+		}
+		if err := db_picture.CreateStoragePurge(ctx, storageKey, time.Now().Add(retention)); err != nil {
+			hlog.Errorf("picture_services - schedulePurge: schedule purge failed, key - %s, %s\n", storageKey, err)
+		}
+	}
+}
+
+// RunStoragePurge - 定时清理已过保留期的存储对象与过期的分片上传会话, 直到 ctx 结束
+// params:
+//   - ctx
+//
+// returns:
+func RunStoragePurge(ctx context.Context) {
+	interval := config.Storage.PurgeInterval
+	if interval <= 0 {
+		interval = constants.DefaultPurgeInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		purgeStorage(ctx)
+		purgeUploadSession(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeStorage - 清理一批到期的存储对象
+func purgeStorage(ctx context.Context) {
+	purges, err := db_picture.QueryDueStoragePurge(ctx, time.Now(), constants.PurgeBatchSize)
+	if err != nil || len(purges) == 0 {
+		return
+	}
+	storage := tencentCos.NewStorage()
+	for _, purge := range purges {
+		// 对象仍被未删除的图片引用时仅移除清理记录
+		total, err := db_picture.CountPictureByStorageKey(ctx, purge.StorageKey)
+		if err != nil {
+			continue
+		}
+		if total == 0 {
+			if err = storage.DeleteObj(ctx, purge.StorageKey); err != nil {
+				hlog.Errorf("picture_services - purgeStorage: delete object failed, key - %s, %s\n", purge.StorageKey, err)
+				continue
+			}
+		}
+		if err = db_picture.DeleteStoragePurge(ctx, purge.Id); err != nil {
+			continue
+		}
+		hlog.Infof("picture_services - purgeStorage: purge object success, key - %s\n", purge.StorageKey)
+	}
+}
@@ -0,0 +1,122 @@
+package picture_services
+
+import (
+	"context"
+	"errors"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_space"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+)
+
+// usageDelta - 保存图片后用量归属方的用量变化, 空间内的图片计入空间用量, 其余计入用户用量
+// params:
+//   - oldPicture: 被替换的图片, 新增时为 nil
+//   - userId: 保存后图片的归属用户
+//   - spaceId: 保存后图片的所属空间, 0 表示公共图库
+//   - size: 新文件大小
+//
+// returns:
+//   - count, size: 用量归属方的用量变化
+func usageDelta(oldPicture *db_picture.Picture, userId, spaceId, size int64) (int64, int64) {
+	if oldPicture != nil && oldPicture.SpaceId == spaceId && (spaceId != 0 || oldPicture.UserId == userId) {
+		return 0, size - oldPicture.PicSize
+	}
+	return 1, size
+}
+
+// checkQuota - 写入存储前预先校验配额, 最终以 chargeUsage 为准
+// params:
+//   - oldPicture: 被替换的图片, 新增时为 nil
+//   - userId: 保存后图片的归属用户
+//   - spaceId: 保存后图片的所属空间, 0 表示公共图库
+//   - size: 新文件大小
+//
+// returns:
+//   - error: 超出配额时返回 QuotaErr
+func (s *PictureService) checkQuota(oldPicture *db_picture.Picture, userId, spaceId, size int64) error {
+	count, size := usageDelta(oldPicture, userId, spaceId, size)
+	var maxCount, maxSize, usedCount, usedSize int64
+	if spaceId != 0 {
+		oldSpace, err := db_space.QuerySpaceById(s.ctx, spaceId)
+		if err != nil {
+			return errno.NotFoundErr.WithMessage("空间不存在")
+		}
+		maxCount, maxSize = oldSpace.MaxCount, oldSpace.MaxSize
+		usedCount, usedSize = oldSpace.TotalCount, oldSpace.TotalSize
+	} else {
+		oldUser, err := db_user.QueryUserById(s.ctx, userId)
+		if err != nil {
+			return errno.NotFoundErr.WithMessage("用户不存在")
+		}
+		maxCount, maxSize = config.Quota.MaxCount, config.Quota.MaxSize
+		if oldUser.QuotaCount != nil {
+			maxCount = *oldUser.QuotaCount
+		}
+		if oldUser.QuotaSize != nil {
+			maxSize = *oldUser.QuotaSize
+		}
+		usedCount, usedSize = oldUser.UsedCount, oldUser.UsedSize
+	}
+	if count > 0 && maxCount > 0 && usedCount+count > maxCount {
+		return errno.QuotaErr.WithMessage("图片数量已达上限")
+	}
+	if size > 0 && maxSize > 0 && usedSize+size > maxSize {
+		return errno.QuotaErr.WithMessage("存储容量不足")
+	}
+	return nil
+}
+
+// chargeUsage - 按保存前后的图片变更用量, 需在事务中调用
+// params:
+//   - ctx: 事务上下文
+//   - oldPicture: 被替换的图片, 新增时为 nil
+//   - userId: 保存后图片的归属用户
+//   - spaceId: 保存后图片的所属空间, 0 表示公共图库
+//   - size: 新文件大小
+//
+// returns:
+//   - error: 超出配额时返回 QuotaErr
+func chargeUsage(ctx context.Context, oldPicture *db_picture.Picture, userId, spaceId, size int64) error {
+	count, size := usageDelta(oldPicture, userId, spaceId, size)
+	// 用量归属方变化时释放原归属方的用量
+	if oldPicture != nil && count > 0 {
+		if err := releaseUsage(ctx, oldPicture); err != nil {
+			return err
+		}
+	}
+	var ok bool
+	var err error
+	if spaceId != 0 {
+		ok, err = db_space.IncrSpaceUsage(ctx, spaceId, count, size)
+	} else {
+		ok, err = db_user.IncrUserUsage(ctx, userId, count, size, config.Quota.MaxCount, config.Quota.MaxSize)
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errno.QuotaErr
+	}
+	return nil
+}
+
+// releaseUsage - 删除图片后释放用量, 需在事务中调用
+func releaseUsage(ctx context.Context, oldPicture *db_picture.Picture) error {
+	if oldPicture.SpaceId != 0 {
+		_, err := db_space.IncrSpaceUsage(ctx, oldPicture.SpaceId, -1, -oldPicture.PicSize)
+		return err
+	}
+	_, err := db_user.IncrUserUsage(ctx, oldPicture.UserId, -1, -oldPicture.PicSize, config.Quota.MaxCount, config.Quota.MaxSize)
+	return err
+}
+
+// txErr - 事务中的业务错误原样返回, 其余视为系统错误
+func txErr(err error) error {
+	var errNo errno.ErrNo
+	if errors.As(err, &errNo) {
+		return errNo
+	}
+	return errno.SystemErr
+}
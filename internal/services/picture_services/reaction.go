@@ -0,0 +1,193 @@
+package picture_services
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_space"
+	"github.com/Alf-Grindel/clide/internal/model"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/model/clide/picture"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// PictureLike 点赞图片 - 已点赞时取消点赞, 仅能点赞可见的图片
+// params:
+//   - req: 点赞请求体
+//     required: pictureId
+//   - c: 请求上下文
+//
+// returns:
+//   - liked: 操作后是否已点赞
+//   - likeCount: 操作后的点赞数
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) PictureLike(req *picture.PictureLikeReq, c *app.RequestContext) (bool, int64, error) {
+	if req == nil {
+		return false, 0, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return false, 0, err
+	}
+	if err = s.checkPictureReact(req.ID, c); err != nil {
+		return false, 0, err
+	}
+	liked, likeCount, err := db_picture.ToggleLike(s.ctx, req.ID, loginUser.Id)
+	if err != nil {
+		return false, 0, errno.OperationErr.WithMessage("点赞失败")
+	}
+	return liked, likeCount, nil
+}
+
+// PictureFavorite 收藏图片 - 已收藏时取消收藏, 仅能收藏可见的图片
+// params:
+//   - req: 收藏请求体
+//     required: pictureId
+//   - c: 请求上下文
+//
+// returns:
+//   - favorited: 操作后是否已收藏
+//   - favoriteCount: 操作后的收藏数
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) PictureFavorite(req *picture.PictureFavoriteReq, c *app.RequestContext) (bool, int64, error) {
+	if req == nil {
+		return false, 0, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return false, 0, err
+	}
+	if err = s.checkPictureReact(req.ID, c); err != nil {
+		return false, 0, err
+	}
+	favorited, favoriteCount, err := db_picture.ToggleFavorite(s.ctx, req.ID, loginUser.Id)
+	if err != nil {
+		return false, 0, errno.OperationErr.WithMessage("收藏失败")
+	}
+	return favorited, favoriteCount, nil
+}
+
+// ListFavoritePicture 我的收藏[分页] - 按收藏时间倒序, 已不可见的图片不返回
+// params:
+//   - req: 收藏列表请求体
+//     required: currentPage, pageSize
+//   - c: 请求上下文
+//
+// returns:
+//   - total: total number of visible favorite pictures
+//   - pictureVos: 图片脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) ListFavoritePicture(req *picture.ListFavoritePictureReq, c *app.RequestContext) (int64, []*base.PictureVo, error) {
+	if req == nil {
+		return 0, nil, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	currentPage := req.CurrentPage
+	if currentPage < 1 {
+		currentPage = constants.CurrentPage
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = constants.PageSize
+	}
+	// 退出空间后其中的图片不再可见
+	var spaceIds []int64
+	memberSpaces, err := db_space.QuerySpaceByMember(s.ctx, loginUser.Id)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	for _, memberSpace := range memberSpaces {
+		spaceIds = append(spaceIds, memberSpace.Id)
+	}
+	if privateSpace, err := db_space.QuerySpaceByUserId(s.ctx, loginUser.Id, constants.SpaceTypePrivate); err == nil {
+		spaceIds = append(spaceIds, privateSpace.Id)
+	}
+	total, oldPictures, err := db_picture.QueryFavoritePicture(s.ctx, loginUser.Id, spaceIds, currentPage, pageSize)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	pictureVos := ObjsToVos(s.ctx, oldPictures, loginUser)
+	FillPictureState(s.ctx, pictureVos, loginUser.Id)
+	return total, pictureVos, nil
+}
+
+// FillPictureState - 填充当前用户对图片的点赞与收藏状态
+// params:
+//   - pictureVos
+//   - userId: 0 表示未登录, 不做查询
+func FillPictureState(ctx context.Context, pictureVos []*base.PictureVo, userId int64) {
+	if userId == 0 || len(pictureVos) == 0 {
+		return
+	}
+	pictureIds := make([]int64, 0, len(pictureVos))
+	for _, pictureVo := range pictureVos {
+		if pictureVo != nil {
+			pictureIds = append(pictureIds, pictureVo.ID)
+		}
+	}
+	likedIds, err := db_picture.QueryLikedPictureIds(ctx, userId, pictureIds)
+	if err != nil {
+		return
+	}
+	favoritedIds, err := db_picture.QueryFavoritedPictureIds(ctx, userId, pictureIds)
+	if err != nil {
+		return
+	}
+	liked := make(map[int64]bool, len(likedIds))
+	for _, id := range likedIds {
+		liked[id] = true
+	}
+	favorited := make(map[int64]bool, len(favoritedIds))
+	for _, id := range favoritedIds {
+		favorited[id] = true
+	}
+	for _, pictureVo := range pictureVos {
+		if pictureVo != nil {
+			pictureVo.Liked = liked[pictureVo.ID]
+			pictureVo.Favorited = favorited[pictureVo.ID]
+		}
+	}
+}
+
+// LoginUserId - 获取登录用户id, 未登录时返回 0
+func LoginUserId(c *app.RequestContext) int64 {
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return 0
+	}
+	return loginUser.Id
+}
+
+// optionalLoginUser - 获取登录用户, 未登录时返回 nil
+func optionalLoginUser(c *app.RequestContext) *model.LoginUser {
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil
+	}
+	return loginUser
+}
+
+// checkPictureReact - 图片需存在且对当前用户可见, 公共图库中他人的图片需已审核通过
+func (s *PictureService) checkPictureReact(pictureId int64, c *app.RequestContext) error {
+	oldPicture, err := db_picture.QueryPictureById(s.ctx, pictureId)
+	if err != nil {
+		return errno.NotFoundErr
+	}
+	if err = s.checkPictureView(oldPicture, c); err != nil {
+		return err
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	if oldPicture.SpaceId == 0 && oldPicture.UserId != loginUser.Id &&
+		oldPicture.ReviewStatus != constants.ReviewPictureMap["通过"] {
+		return errno.NotFoundErr
+	}
+	return nil
+}
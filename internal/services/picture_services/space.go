@@ -0,0 +1,49 @@
+package picture_services
+
+import (
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/model"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// checkPictureView - 公共图库的图片所有人可见, 空间内的图片仅空间成员可见
+// params:
+//   - oldPicture
+//   - c: 请求上下文, 未登录时视为匿名访客
+//
+// returns:
+//   - error: 无权查看时返回 NotFoundErr, 不暴露图片是否存在
+func (s *PictureService) checkPictureView(oldPicture *db_picture.Picture, c *app.RequestContext) error {
+	if oldPicture.SpaceId == 0 {
+		return nil
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return errno.NotFoundErr
+	}
+	if _, err = services.CheckSpaceRole(s.ctx, oldPicture.SpaceId, loginUser, constants.SpaceRoleViewer); err != nil {
+		return errno.NotFoundErr
+	}
+	return nil
+}
+
+// checkPictureEdit - 公共图库的图片仅本人或管理员可修改, 空间内的图片需空间编辑者及以上角色
+// params:
+//   - oldPicture
+//   - loginUser
+//
+// returns:
+//   - error: 无权修改时返回 NoAuthErr
+func (s *PictureService) checkPictureEdit(oldPicture *db_picture.Picture, loginUser *model.LoginUser) error {
+	if oldPicture.SpaceId == 0 {
+		if oldPicture.UserId != loginUser.Id && loginUser.Role != "admin" {
+			return errno.NoAuthErr
+		}
+		return nil
+	}
+	_, err := services.CheckSpaceRole(s.ctx, oldPicture.SpaceId, loginUser, constants.SpaceRoleEditor)
+	return err
+}
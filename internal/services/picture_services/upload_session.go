@@ -0,0 +1,302 @@
+package picture_services
+
+import (
+	"context"
+	"fmt"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/model/clide/picture"
+	tencentCos "github.com/Alf-Grindel/clide/internal/pkg/cos_client"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// InitUploadSession 创建分片上传会话
+// params:
+//   - req: 创建分片上传请求体
+//     required: fileName, fileSize
+//     optional: chunkSize, pictureId, picName, keepGps
+//   - c: 请求上下文
+//
+// returns:
+//   - session: 分片上传会话
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) InitUploadSession(req *picture.InitUploadSessionReq, c *app.RequestContext) (*db_picture.UploadSession, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	maxFileSize := maxSessionFileSize()
+	if req.FileSize > maxFileSize {
+		return nil, errno.ParamErr.WithMessage(fmt.Sprintf("上传文件大小不能超过 %d MB", maxFileSize/1024/1024))
+	}
+	chunkSize := int64(constants.DefaultChunkSize)
+	if req.ChunkSize != nil {
+		chunkSize = req.GetChunkSize()
+	}
+	if chunkSize < constants.MinChunkSize || chunkSize > constants.MaxChunkSize {
+		return nil, errno.ParamErr.WithMessage("分片大小错误")
+	}
+	chunkCount := (req.FileSize + chunkSize - 1) / chunkSize
+	if chunkCount > constants.MaxChunkCount {
+		return nil, errno.ParamErr.WithMessage("分片数量过多")
+	}
+	if req.ID != nil {
+		oldPicture, err := db_picture.QueryPictureById(s.ctx, req.GetID())
+		if err != nil {
+			return nil, errno.NotFoundErr.WithMessage("图片不存在")
+		}
+		if err = s.checkPictureEdit(oldPicture, loginUser); err != nil {
+			return nil, err
+		}
+	}
+	expire := config.Upload.SessionExpire
+	if expire <= 0 {
+		expire = constants.DefaultSessionExpire
+	}
+	session := &db_picture.UploadSession{
+		UserId:     loginUser.Id,
+		PictureId:  req.GetID(),
+		FileName:   req.FileName,
+		FileSize:   req.FileSize,
+		ChunkSize:  chunkSize,
+		ChunkCount: int(chunkCount),
+		PicName:    req.GetPicName(),
+		KeepGps:    req.GetKeepGps(),
+		Status:     constants.UploadSessionStatusActive,
+		ExpireTime: time.Now().Add(expire),
+	}
+	if _, err = db_picture.CreateUploadSession(s.ctx, session); err != nil {
+		return nil, errno.SystemErr
+	}
+	return session, nil
+}
+
+// UploadSessionChunk 上传指定序号的分片, 重复上传会覆盖已有分片
+// params:
+//   - req: 上传分片请求体
+//     required: uploadId, index
+//   - body: 分片内容
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) UploadSessionChunk(req *picture.UploadSessionChunkReq, body []byte, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	session, err := s.getActiveSession(req.UploadID, c)
+	if err != nil {
+		return err
+	}
+	index := int64(req.Index)
+	if index >= int64(session.ChunkCount) {
+		return errno.ParamErr.WithMessage("分片序号错误")
+	}
+	// 除最后一片外的分片大小必须与会话一致
+	expectSize := session.ChunkSize
+	if index == int64(session.ChunkCount)-1 {
+		expectSize = session.FileSize - session.ChunkSize*index
+	}
+	if int64(len(body)) != expectSize {
+		return errno.ParamErr.WithMessage("分片大小错误")
+	}
+	dir := sessionPartDir(session.Id)
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		hlog.Errorf("picture_services - UploadSessionChunk: make dir failed, %s\n", err)
+		return errno.OperationErr
+	}
+	// 先写临时文件再重命名, 避免合并时读到未写完的分片
+	tempFile, err := os.CreateTemp(dir, "chunk-*")
+	if err != nil {
+		hlog.Errorf("picture_services - UploadSessionChunk: create temp file failed, %s\n", err)
+		return errno.OperationErr
+	}
+	defer os.Remove(tempFile.Name())
+	_, err = tempFile.Write(body)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		hlog.Errorf("picture_services - UploadSessionChunk: write chunk failed, %s\n", err)
+		return errno.OperationErr
+	}
+	if err = os.Rename(tempFile.Name(), sessionPartPath(session.Id, int(index))); err != nil {
+		hlog.Errorf("picture_services - UploadSessionChunk: rename chunk failed, %s\n", err)
+		return errno.OperationErr
+	}
+	return nil
+}
+
+// GetUploadSession 查询分片上传进度, 用于断点续传
+// params:
+//   - req: 查询分片上传请求体
+//     required: uploadId
+//   - c: 请求上下文
+//
+// returns:
+//   - session: 分片上传会话
+//   - uploadedChunks: 已上传的分片序号
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) GetUploadSession(req *picture.GetUploadSessionReq, c *app.RequestContext) (*db_picture.UploadSession, []int32, error) {
+	if req == nil {
+		return nil, nil, errno.ParamErr
+	}
+	session, err := s.getActiveSession(req.UploadID, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	uploadedChunks := make([]int32, 0, session.ChunkCount)
+	for index := 0; index < session.ChunkCount; index++ {
+		if _, err = os.Stat(sessionPartPath(session.Id, index)); err == nil {
+			uploadedChunks = append(uploadedChunks, int32(index))
+		}
+	}
+	return session, uploadedChunks, nil
+}
+
+// CompleteUploadSession 合并分片并创建图片记录
+// params:
+//   - req: 完成分片上传请求体
+//     required: uploadId
+//   - c: 请求上下文
+//
+// returns:
+//   - pictureId: 重复图片且 dedupMode 为 reuse 时返回已有图片id
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) CompleteUploadSession(req *picture.CompleteUploadSessionReq, c *app.RequestContext) (int64, error) {
+	if req == nil {
+		return 0, errno.ParamErr
+	}
+	session, err := s.getActiveSession(req.UploadID, c)
+	if err != nil {
+		return 0, err
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return 0, err
+	}
+	partPaths := make([]string, 0, session.ChunkCount)
+	for index := 0; index < session.ChunkCount; index++ {
+		partPath := sessionPartPath(session.Id, index)
+		if _, err = os.Stat(partPath); err != nil {
+			return 0, errno.ParamErr.WithMessage(fmt.Sprintf("分片 %d 未上传", index))
+		}
+		partPaths = append(partPaths, partPath)
+	}
+	// 锁定会话, 防止重复合并
+	ok, err := db_picture.UpdateUploadSessionStatus(s.ctx, session.Id, constants.UploadSessionStatusActive, constants.UploadSessionStatusClosed)
+	if err != nil {
+		return 0, errno.SystemErr
+	}
+	if !ok {
+		return 0, errno.OperationErr.WithMessage("上传会话正在处理")
+	}
+	uploadReq := &picture.UploadPictureReq{
+		KeepGps: &session.KeepGps,
+	}
+	if session.PictureId != 0 {
+		uploadReq.ID = &session.PictureId
+	}
+	if session.PicName != "" {
+		uploadReq.PicName = &session.PicName
+	}
+	id, err := s.savePicture(uploadReq, loginUser, func(opt *tencentCos.UploadOption) (*tencentCos.File, error) {
+		return tencentCos.UploadPictureByParts(s.ctx, session.FileName, session.FileSize, maxSessionFileSize(), partPaths, opt)
+	}, nil)
+	if err != nil && id == 0 {
+		// 失败后解锁会话, 允许重新上传分片后再次合并
+		_, _ = db_picture.UpdateUploadSessionStatus(s.ctx, session.Id, constants.UploadSessionStatusClosed, constants.UploadSessionStatusActive)
+		return 0, err
+	}
+	removeUploadSession(s.ctx, session.Id)
+	return id, nil
+}
+
+// AbortUploadSession 取消分片上传并删除已上传的分片
+// params:
+//   - req: 取消分片上传请求体
+//     required: uploadId
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *PictureService) AbortUploadSession(req *picture.AbortUploadSessionReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	session, err := s.getActiveSession(req.UploadID, c)
+	if err != nil {
+		return err
+	}
+	removeUploadSession(s.ctx, session.Id)
+	return nil
+}
+
+// getActiveSession - 查询当前用户未过期且未在合并中的上传会话
+func (s *PictureService) getActiveSession(uploadId int64, c *app.RequestContext) (*db_picture.UploadSession, error) {
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	session, err := db_picture.QueryUploadSessionById(s.ctx, uploadId)
+	if err != nil {
+		return nil, errno.NotFoundErr.WithMessage("上传会话不存在或已过期")
+	}
+	if session.UserId != loginUser.Id {
+		return nil, errno.NoAuthErr
+	}
+	if session.Status != constants.UploadSessionStatusActive {
+		return nil, errno.OperationErr.WithMessage("上传会话正在处理")
+	}
+	return session, nil
+}
+
+// purgeUploadSession - 清理一批过期的上传会话及其分片
+func purgeUploadSession(ctx context.Context) {
+	sessions, err := db_picture.QueryExpiredUploadSession(ctx, time.Now(), constants.PurgeBatchSize)
+	if err != nil {
+		return
+	}
+	for _, session := range sessions {
+		removeUploadSession(ctx, session.Id)
+	}
+}
+
+// removeUploadSession - 删除上传会话记录与分片目录
+func removeUploadSession(ctx context.Context, id int64) {
+	if err := os.RemoveAll(sessionPartDir(id)); err != nil {
+		hlog.Errorf("picture_services - removeUploadSession: remove chunks failed, id - %d, %s\n", id, err)
+		return
+	}
+	_ = db_picture.DeleteUploadSession(ctx, id)
+}
+
+func maxSessionFileSize() int64 {
+	if config.Upload.MaxSessionFileSize > 0 {
+		return config.Upload.MaxSessionFileSize
+	}
+	return constants.DefaultMaxSessionFileSize
+}
+
+func sessionPartDir(id int64) string {
+	root := config.Upload.SessionDir
+	if root == "" {
+		root = filepath.Join(os.TempDir(), constants.UploadSessionDir)
+	}
+	return filepath.Join(root, strconv.FormatInt(id, 10))
+}
+
+func sessionPartPath(id int64, index int) string {
+	return filepath.Join(sessionPartDir(id), strconv.Itoa(index)+".part")
+}
@@ -0,0 +1,86 @@
+package picture_services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/Alf-Grindel/clide/config"
+	"github.com/Alf-Grindel/clide/internal/pkg/image_util"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"image"
+	"image/color"
+	"os"
+	"sync"
+)
+
+const (
+	watermarkTypeText  = "text"
+	watermarkTypeImage = "image"
+)
+
+// watermarkCache - 按当前配置缓存渲染好的水印, 配置重新加载后重建
+var watermarkCache struct {
+	sync.Mutex
+	conf        any
+	watermark   *image_util.Watermark
+	fingerprint string
+}
+
+// currentWatermark - 获取当前配置的水印
+// params:
+//
+// returns:
+//   - watermark: 未开启或配置错误时为 nil
+//   - fingerprint: 水印配置摘要, 用于区分衍生图缓存
+func currentWatermark() (*image_util.Watermark, string) {
+	watermarkCache.Lock()
+	defer watermarkCache.Unlock()
+	if watermarkCache.conf == any(config.Watermark) {
+		return watermarkCache.watermark, watermarkCache.fingerprint
+	}
+	watermarkCache.conf = config.Watermark
+	watermarkCache.watermark, watermarkCache.fingerprint = nil, ""
+	conf := config.Watermark
+	if conf == nil || !conf.Enabled {
+		return nil, ""
+	}
+	var mark image.Image
+	var err error
+	switch conf.Type {
+	case watermarkTypeImage:
+		mark, err = loadWatermarkImage(conf.Image)
+	case watermarkTypeText:
+		// 颜色未配置或格式错误时使用白色
+		textColor, parseErr := image_util.ParseHexColor(conf.Color)
+		if parseErr != nil {
+			textColor = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+		}
+		mark, err = image_util.RenderText(conf.Text, textColor)
+	default:
+		err = fmt.Errorf("unknown watermark type %q", conf.Type)
+	}
+	if err != nil {
+		hlog.Errorf("picture_services - currentWatermark: build watermark failed, %s\n", err)
+		return nil, ""
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%+v", *conf)))
+	watermarkCache.watermark = &image_util.Watermark{
+		Mark:     mark,
+		Position: conf.Position,
+		Opacity:  conf.Opacity,
+		Scale:    conf.Scale,
+	}
+	watermarkCache.fingerprint = hex.EncodeToString(sum[:4])
+	return watermarkCache.watermark, watermarkCache.fingerprint
+}
+
+// loadWatermarkImage - 读取水印图片
+func loadWatermarkImage(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	mark, _, err := image_util.Decode(file)
+	return mark, err
+}
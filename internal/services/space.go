@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_space"
+	"github.com/Alf-Grindel/clide/internal/model"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+)
+
+// GetSpaceRole - 获取用户在空间中的角色, 私有空间所有者视为空间管理员
+// params:
+//   - oldSpace
+//   - userId
+//
+// returns:
+//   - spaceRole: 非空间成员返回空字符串
+func GetSpaceRole(ctx context.Context, oldSpace *db_space.Space, userId int64) string {
+	if oldSpace.SpaceType == constants.SpaceTypePrivate {
+		if oldSpace.UserId == userId {
+			return constants.SpaceRoleAdmin
+		}
+		return ""
+	}
+	spaceUser, err := db_space.QuerySpaceUser(ctx, oldSpace.Id, userId)
+	if err != nil {
+		return ""
+	}
+	return spaceUser.SpaceRole
+}
+
+// CheckSpaceRole - 校验登录用户在空间中的角色不低于 minRole
+// params:
+//   - spaceId
+//   - loginUser
+//   - minRole: viewer, editor, admin
+//
+// returns:
+//   - space
+//   - error: 空间不存在返回 NotFoundErr, 权限不足返回 NoAuthErr
+func CheckSpaceRole(ctx context.Context, spaceId int64, loginUser *model.LoginUser, minRole string) (*db_space.Space, error) {
+	oldSpace, err := db_space.QuerySpaceById(ctx, spaceId)
+	if err != nil {
+		return nil, errno.NotFoundErr.WithMessage("空间不存在")
+	}
+	role := GetSpaceRole(ctx, oldSpace, loginUser.Id)
+	if constants.SpaceRoleLevelMap[role] < constants.SpaceRoleLevelMap[minRole] {
+		return nil, errno.NoAuthErr.WithMessage("无空间权限")
+	}
+	return oldSpace, nil
+}
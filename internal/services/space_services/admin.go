@@ -0,0 +1,112 @@
+package space_services
+
+import (
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_space"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/model/clide/space"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"time"
+)
+
+// UpdateSpace 更新空间 - 修改级别时未指定的上限按新级别重置
+// params:
+//   - req: 更新空间请求体
+//     required: spaceId
+//     optional: spaceName, spaceLevel, maxCount, maxSize
+//
+// returns:
+//   - spaceVo: 空间脱敏信息
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) UpdateSpace(req *space.UpdateSpaceReq) (*base.SpaceVo, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	if req.SpaceName == nil && req.SpaceLevel == nil && req.MaxCount == nil && req.MaxSize == nil {
+		return nil, errno.ParamErr.WithMessage("未有更新数据")
+	}
+	if _, err := db_space.QuerySpaceById(s.ctx, req.ID); err != nil {
+		return nil, errno.NotFoundErr.WithMessage("空间不存在")
+	}
+	updates := &db_space.Space{
+		Id:        req.ID,
+		SpaceName: req.GetSpaceName(),
+		EditTime:  time.Now(),
+	}
+	var level *int
+	maxCount, maxSize := req.MaxCount, req.MaxSize
+	if req.SpaceLevel != nil {
+		spaceLevel := int(req.GetSpaceLevel())
+		if _, ok := constants.SpaceLevelMap[spaceLevel]; !ok {
+			return nil, errno.ParamErr.WithMessage("空间级别不存在")
+		}
+		level = &spaceLevel
+		if maxCount == nil {
+			count := constants.SpaceLevelCountMap[spaceLevel]
+			maxCount = &count
+		}
+		if maxSize == nil {
+			size := constants.SpaceLevelSizeMap[spaceLevel]
+			maxSize = &size
+		}
+	}
+	if err := db_space.UpdateSpace(s.ctx, updates); err != nil {
+		return nil, errno.OperationErr.WithMessage("更新失败")
+	}
+	// 级别与上限可能为 0, 单独写入
+	if err := db_space.UpdateSpaceLimit(s.ctx, req.ID, level, maxCount, maxSize); err != nil {
+		return nil, errno.OperationErr.WithMessage("更新上限失败")
+	}
+	oldSpace, err := db_space.QuerySpaceById(s.ctx, req.ID)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	oldUser, err := db_user.QueryUserById(s.ctx, oldSpace.UserId)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	return ObjToVo(oldSpace, oldUser), nil
+}
+
+// QuerySpace 分页查询空间
+// params:
+//   - req: 查询空间请求体
+//     required: currentPage, pageSize
+//     optional: spaceId, userId, spaceName, spaceLevel, spaceType
+//
+// returns:
+//   - total: total number of matched spaces
+//   - spaceVos: 空间脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) QuerySpace(req *space.QuerySpaceReq) (int64, []*base.SpaceVo, error) {
+	if req == nil {
+		return 0, nil, errno.ParamErr
+	}
+	currentPage := req.CurrentPage
+	pageSize := req.PageSize
+	if currentPage < 1 {
+		currentPage = constants.CurrentPage
+	}
+	if pageSize < 1 || pageSize > 30 {
+		pageSize = constants.PageSize
+	}
+	search := &db_space.Space{
+		Id:         req.GetID(),
+		UserId:     req.GetUserID(),
+		SpaceName:  req.GetSpaceName(),
+		SpaceLevel: -1,
+		SpaceType:  -1,
+	}
+	if req.SpaceLevel != nil {
+		search.SpaceLevel = int(req.GetSpaceLevel())
+	}
+	if req.SpaceType != nil {
+		search.SpaceType = int(req.GetSpaceType())
+	}
+	total, oldSpaces, err := db_space.QuerySpace(s.ctx, search, currentPage, pageSize)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	return total, ObjsToVos(s.ctx, oldSpaces), nil
+}
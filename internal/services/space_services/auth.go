@@ -0,0 +1,257 @@
+package space_services
+
+import (
+	"context"
+	"errors"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_picture"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_space"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
+	"github.com/Alf-Grindel/clide/internal/model"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/model/clide/space"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/internal/services/picture_services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+	"sort"
+	"time"
+)
+
+// AddSpace 创建空间 - 每种类型每个用户仅能创建一个, 普通用户仅能创建普通版, 团队空间创建者为空间管理员
+// params:
+//   - req: 创建空间请求体
+//     optional: spaceName, spaceLevel, spaceType
+//   - c: 请求上下文
+//
+// returns:
+//   - spaceId
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) AddSpace(req *space.AddSpaceReq, c *app.RequestContext) (int64, error) {
+	if req == nil {
+		return 0, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return 0, err
+	}
+	level := int(req.GetSpaceLevel())
+	if _, ok := constants.SpaceLevelMap[level]; !ok {
+		return 0, errno.ParamErr.WithMessage("空间级别不存在")
+	}
+	if level != 0 && loginUser.Role != "admin" {
+		return 0, errno.NoAuthErr.WithMessage("无权限创建指定级别的空间")
+	}
+	spaceType := int(req.GetSpaceType())
+	if _, ok := constants.SpaceTypeMap[spaceType]; !ok {
+		return 0, errno.ParamErr.WithMessage("空间类型不存在")
+	}
+	spaceName := req.GetSpaceName()
+	if spaceName == "" {
+		spaceName = "默认空间"
+	}
+	addSpace := &db_space.Space{
+		SpaceName:  spaceName,
+		SpaceLevel: level,
+		SpaceType:  spaceType,
+		MaxCount:   constants.SpaceLevelCountMap[level],
+		MaxSize:    constants.SpaceLevelSizeMap[level],
+		UserId:     loginUser.Id,
+	}
+	var id int64
+	// 锁定用户, 避免并发请求创建多个空间
+	err = db.Transaction(s.ctx, func(ctx context.Context) error {
+		if err := db_user.LockUserById(ctx, loginUser.Id); err != nil {
+			return err
+		}
+		total, err := db_space.CountSpaceByUserId(ctx, loginUser.Id, spaceType)
+		if err != nil {
+			return err
+		}
+		if total > 0 {
+			return errno.OperationErr.WithMessage("每个用户仅能创建一个" + constants.SpaceTypeMap[spaceType])
+		}
+		id, err = db_space.CreateSpace(ctx, addSpace)
+		if err != nil || spaceType != constants.SpaceTypeTeam {
+			return err
+		}
+		_, err = db_space.CreateSpaceUser(ctx, &db_space.SpaceUser{
+			SpaceId:   id,
+			UserId:    loginUser.Id,
+			SpaceRole: constants.SpaceRoleAdmin,
+		})
+		return err
+	})
+	if err != nil {
+		var errNo errno.ErrNo
+		if errors.As(err, &errNo) {
+			return 0, errNo
+		}
+		return 0, errno.OperationErr.WithMessage("创建空间失败")
+	}
+	return id, nil
+}
+
+// GetSpace 获取空间 - 仅空间成员或管理员可获取
+// params:
+//   - req: 获取空间请求体
+//     optional: spaceId, 为空时获取本人创建的指定类型空间
+//     optional: spaceType
+//   - c: 请求上下文
+//
+// returns:
+//   - spaceVo: 空间脱敏信息
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) GetSpace(req *space.GetSpaceReq, c *app.RequestContext) (*base.SpaceVo, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	var oldSpace *db_space.Space
+	if req.ID != nil {
+		oldSpace, err = s.checkSpaceAuth(req.GetID(), loginUser)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		spaceType := int(req.GetSpaceType())
+		oldSpace, err = db_space.QuerySpaceByUserId(s.ctx, loginUser.Id, spaceType)
+		if err != nil {
+			return nil, errno.NotFoundErr.WithMessage("尚未创建" + constants.SpaceTypeMap[spaceType])
+		}
+	}
+	oldUser, err := db_user.QueryUserById(s.ctx, oldSpace.UserId)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	spaceVo := ObjToVo(oldSpace, oldUser)
+	spaceVo.SpaceRole = services.GetSpaceRole(s.ctx, oldSpace, loginUser.Id)
+	return spaceVo, nil
+}
+
+// EditSpace 编辑空间 [用户] - 仅空间管理员可编辑
+// params:
+//   - req: 编辑空间请求体
+//     required: spaceId
+//     optional: spaceName
+//   - c: 请求上下文
+//
+// returns:
+//   - spaceVo: 空间脱敏信息
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) EditSpace(req *space.EditSpaceReq, c *app.RequestContext) (*base.SpaceVo, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	if req.SpaceName == nil {
+		return nil, errno.ParamErr.WithMessage("未有更新数据")
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = services.CheckSpaceRole(s.ctx, req.ID, loginUser, constants.SpaceRoleAdmin); err != nil {
+		return nil, err
+	}
+	updates := &db_space.Space{
+		Id:        req.ID,
+		SpaceName: req.GetSpaceName(),
+		EditTime:  time.Now(),
+	}
+	if err = db_space.UpdateSpace(s.ctx, updates); err != nil {
+		return nil, errno.OperationErr.WithMessage("更新失败")
+	}
+	return s.GetSpace(&space.GetSpaceReq{ID: &req.ID}, c)
+}
+
+// ListSpaceLevel 获取空间级别及各级别的默认上限
+// returns:
+//   - levels
+func (s *SpaceService) ListSpaceLevel() []*base.SpaceLevel {
+	levels := make([]*base.SpaceLevel, 0, len(constants.SpaceLevelMap))
+	for value, text := range constants.SpaceLevelMap {
+		levels = append(levels, &base.SpaceLevel{
+			Value:    int32(value),
+			Text:     text,
+			MaxCount: constants.SpaceLevelCountMap[value],
+			MaxSize:  constants.SpaceLevelSizeMap[value],
+		})
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Value < levels[j].Value
+	})
+	return levels
+}
+
+// SearchSpacePicture 空间内图片搜索[分页] - 仅空间成员可搜索, 空间内的图片无需审核
+// params:
+//   - req: 空间图片搜索请求体
+//     required: spaceId, currentPage, pageSize
+//     optional: searchText, category, tags, picFormat
+//   - c: 请求上下文
+//
+// returns:
+//   - total: total number of matched pictures
+//   - pictureVos: 图片脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) SearchSpacePicture(req *space.SearchSpacePictureReq, c *app.RequestContext) (int64, []*base.PictureVo, error) {
+	if req == nil {
+		return 0, nil, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return 0, nil, err
+	}
+	oldSpace, err := services.CheckSpaceRole(s.ctx, req.SpaceID, loginUser, constants.SpaceRoleViewer)
+	if err != nil {
+		return 0, nil, err
+	}
+	currentPage := req.CurrentPage
+	if currentPage < 1 {
+		currentPage = constants.CurrentPage
+	}
+	pageSize := req.PageSize
+	if pageSize < 1 {
+		pageSize = constants.PageSize
+	}
+	search := &db_picture.Picture{
+		Category:     req.GetCategory(),
+		PicFormat:    req.GetPicFormat(),
+		SpaceId:      oldSpace.Id,
+		ReviewStatus: -1,
+	}
+	var tags []string
+	if req.Tags != nil {
+		tags = req.GetTags()
+	}
+	total, oldPictures, err := db_picture.QueryPicture(s.ctx, search, req.GetSearchText(), tags, nil, currentPage, pageSize)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	pictureVos := picture_services.ObjsToVos(s.ctx, oldPictures, loginUser)
+	picture_services.FillPictureState(s.ctx, pictureVos, loginUser.Id)
+	return total, pictureVos, nil
+}
+
+// checkSpaceAuth - 空间仅成员或管理员可访问
+// params:
+//   - spaceId
+//   - loginUser
+//
+// returns:
+//   - space
+//   - error: 空间不存在返回 NotFoundErr, 无权访问返回 NoAuthErr
+func (s *SpaceService) checkSpaceAuth(spaceId int64, loginUser *model.LoginUser) (*db_space.Space, error) {
+	oldSpace, err := db_space.QuerySpaceById(s.ctx, spaceId)
+	if err != nil {
+		return nil, errno.NotFoundErr.WithMessage("空间不存在")
+	}
+	if loginUser.Role != "admin" && services.GetSpaceRole(s.ctx, oldSpace, loginUser.Id) == "" {
+		return nil, errno.NoAuthErr
+	}
+	return oldSpace, nil
+}
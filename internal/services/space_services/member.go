@@ -0,0 +1,328 @@
+package space_services
+
+import (
+	"context"
+	"errors"
+	"github.com/Alf-Grindel/clide/internal/dal/db"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_space"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/model/clide/space"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ListMySpace 获取本人的私有空间及加入的团队空间
+// params:
+//   - c: 请求上下文
+//
+// returns:
+//   - spaceVos: 空间脱敏信息列表, 附带本人在空间中的角色
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) ListMySpace(c *app.RequestContext) ([]*base.SpaceVo, error) {
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	oldSpaces, err := db_space.QuerySpaceByMember(s.ctx, loginUser.Id)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	if privateSpace, err := db_space.QuerySpaceByUserId(s.ctx, loginUser.Id, constants.SpaceTypePrivate); err == nil {
+		oldSpaces = append([]*db_space.Space{privateSpace}, oldSpaces...)
+	}
+	spaceVos := ObjsToVos(s.ctx, oldSpaces)
+	for i, spaceVo := range spaceVos {
+		spaceVo.SpaceRole = services.GetSpaceRole(s.ctx, oldSpaces[i], loginUser.Id)
+	}
+	return spaceVos, nil
+}
+
+// AddSpaceUser 邀请用户加入团队空间 - 仅空间管理员可邀请, 用户接受邀请后成为成员
+// 已邀请过的用户重新发出邀请, 角色以本次为准
+// params:
+//   - req: 添加成员请求体
+//     required: spaceId, userId
+//     optional: spaceRole, 默认为 viewer
+//   - c: 请求上下文
+//
+// returns:
+//   - spaceInviteVo: 邀请脱敏信息
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) AddSpaceUser(req *space.AddSpaceUserReq, c *app.RequestContext) (*base.SpaceInviteVo, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	oldSpace, err := services.CheckSpaceRole(s.ctx, req.SpaceID, loginUser, constants.SpaceRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if oldSpace.SpaceType != constants.SpaceTypeTeam {
+		return nil, errno.ParamErr.WithMessage("仅团队空间可添加成员")
+	}
+	if _, err = db_user.QueryUserById(s.ctx, req.UserID); err != nil {
+		return nil, errno.NotFoundErr.WithMessage("用户不存在")
+	}
+	if _, err = db_space.QuerySpaceUser(s.ctx, req.SpaceID, req.UserID); err == nil {
+		return nil, errno.OperationErr.WithMessage("用户已是空间成员")
+	}
+	spaceRole := constants.SpaceRoleViewer
+	if req.SpaceRole != nil {
+		spaceRole = req.GetSpaceRole()
+	}
+	if oldSpaceInvite, err := db_space.QuerySpaceInvite(s.ctx, req.SpaceID, req.UserID); err == nil {
+		err = db_space.RenewSpaceInvite(s.ctx, oldSpaceInvite.Id, loginUser.Id, spaceRole)
+	} else {
+		_, err = db_space.CreateSpaceInvite(s.ctx, &db_space.SpaceInvite{
+			SpaceId:   req.SpaceID,
+			UserId:    req.UserID,
+			InviterId: loginUser.Id,
+			SpaceRole: spaceRole,
+		})
+	}
+	if err != nil {
+		return nil, errno.OperationErr.WithMessage("邀请失败")
+	}
+	oldSpaceInvite, err := db_space.QuerySpaceInvite(s.ctx, req.SpaceID, req.UserID)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	spaceInvites := SpaceInviteObjsToVos(s.ctx, []*db_space.SpaceInvite{oldSpaceInvite})
+	if len(spaceInvites) == 0 {
+		return nil, errno.NotFoundErr
+	}
+	return spaceInvites[0], nil
+}
+
+// ListMySpaceInvite 获取本人待处理的空间邀请
+// params:
+//   - c: 请求上下文
+//
+// returns:
+//   - spaceInviteVos: 邀请脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) ListMySpaceInvite(c *app.RequestContext) ([]*base.SpaceInviteVo, error) {
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	oldSpaceInvites, err := db_space.QueryPendingSpaceInviteByUserId(s.ctx, loginUser.Id)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	return SpaceInviteObjsToVos(s.ctx, oldSpaceInvites), nil
+}
+
+// AcceptSpaceInvite 接受空间邀请 - 仅受邀用户可接受, 接受后按邀请中的角色成为成员
+// params:
+//   - req: 接受邀请请求体
+//     required: id
+//   - c: 请求上下文
+//
+// returns:
+//   - spaceUserVo: 成员脱敏信息
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) AcceptSpaceInvite(req *space.AcceptSpaceInviteReq, c *app.RequestContext) (*base.SpaceUserVo, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	oldSpaceInvite, err := s.getPendingSpaceInvite(req.ID, loginUser.Id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = db_space.QuerySpaceById(s.ctx, oldSpaceInvite.SpaceId); err != nil {
+		return nil, errno.NotFoundErr.WithMessage("空间不存在")
+	}
+	err = db.Transaction(s.ctx, func(ctx context.Context) error {
+		ok, err := db_space.UpdateSpaceInviteStatus(ctx, oldSpaceInvite.Id, constants.SpaceInviteStatusPending, constants.SpaceInviteStatusAccepted)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errno.OperationErr.WithMessage("邀请已处理")
+		}
+		// 已是成员时仅更新邀请状态
+		if _, err = db_space.QuerySpaceUser(ctx, oldSpaceInvite.SpaceId, loginUser.Id); err == nil {
+			return nil
+		}
+		_, err = db_space.CreateSpaceUser(ctx, &db_space.SpaceUser{
+			SpaceId:   oldSpaceInvite.SpaceId,
+			UserId:    loginUser.Id,
+			SpaceRole: oldSpaceInvite.SpaceRole,
+		})
+		return err
+	})
+	if err != nil {
+		var errNo errno.ErrNo
+		if errors.As(err, &errNo) {
+			return nil, errNo
+		}
+		return nil, errno.OperationErr.WithMessage("接受邀请失败")
+	}
+	oldSpaceUser, err := db_space.QuerySpaceUser(s.ctx, oldSpaceInvite.SpaceId, loginUser.Id)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	oldUser, err := db_user.QueryUserById(s.ctx, loginUser.Id)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	return SpaceUserObjToVo(oldSpaceUser, oldUser), nil
+}
+
+// DeclineSpaceInvite 拒绝空间邀请 - 仅受邀用户可拒绝
+// params:
+//   - req: 拒绝邀请请求体
+//     required: id
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) DeclineSpaceInvite(req *space.DeclineSpaceInviteReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	oldSpaceInvite, err := s.getPendingSpaceInvite(req.ID, loginUser.Id)
+	if err != nil {
+		return err
+	}
+	ok, err := db_space.UpdateSpaceInviteStatus(s.ctx, oldSpaceInvite.Id, constants.SpaceInviteStatusPending, constants.SpaceInviteStatusDeclined)
+	if err != nil {
+		return errno.OperationErr.WithMessage("拒绝邀请失败")
+	}
+	if !ok {
+		return errno.OperationErr.WithMessage("邀请已处理")
+	}
+	return nil
+}
+
+// getPendingSpaceInvite - 获取发给指定用户且尚未处理的邀请
+func (s *SpaceService) getPendingSpaceInvite(id, userId int64) (*db_space.SpaceInvite, error) {
+	oldSpaceInvite, err := db_space.QuerySpaceInviteById(s.ctx, id)
+	// 不向其他用户暴露邀请是否存在
+	if err != nil || oldSpaceInvite.UserId != userId {
+		return nil, errno.NotFoundErr.WithMessage("邀请不存在")
+	}
+	if oldSpaceInvite.Status != constants.SpaceInviteStatusPending {
+		return nil, errno.OperationErr.WithMessage("邀请已处理")
+	}
+	return oldSpaceInvite, nil
+}
+
+// DeleteSpaceUser 移除团队空间成员 - 空间管理员可移除成员, 成员可退出空间, 创建者不能被移除
+// params:
+//   - req: 移除成员请求体
+//     required: spaceId, userId
+//   - c: 请求上下文
+//
+// returns:
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) DeleteSpaceUser(req *space.DeleteSpaceUserReq, c *app.RequestContext) error {
+	if req == nil {
+		return errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return err
+	}
+	minRole := constants.SpaceRoleAdmin
+	if req.UserID == loginUser.Id {
+		minRole = constants.SpaceRoleViewer
+	}
+	oldSpace, err := services.CheckSpaceRole(s.ctx, req.SpaceID, loginUser, minRole)
+	if err != nil {
+		return err
+	}
+	if oldSpace.UserId == req.UserID {
+		return errno.OperationErr.WithMessage("不能移除空间创建者")
+	}
+	if _, err = db_space.QuerySpaceUser(s.ctx, req.SpaceID, req.UserID); err != nil {
+		return errno.NotFoundErr.WithMessage("用户不是空间成员")
+	}
+	if err = db_space.DeleteSpaceUser(s.ctx, req.SpaceID, req.UserID); err != nil {
+		return errno.OperationErr.WithMessage("移除成员失败")
+	}
+	return nil
+}
+
+// EditSpaceUser 修改团队空间成员角色 - 仅空间管理员可修改, 创建者的角色不能修改
+// params:
+//   - req: 修改成员请求体
+//     required: spaceId, userId, spaceRole
+//   - c: 请求上下文
+//
+// returns:
+//   - spaceUserVo: 成员脱敏信息
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) EditSpaceUser(req *space.EditSpaceUserReq, c *app.RequestContext) (*base.SpaceUserVo, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	oldSpace, err := services.CheckSpaceRole(s.ctx, req.SpaceID, loginUser, constants.SpaceRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if oldSpace.UserId == req.UserID {
+		return nil, errno.OperationErr.WithMessage("不能修改空间创建者的角色")
+	}
+	if _, err = db_space.QuerySpaceUser(s.ctx, req.SpaceID, req.UserID); err != nil {
+		return nil, errno.NotFoundErr.WithMessage("用户不是空间成员")
+	}
+	if err = db_space.UpdateSpaceUserRole(s.ctx, req.SpaceID, req.UserID, req.SpaceRole); err != nil {
+		return nil, errno.OperationErr.WithMessage("更新失败")
+	}
+	oldSpaceUser, err := db_space.QuerySpaceUser(s.ctx, req.SpaceID, req.UserID)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	oldUser, err := db_user.QueryUserById(s.ctx, req.UserID)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	return SpaceUserObjToVo(oldSpaceUser, oldUser), nil
+}
+
+// ListSpaceUser 获取团队空间成员列表 - 仅空间成员可获取
+// params:
+//   - req: 成员列表请求体
+//     required: spaceId
+//   - c: 请求上下文
+//
+// returns:
+//   - spaceUserVos: 成员脱敏信息列表
+//   - error: nil on success, non-nil on failure
+func (s *SpaceService) ListSpaceUser(req *space.ListSpaceUserReq, c *app.RequestContext) ([]*base.SpaceUserVo, error) {
+	if req == nil {
+		return nil, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = services.CheckSpaceRole(s.ctx, req.SpaceID, loginUser, constants.SpaceRoleViewer); err != nil {
+		return nil, err
+	}
+	oldSpaceUsers, err := db_space.QuerySpaceUserBySpaceId(s.ctx, req.SpaceID)
+	if err != nil {
+		return nil, errno.NotFoundErr
+	}
+	return SpaceUserObjsToVos(s.ctx, oldSpaceUsers), nil
+}
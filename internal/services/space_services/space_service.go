@@ -0,0 +1,128 @@
+package space_services
+
+import (
+	"context"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_space"
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/services/user_services"
+	"time"
+)
+
+type SpaceService struct {
+	ctx context.Context
+}
+
+func NewSpaceService(ctx context.Context) *SpaceService {
+	return &SpaceService{ctx}
+}
+
+// ObjToVo - 转换为脱敏对象
+func ObjToVo(oldSpace *db_space.Space, oldUser *db_user.User) *base.SpaceVo {
+	if oldSpace == nil {
+		return nil
+	}
+	return &base.SpaceVo{
+		ID:         oldSpace.Id,
+		SpaceName:  oldSpace.SpaceName,
+		SpaceLevel: int32(oldSpace.SpaceLevel),
+		MaxCount:   oldSpace.MaxCount,
+		MaxSize:    oldSpace.MaxSize,
+		TotalCount: oldSpace.TotalCount,
+		TotalSize:  oldSpace.TotalSize,
+		UserId:     oldSpace.UserId,
+		User:       user_services.ObjToVo(oldUser),
+		EditTime:   oldSpace.EditTime.Format(time.DateTime),
+		CreateTime: oldSpace.CreateTime.Format(time.DateTime),
+		SpaceType:  int32(oldSpace.SpaceType),
+	}
+}
+
+// ObjsToVos - 转换为脱敏列表
+func ObjsToVos(ctx context.Context, oldSpaces []*db_space.Space) []*base.SpaceVo {
+	if oldSpaces == nil {
+		return nil
+	}
+	var spaces []*base.SpaceVo
+	for _, oldSpace := range oldSpaces {
+		oldUser, err := db_user.QueryUserById(ctx, oldSpace.UserId)
+		if err != nil {
+			return nil
+		}
+		spaces = append(spaces, ObjToVo(oldSpace, oldUser))
+	}
+	return spaces
+}
+
+// SpaceUserObjToVo - 转换空间成员为脱敏对象
+func SpaceUserObjToVo(oldSpaceUser *db_space.SpaceUser, oldUser *db_user.User) *base.SpaceUserVo {
+	if oldSpaceUser == nil {
+		return nil
+	}
+	return &base.SpaceUserVo{
+		ID:         oldSpaceUser.Id,
+		SpaceId:    oldSpaceUser.SpaceId,
+		UserId:     oldSpaceUser.UserId,
+		SpaceRole:  oldSpaceUser.SpaceRole,
+		User:       user_services.ObjToVo(oldUser),
+		CreateTime: oldSpaceUser.CreateTime.Format(time.DateTime),
+	}
+}
+
+// SpaceUserObjsToVos - 转换空间成员为脱敏列表
+func SpaceUserObjsToVos(ctx context.Context, oldSpaceUsers []*db_space.SpaceUser) []*base.SpaceUserVo {
+	if oldSpaceUsers == nil {
+		return nil
+	}
+	var spaceUsers []*base.SpaceUserVo
+	for _, oldSpaceUser := range oldSpaceUsers {
+		oldUser, err := db_user.QueryUserById(ctx, oldSpaceUser.UserId)
+		if err != nil {
+			return nil
+		}
+		spaceUsers = append(spaceUsers, SpaceUserObjToVo(oldSpaceUser, oldUser))
+	}
+	return spaceUsers
+}
+
+// SpaceInviteObjToVo - 转换空间邀请为脱敏对象
+func SpaceInviteObjToVo(oldSpaceInvite *db_space.SpaceInvite, spaceVo *base.SpaceVo, oldInviter *db_user.User) *base.SpaceInviteVo {
+	if oldSpaceInvite == nil {
+		return nil
+	}
+	return &base.SpaceInviteVo{
+		ID:         oldSpaceInvite.Id,
+		SpaceId:    oldSpaceInvite.SpaceId,
+		Space:      spaceVo,
+		UserId:     oldSpaceInvite.UserId,
+		InviterId:  oldSpaceInvite.InviterId,
+		Inviter:    user_services.ObjToVo(oldInviter),
+		SpaceRole:  oldSpaceInvite.SpaceRole,
+		Status:     int32(oldSpaceInvite.Status),
+		CreateTime: oldSpaceInvite.CreateTime.Format(time.DateTime),
+	}
+}
+
+// SpaceInviteObjsToVos - 转换空间邀请为脱敏列表
+func SpaceInviteObjsToVos(ctx context.Context, oldSpaceInvites []*db_space.SpaceInvite) []*base.SpaceInviteVo {
+	if oldSpaceInvites == nil {
+		return nil
+	}
+	var spaceInvites []*base.SpaceInviteVo
+	for _, oldSpaceInvite := range oldSpaceInvites {
+		oldSpace, err := db_space.QuerySpaceById(ctx, oldSpaceInvite.SpaceId)
+		if err != nil {
+			return nil
+		}
+		oldOwner, err := db_user.QueryUserById(ctx, oldSpace.UserId)
+		if err != nil {
+			return nil
+		}
+		oldInviter, err := db_user.QueryUserById(ctx, oldSpaceInvite.InviterId)
+		if err != nil {
+			return nil
+		}
+		spaceInvites = append(spaceInvites, SpaceInviteObjToVo(oldSpaceInvite, ObjToVo(oldSpace, oldOwner), oldInviter))
+	}
+	return spaceInvites
+}
@@ -65,7 +65,7 @@ func (s *UserService) DeleteUser(req *user.DeleteUserReq) error {
 // params:
 //   - req: 更新用户请求体
 //     required: id
-//     optional: userPassword, userAvatar, userProfile, userRole
+//     optional: userPassword, userAvatar, userProfile, userRole, quotaCount, quotaSize
 //
 // returns:
 //   - userVo: 脱敏用户信息
@@ -74,7 +74,8 @@ func (s *UserService) UpdateUser(req *user.UpdateUserReq) (*base.UserVo, error)
 	if req == nil {
 		return nil, errno.ParamErr
 	}
-	if req.UserPassword == nil && req.UserAvatar == nil && req.UserProfile == nil && req.UserRole == nil {
+	if req.UserPassword == nil && req.UserAvatar == nil && req.UserProfile == nil && req.UserRole == nil &&
+		req.QuotaCount == nil && req.QuotaSize == nil {
 		return nil, errno.ParamErr.WithMessage("未有更新数据")
 	}
 	updates := &db_user.User{
@@ -96,6 +97,9 @@ func (s *UserService) UpdateUser(req *user.UpdateUserReq) (*base.UserVo, error)
 	if err != nil {
 		return nil, errno.OperationErr.WithMessage("更新失败")
 	}
+	if err = db_user.UpdateUserQuota(s.ctx, req.ID, req.QuotaCount, req.QuotaSize); err != nil {
+		return nil, errno.OperationErr.WithMessage("更新配额失败")
+	}
 	oldUser, err := db_user.QueryUserById(s.ctx, req.ID)
 	if err != nil {
 		return nil, errno.NotFoundErr
@@ -20,17 +20,18 @@ import (
 //
 // returns:
 //   - userVo: 脱敏用户信息
+//   - quota: 存储配额及用量
 //   - error: nil on success, non-nil on failure
-func (s *UserService) GetLoginUser(c *app.RequestContext) (*base.UserVo, error) {
+func (s *UserService) GetLoginUser(c *app.RequestContext) (*base.UserVo, *base.UserQuota, error) {
 	loginUser, err := services.GetLoginUserIdRole(c)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	oldUser, err := db_user.QueryUserById(s.ctx, loginUser.Id)
 	if err != nil {
-		return nil, errno.NotFoundErr
+		return nil, nil, errno.NotFoundErr
 	}
-	return ObjToVo(oldUser), nil
+	return ObjToVo(oldUser), ObjToQuota(oldUser), nil
 }
 
 // UserLogout 用户登出
@@ -100,12 +101,13 @@ func (s *UserService) UserEdit(req *user.UserEditReq, c *app.RequestContext) (*b
 //   - req: 用户搜索请求体
 //     required: currentPage, pageSize
 //     optional: id, userAccount, userProfile
+//   - c: 请求上下文
 //
 // returns:
 //   - total: total number of matched users
-//   - userVos: 用户脱敏信息列表
+//   - userVos: 用户脱敏信息列表, 附带是否已关注
 //   - error: nil on success, non-nil on failure
-func (s *UserService) UserSearch(req *user.UserSearchReq) (int64, []*base.UserVo, error) {
+func (s *UserService) UserSearch(req *user.UserSearchReq, c *app.RequestContext) (int64, []*base.UserVo, error) {
 	if req == nil {
 		return 0, nil, errno.ParamErr
 	}
@@ -126,5 +128,7 @@ func (s *UserService) UserSearch(req *user.UserSearchReq) (int64, []*base.UserVo
 	if err != nil {
 		return 0, nil, errno.NotFoundErr
 	}
-	return total, ObjsToVos(oldUsers), nil
+	userVos := ObjsToVos(oldUsers)
+	s.fillFollowState(userVos, c)
+	return total, userVos, nil
 }
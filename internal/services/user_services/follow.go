@@ -0,0 +1,153 @@
+package user_services
+
+import (
+	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
+	"github.com/Alf-Grindel/clide/internal/model/base"
+	"github.com/Alf-Grindel/clide/internal/model/clide/user"
+	"github.com/Alf-Grindel/clide/internal/services"
+	"github.com/Alf-Grindel/clide/pkg/constants"
+	"github.com/Alf-Grindel/clide/pkg/errno"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// FollowUser 关注用户 - 不能关注自己, 重复关注不会重复计数
+// params:
+//   - req: 关注请求体
+//     required: userId
+//   - c: 请求上下文
+//
+// returns:
+//   - followerCount: 被关注用户的粉丝数
+//   - error: nil on success, non-nil on failure
+func (s *UserService) FollowUser(req *user.FollowUserReq, c *app.RequestContext) (int64, error) {
+	if req == nil {
+		return 0, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return 0, err
+	}
+	if req.UserID == loginUser.Id {
+		return 0, errno.ParamErr.WithMessage("不能关注自己")
+	}
+	if _, err = db_user.QueryUserById(s.ctx, req.UserID); err != nil {
+		return 0, errno.NotFoundErr.WithMessage("用户不存在")
+	}
+	followerCount, err := db_user.CreateFollow(s.ctx, loginUser.Id, req.UserID)
+	if err != nil {
+		return 0, errno.OperationErr.WithMessage("关注失败")
+	}
+	return followerCount, nil
+}
+
+// UnfollowUser 取消关注用户 - 未关注时不做变更
+// params:
+//   - req: 取消关注请求体
+//     required: userId
+//   - c: 请求上下文
+//
+// returns:
+//   - followerCount: 被取消关注用户的粉丝数
+//   - error: nil on success, non-nil on failure
+func (s *UserService) UnfollowUser(req *user.UnfollowUserReq, c *app.RequestContext) (int64, error) {
+	if req == nil {
+		return 0, errno.ParamErr
+	}
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil {
+		return 0, err
+	}
+	followerCount, err := db_user.DeleteFollow(s.ctx, loginUser.Id, req.UserID)
+	if err != nil {
+		return 0, errno.OperationErr.WithMessage("取消关注失败")
+	}
+	return followerCount, nil
+}
+
+// ListFollower 粉丝列表[分页] - 按关注时间倒序
+// params:
+//   - req: 粉丝列表请求体
+//     required: userId, currentPage, pageSize
+//   - c: 请求上下文
+//
+// returns:
+//   - total: total number of followers
+//   - userVos: 用户脱敏信息列表, 附带当前用户是否已关注
+//   - error: nil on success, non-nil on failure
+func (s *UserService) ListFollower(req *user.ListFollowerReq, c *app.RequestContext) (int64, []*base.UserVo, error) {
+	if req == nil {
+		return 0, nil, errno.ParamErr
+	}
+	currentPage, pageSize := pageParams(req.CurrentPage, req.PageSize)
+	if _, err := db_user.QueryUserById(s.ctx, req.UserID); err != nil {
+		return 0, nil, errno.NotFoundErr.WithMessage("用户不存在")
+	}
+	total, oldUsers, err := db_user.QueryFollower(s.ctx, req.UserID, currentPage, pageSize)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	userVos := ObjsToVos(oldUsers)
+	s.fillFollowState(userVos, c)
+	return total, userVos, nil
+}
+
+// ListFollowing 关注列表[分页] - 按关注时间倒序
+// params:
+//   - req: 关注列表请求体
+//     required: userId, currentPage, pageSize
+//   - c: 请求上下文
+//
+// returns:
+//   - total: total number of followed users
+//   - userVos: 用户脱敏信息列表, 附带当前用户是否已关注
+//   - error: nil on success, non-nil on failure
+func (s *UserService) ListFollowing(req *user.ListFollowingReq, c *app.RequestContext) (int64, []*base.UserVo, error) {
+	if req == nil {
+		return 0, nil, errno.ParamErr
+	}
+	currentPage, pageSize := pageParams(req.CurrentPage, req.PageSize)
+	if _, err := db_user.QueryUserById(s.ctx, req.UserID); err != nil {
+		return 0, nil, errno.NotFoundErr.WithMessage("用户不存在")
+	}
+	total, oldUsers, err := db_user.QueryFollowing(s.ctx, req.UserID, currentPage, pageSize)
+	if err != nil {
+		return 0, nil, errno.NotFoundErr
+	}
+	userVos := ObjsToVos(oldUsers)
+	s.fillFollowState(userVos, c)
+	return total, userVos, nil
+}
+
+// fillFollowState - 填充当前用户对列表中用户的关注状态
+func (s *UserService) fillFollowState(userVos []*base.UserVo, c *app.RequestContext) {
+	loginUser, err := services.GetLoginUserIdRole(c)
+	if err != nil || len(userVos) == 0 {
+		return
+	}
+	userIds := make([]int64, 0, len(userVos))
+	for _, userVo := range userVos {
+		userIds = append(userIds, userVo.ID)
+	}
+	followedIds, err := db_user.QueryFollowedUserIds(s.ctx, loginUser.Id, userIds)
+	if err != nil {
+		return
+	}
+	followed := make(map[int64]bool, len(followedIds))
+	for _, id := range followedIds {
+		followed[id] = true
+	}
+	for _, userVo := range userVos {
+		userVo.Followed = followed[userVo.ID]
+	}
+}
+
+// pageParams - 分页参数为空时使用默认值
+func pageParams(currentPage, pageSize int64) (int64, int64) {
+	if currentPage < 1 {
+		currentPage = constants.CurrentPage
+	}
+	if pageSize < 1 {
+		pageSize = constants.PageSize
+	}
+	return currentPage, pageSize
+}
@@ -2,6 +2,7 @@ package user_services
 
 import (
 	"context"
+	"github.com/Alf-Grindel/clide/config"
 	"github.com/Alf-Grindel/clide/internal/dal/db/db_user"
 	"github.com/Alf-Grindel/clide/internal/model/base"
 	"github.com/Alf-Grindel/clide/pkg/constants"
@@ -28,6 +29,9 @@ func ObjToVo(oldUser *db_user.User) *base.UserVo {
 		UserProfile: oldUser.UserProfile,
 		EditTime:    oldUser.EditTime.Format(time.DateTime),
 		CreateTime:  oldUser.CreateTime.Format(time.DateTime),
+
+		FollowerCount:  oldUser.FollowerCount,
+		FollowingCount: oldUser.FollowingCount,
 	}
 }
 
@@ -58,5 +62,26 @@ func ObjToObj(oldUser *db_user.User) *base.User {
 		CreateTime:  oldUser.CreateTime.Format(time.DateTime),
 		UpdateTime:  oldUser.UpdateTime.Format(time.DateTime),
 		IsDelete:    constants.IsDeleteMap[oldUser.IsDelete],
+		Quota:       ObjToQuota(oldUser),
+	}
+}
+
+// ObjToQuota - 转换为存储配额, 未覆盖时使用默认配额
+func ObjToQuota(oldUser *db_user.User) *base.UserQuota {
+	if oldUser == nil {
+		return nil
+	}
+	quota := &base.UserQuota{
+		MaxCount:  config.Quota.MaxCount,
+		MaxSize:   config.Quota.MaxSize,
+		UsedCount: oldUser.UsedCount,
+		UsedSize:  oldUser.UsedSize,
+	}
+	if oldUser.QuotaCount != nil {
+		quota.MaxCount = *oldUser.QuotaCount
+	}
+	if oldUser.QuotaSize != nil {
+		quota.MaxSize = *oldUser.QuotaSize
 	}
+	return quota
 }
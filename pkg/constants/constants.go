@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
 	CookieStore    = "secret-key-secret"
 	SessionKey     = "mysession"
@@ -10,25 +12,123 @@ const (
 const (
 	CosDefaultOrigin = "https://%s.cos.%s.myqcloud.com"
 	MaxFileSize      = 2 * 1024 * 1024 // 2MB
+	// 默认请求体上限, 压缩包上传等路由单独放宽
+	MaxRequestBodySize = 4 * 1024 * 1024 // 4MB
+
+	PublicSpace  = "public/%s"
+	PublicPrefix = "public/"
+	// 私有空间按空间id划分目录, 访问时需签名
+	PrivateSpace = "private/%s"
+	// 直传的暂存目录, 不对外公开, 确认后复制至正式目录
+	StagingSpace = "staging/%s"
+
+	StorageDriverCos   = "cos"
+	StorageDriverLocal = "local"
+
+	DedupScopeOff    = "off"
+	DedupScopeUser   = "user"
+	DedupScopeGlobal = "global"
+	DedupModeReject  = "reject"
+	DedupModeReuse   = "reuse"
 
-	PublicSpace = "public/%s"
+	DefaultPurgeRetention = 7 * 24 * time.Hour
+	DefaultPurgeInterval  = 10 * time.Minute
+	PurgeBatchSize        = 100
+
+	DefaultMaxSessionFileSize = 50 * 1024 * 1024 // 50MB
+	DefaultSessionExpire      = 24 * time.Hour
+	DefaultChunkSize          = 1024 * 1024 // 1MB
+	MinChunkSize              = 64 * 1024   // 64KB
+	MaxChunkSize              = 2 * 1024 * 1024
+	MaxChunkCount             = 1000
+	UploadSessionDir          = "clide-upload"
+	UploadSessionStatusActive = 0
+	UploadSessionStatusClosed = 1
+	DefaultPresignExpire      = 15 * time.Minute
+	DefaultSignExpire         = time.Hour
+	DefaultFetchTimeout       = 10 * time.Second
+	SvgModeSanitize           = "sanitize"
+	SvgModeRasterize          = "rasterize"
+	SvgRasterMaxSize          = 4096
+	HeicJpegQuality           = 90
+	DefaultMaxArchiveSize     = 100 * 1024 * 1024 // 100MB
+	MaxArchiveEntries         = 500
+	// 含目录与跳过的文件
+	MaxArchiveHeaders          = 2000
+	MaxArchiveUncompressedSize = 512 * 1024 * 1024 // 512MB
+	ArchiveManifest            = "manifest.json"
+	MaxManifestSize            = 1024 * 1024
 
 	FetchUrl = "https://cn.bing.com/images/async?q=%s&mmasync=1"
 )
 
 const (
-	MysqlDefaultDsn  = "%s:%s@tcp(%s)/%s?charset=utf8mb4&parseTime=True&loc=Local"
-	UserTableName    = "c_users"
-	PictureTableName = "c_pictures"
+	MysqlDefaultDsn       = "%s:%s@tcp(%s)/%s?charset=utf8mb4&parseTime=True&loc=Local"
+	UserTableName         = "c_users"
+	PictureTableName      = "c_pictures"
+	PurgeTableName        = "c_storage_purges"
+	SessionTableName      = "c_upload_sessions"
+	SpaceTableName        = "c_spaces"
+	SpaceUserTableName    = "c_space_users"
+	SpaceInviteTableName  = "c_space_invites"
+	AlbumTableName        = "c_albums"
+	AlbumPictureTableName = "c_album_pictures"
+	LikeTableName         = "c_picture_likes"
+	FavoriteTableName     = "c_picture_favorites"
+	CommentTableName      = "c_comments"
+	FollowTableName       = "c_user_follows"
 )
 
 const (
 	DefaultPassword = "12345678"
 )
 
+const (
+	SpaceTypePrivate = 0
+	SpaceTypeTeam    = 1
+
+	SpaceRoleViewer = "viewer"
+	SpaceRoleEditor = "editor"
+	SpaceRoleAdmin  = "admin"
+
+	SpaceInviteStatusPending  = 0
+	SpaceInviteStatusAccepted = 1
+	SpaceInviteStatusDeclined = 2
+)
+
+const (
+	AlbumVisibilityPrivate = 0
+	AlbumVisibilityPublic  = 1
+
+	MaxAlbumPictures = 500
+
+	// 一级评论附带的回复数量
+	CommentReplyPreview = 3
+)
+
 const (
 	PageSize    = 20
 	CurrentPage = 1
+
+	SimilarMaxDistance = 10
+	SimilarLimit       = 20
+
+	PaletteSize    = 5
+	ColorTolerance = 60
+
+	ImageQuality     = 80
+	ThumbnailSize    = 256
+	CompressedSize   = 1920
+	ImageCacheMaxAge = 24 * time.Hour
+	// 衍生图仅允许以下预设的宽高与质量, 分别用于列表缩略图与预览, 避免任意参数组合生成大量衍生图
+	// 缩略图与上传时生成的缩略图变体尺寸一致
+	ImagePresetThumbnail = ThumbnailSize
+	ImagePresetPreview   = 1080
+	ImageQualityLow      = 60
+	ImageQualityHigh     = 95
+	// 缓存未命中时每秒允许渲染的衍生图数量
+	ImageRenderRate  = 10
+	ImageRenderBurst = 20
 )
 
 var (
@@ -48,4 +148,40 @@ var (
 		1: "通过",
 		2: "拒绝",
 	}
+
+	SpaceTypeMap = map[int]string{
+		0: "私有空间",
+		1: "团队空间",
+	}
+
+	AlbumVisibilityMap = map[int]string{
+		0: "私密",
+		1: "公开",
+	}
+
+	SpaceLevelMap = map[int]string{
+		0: "普通版",
+		1: "专业版",
+		2: "旗舰版",
+	}
+
+	// 各级别空间的默认图片数量与存储容量上限
+	SpaceLevelCountMap = map[int]int64{
+		0: 100,
+		1: 1000,
+		2: 10000,
+	}
+
+	SpaceLevelSizeMap = map[int]int64{
+		0: 100 * 1024 * 1024,
+		1: 1024 * 1024 * 1024,
+		2: 10 * 1024 * 1024 * 1024,
+	}
+
+	// 空间成员角色的权限等级, 高等级拥有低等级的全部权限
+	SpaceRoleLevelMap = map[string]int{
+		SpaceRoleViewer: 1,
+		SpaceRoleEditor: 2,
+		SpaceRoleAdmin:  3,
+	}
 )
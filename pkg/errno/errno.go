@@ -10,7 +10,9 @@ const (
 	ParamErrCode     = 40000
 	NotLoginErrCode  = 40100
 	NoAuthErrCode    = 40101
+	QuotaErrCode     = 40300
 	NotFoundErrCode  = 40400
+	DuplicateErrCode = 40900
 	SystemErrCode    = 50000
 	OperationErrCode = 50001
 )
@@ -39,6 +41,8 @@ var (
 	NoAuthErr    = NewErrNo(NoAuthErrCode, "无权限")
 	OperationErr = NewErrNo(OperationErrCode, "操作失败")
 	NotFoundErr  = NewErrNo(NotFoundErrCode, "请求数据不存在")
+	DuplicateErr = NewErrNo(DuplicateErrCode, "图片已存在")
+	QuotaErr     = NewErrNo(QuotaErrCode, "存储配额不足")
 )
 
 func (e ErrNo) WithMessage(msg string) ErrNo {